@@ -0,0 +1,24 @@
+//Package gofmt formats the code written by the generators in cmd the way the
+//mailchimp packages are written.
+package gofmt
+
+import (
+	"bytes"
+	"go/format"
+	"regexp"
+)
+
+//docSpace matches the space gofmt puts after the slashes of doc comments
+var docSpace = regexp.MustCompile(`(?m)^(\t*)// (\S)`)
+
+//Source formats src with go/format and removes the space gofmt puts after the
+//slashes of doc comments, which the packages don't use, keeping the generated
+//code header on the first line that go tools recognise
+func Source(src []byte) ([]byte, error) {
+	formatted, err := format.Source(src)
+	if err != nil {
+		return nil, err
+	}
+	header, rest, _ := bytes.Cut(formatted, []byte("\n"))
+	return append(append(header, '\n'), docSpace.ReplaceAll(rest, []byte("$1//$2"))...), nil
+}
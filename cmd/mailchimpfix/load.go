@@ -0,0 +1,104 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"go/ast"
+	"go/importer"
+	"go/parser"
+	"go/token"
+	"go/types"
+	"io"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+)
+
+//goPackage is a package as described by go list
+type goPackage struct {
+	Dir          string
+	ImportPath   string
+	Export       string
+	ForTest      string
+	DepOnly      bool
+	GoFiles      []string
+	CgoFiles     []string
+	TestGoFiles  []string
+	XTestGoFiles []string
+}
+
+//load lists the packages matched by patterns, dependencies first, with the
+//files of the export data of every package they or their tests import
+func load(patterns []string) ([]goPackage, map[string]string, error) {
+	args := append([]string{"list", "-e", "-json", "-export", "-deps", "-test", "--"}, patterns...)
+	cmd := exec.Command("go", args...)
+	var stderr bytes.Buffer
+	cmd.Stderr = &stderr
+	out, err := cmd.Output()
+	if err != nil {
+		return nil, nil, fmt.Errorf("go list: %v\n%s", err, stderr.Bytes())
+	}
+	var pkgs []goPackage
+	exports := make(map[string]string)
+	decoder := json.NewDecoder(bytes.NewReader(out))
+	for decoder.More() {
+		var p goPackage
+		if err := decoder.Decode(&p); err != nil {
+			return nil, nil, fmt.Errorf("go list: %v", err)
+		}
+		//test variants and test mains are covered by the packages themselves
+		if p.ForTest != "" || strings.HasSuffix(p.ImportPath, ".test") {
+			continue
+		}
+		if p.Export != "" {
+			exports[p.ImportPath] = p.Export
+		}
+		if !p.DepOnly {
+			pkgs = append(pkgs, p)
+		}
+	}
+	return pkgs, exports, nil
+}
+
+func parseFiles(fset *token.FileSet, dir string, names ...[]string) ([]*ast.File, error) {
+	var files []*ast.File
+	for _, list := range names {
+		for _, name := range list {
+			file, err := parser.ParseFile(fset, filepath.Join(dir, name), nil, parser.ParseComments)
+			if err != nil {
+				return nil, err
+			}
+			files = append(files, file)
+		}
+	}
+	return files, nil
+}
+
+//packageImporter imports the packages it has checked itself and reads the
+//rest from their export data
+type packageImporter struct {
+	checked map[string]*types.Package
+	exports types.Importer
+}
+
+func newImporter(fset *token.FileSet, exports map[string]string) *packageImporter {
+	return &packageImporter{
+		checked: make(map[string]*types.Package),
+		exports: importer.ForCompiler(fset, "gc", func(path string) (io.ReadCloser, error) {
+			export, ok := exports[path]
+			if !ok {
+				return nil, fmt.Errorf("no export data for %v", path)
+			}
+			return os.Open(export)
+		}),
+	}
+}
+
+func (i *packageImporter) Import(path string) (*types.Package, error) {
+	if pkg, ok := i.checked[path]; ok {
+		return pkg, nil
+	}
+	return i.exports.Import(path)
+}
@@ -0,0 +1,394 @@
+//Command mailchimpfix rewrites Go code that uses the old underscored field
+//and type names of the mailchimp result types, e.g. Web_id, to their current
+//names, e.g. WebID.
+//
+//Usage:
+//
+//	mailchimpfix [-l] [-n] [path ...]
+//
+//A path ending in /... is walked recursively. The packages are loaded with
+//go list and type-checked, so mailchimpfix must be run from the module that
+//uses github.com/areed/mailchimp. A selector or composite literal key is
+//renamed only if the old name doesn't resolve and the new one is a field of
+//a type declared in the mailchimp package, so fields of other types with the
+//same names, such as Id, are left alone. Only files that import the package
+//are changed.
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/token"
+	"go/types"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+)
+
+const importPath = "github.com/areed/mailchimp"
+
+var (
+	list   = flag.Bool("l", false, "list the files that would change without changing them")
+	dryRun = flag.Bool("n", false, "print the rewritten files instead of changing them")
+)
+
+func main() {
+	flag.Usage = func() {
+		fmt.Fprintf(os.Stderr, "usage: mailchimpfix [-l] [-n] [path ...]\n")
+		flag.PrintDefaults()
+	}
+	flag.Parse()
+	paths := flag.Args()
+	if len(paths) == 0 {
+		paths = []string{"./..."}
+	}
+	if err := run(patterns(paths)); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+}
+
+//patterns converts paths to go list patterns, which must start with a dot
+//or be absolute to be taken as directories rather than import paths
+func patterns(paths []string) []string {
+	patterns := make([]string, len(paths))
+	for i, path := range paths {
+		if !filepath.IsAbs(path) && !strings.HasPrefix(path, ".") {
+			path = "." + string(filepath.Separator) + path
+		}
+		patterns[i] = path
+	}
+	return patterns
+}
+
+//run fixes the packages matched by patterns, dependencies first so that
+//their fixed types are used when checking the packages that import them
+func run(patterns []string) error {
+	pkgs, exports, err := load(patterns)
+	if err != nil {
+		return err
+	}
+	fset := token.NewFileSet()
+	imp := newImporter(fset, exports)
+	for _, p := range pkgs {
+		files, err := parseFiles(fset, p.Dir, p.GoFiles, p.CgoFiles, p.TestGoFiles)
+		if err != nil {
+			return err
+		}
+		if importsMailchimp(files...) {
+			pkg, changed := fixPackage(fset, p.ImportPath, files, imp)
+			imp.checked[p.ImportPath] = pkg
+			if err := write(fset, changed); err != nil {
+				return err
+			}
+		}
+		files, err = parseFiles(fset, p.Dir, p.XTestGoFiles)
+		if err != nil {
+			return err
+		}
+		if importsMailchimp(files...) {
+			_, changed := fixPackage(fset, p.ImportPath+"_test", files, imp)
+			if err := write(fset, changed); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+//write saves, lists or prints the files in the order they were parsed
+func write(fset *token.FileSet, files []*ast.File) error {
+	for _, file := range files {
+		path := fset.Position(file.Package).Filename
+		if wd, err := os.Getwd(); err == nil {
+			if rel, err := filepath.Rel(wd, path); err == nil && !strings.HasPrefix(rel, "..") {
+				path = rel
+			}
+		}
+		var buf bytes.Buffer
+		if err := format.Node(&buf, fset, file); err != nil {
+			return err
+		}
+		switch {
+		case *list:
+			fmt.Println(path)
+		case *dryRun:
+			fmt.Printf("// %v\n%s", path, buf.Bytes())
+		default:
+			info, err := os.Stat(path)
+			if err != nil {
+				return err
+			}
+			if err := os.WriteFile(path, buf.Bytes(), info.Mode()); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+//fixPackage renames the old names in files, the files of the package path,
+//returning the package as checked after the renames and the files that
+//changed. The package is checked again after each round of renames, since a
+//renamed selector gives a type to the expression it is part of, e.g. to
+//r.Data[0].Segment_opts in r.Data[0].Segment_opts.Match.
+func fixPackage(fset *token.FileSet, path string, files []*ast.File, imp types.Importer) (*types.Package, []*ast.File) {
+	changed := make(map[*ast.File]bool)
+	for {
+		info := &types.Info{
+			Types:      make(map[ast.Expr]types.TypeAndValue),
+			Uses:       make(map[*ast.Ident]types.Object),
+			Selections: make(map[*ast.SelectorExpr]*types.Selection),
+		}
+		//the code doesn't compile until it is fixed, so errors are expected
+		conf := types.Config{Importer: imp, FakeImportC: true, Error: func(error) {}}
+		pkg, _ := conf.Check(path, fset, files, info)
+		renamed := false
+		for _, file := range files {
+			if fix(file, info) {
+				changed[file] = true
+				renamed = true
+			}
+		}
+		if !renamed {
+			var fixed []*ast.File
+			for _, file := range files {
+				if changed[file] {
+					fixed = append(fixed, file)
+				}
+			}
+			return pkg, fixed
+		}
+	}
+}
+
+//fix renames the old field and type names in file, which has been
+//type-checked with info, reporting whether anything changed
+func fix(file *ast.File, info *types.Info) bool {
+	if !importsMailchimp(file) {
+		return false
+	}
+	changed := false
+	ast.Inspect(file, func(n ast.Node) bool {
+		switch n := n.(type) {
+		case *ast.SelectorExpr:
+			if name, ok := renames[n.Sel.Name]; ok && selects(n, name, info) {
+				n.Sel.Name = name
+				changed = true
+			}
+		case *ast.CompositeLit:
+			fields := mailchimpFields(info.TypeOf(n))
+			for _, elt := range n.Elts {
+				if kv, ok := elt.(*ast.KeyValueExpr); ok {
+					if id, ok := kv.Key.(*ast.Ident); ok {
+						if name, ok := renames[id.Name]; ok && fields[name] && !fields[id.Name] {
+							id.Name = name
+							changed = true
+						}
+					}
+				}
+			}
+		}
+		return true
+	})
+	return changed
+}
+
+//selects reports whether sel, whose name is an old one, should select name
+//instead: a name declared in the mailchimp package if sel is qualified by
+//it, or otherwise a field or method declared in the package when sel's own
+//name doesn't resolve
+func selects(sel *ast.SelectorExpr, name string, info *types.Info) bool {
+	if id, ok := sel.X.(*ast.Ident); ok {
+		if pkg, ok := info.Uses[id].(*types.PkgName); ok {
+			return pkg.Imported().Path() == importPath && pkg.Imported().Scope().Lookup(name) != nil
+		}
+	}
+	if _, ok := info.Selections[sel]; ok {
+		return false
+	}
+	t := info.TypeOf(sel.X)
+	if t == nil {
+		return false
+	}
+	obj, _, _ := types.LookupFieldOrMethod(t, true, nil, name)
+	return declaredInMailchimp(obj)
+}
+
+//mailchimpFields returns the names of the fields of the struct t, or of the
+//struct it points to, that are declared in the mailchimp package
+func mailchimpFields(t types.Type) map[string]bool {
+	if t == nil {
+		return nil
+	}
+	if p, ok := t.Underlying().(*types.Pointer); ok {
+		t = p.Elem()
+	}
+	s, ok := t.Underlying().(*types.Struct)
+	if !ok {
+		return nil
+	}
+	fields := make(map[string]bool)
+	for i := 0; i < s.NumFields(); i++ {
+		if declaredInMailchimp(s.Field(i)) {
+			fields[s.Field(i).Name()] = true
+		}
+	}
+	return fields
+}
+
+func declaredInMailchimp(obj types.Object) bool {
+	return obj != nil && obj.Pkg() != nil && obj.Pkg().Path() == importPath
+}
+
+func importsMailchimp(files ...*ast.File) bool {
+	for _, file := range files {
+		for _, spec := range file.Imports {
+			if path, err := strconv.Unquote(spec.Path.Value); err == nil && path == importPath {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+//renames maps the old names to the current ones
+var renames = map[string]string{
+	"Absplit_group":                   "AbsplitGroup",
+	"Abuse_reports":                   "AbuseReports",
+	"Abuse_reports_a":                 "AbuseReportsA",
+	"Abuse_reports_b":                 "AbuseReportsB",
+	"Add_count":                       "AddCount",
+	"Affiliate_link":                  "AffiliateLink",
+	"Analytics_tag":                   "AnalyticsTag",
+	"Archive_url":                     "ArchiveURL",
+	"Auto_fb_post":                    "AutoFBPost",
+	"Auto_footer":                     "AutoFooter",
+	"Auto_tweet":                      "AutoTweet",
+	"Bounces_a":                       "BouncesA",
+	"Bounces_b":                       "BouncesB",
+	"Campaign_id":                     "CampaignID",
+	"CampaignsResultDataSegment_opts": "CampaignsResultDataSegmentOpts",
+	"Clicks_pct":                      "ClicksPct",
+	"Content_type":                    "ContentType",
+	"Create_time":                     "CreateTime",
+	"Credits_used":                    "CreditsUsed",
+	"Date_created":                    "DateCreated",
+	"Display_order":                   "DisplayOrder",
+	"Ecomm_conversions":               "EcommConversions",
+	"Email_address":                   "EmailAddress",
+	"Emails_left":                     "EmailsLeft",
+	"Emails_pct":                      "EmailsPct",
+	"Emails_sent":                     "EmailsSent",
+	"Error_count":                     "ErrorCount",
+	"Facebook_likes":                  "FacebookLikes",
+	"First_click":                     "FirstClick",
+	"First_payment":                   "FirstPayment",
+	"First_retweet":                   "FirstRetweet",
+	"First_tweet":                     "FirstTweet",
+	"Folder_id":                       "FolderID",
+	"Form_fields":                     "FormFields",
+	"Forwards_a":                      "ForwardsA",
+	"Forwards_b":                      "ForwardsB",
+	"Forwards_opens":                  "ForwardsOpens",
+	"Forwards_opens_a":                "ForwardsOpensA",
+	"Forwards_opens_b":                "ForwardsOpensB",
+	"From_email":                      "FromEmail",
+	"From_name":                       "FromName",
+	"Goal_conversions":                "GoalConversions",
+	"Goal_value":                      "GoalValue",
+	"Hard_bounce":                     "HardBounce",
+	"Hard_bounces":                    "HardBounces",
+	"Has_activated":                   "HasActivated",
+	"Html":                            "HTML",
+	"Html_clicks":                     "HTMLClicks",
+	"Id":                              "ID",
+	"Inline_css":                      "InlineCSS",
+	"Is_approved":                     "IsApproved",
+	"Is_retweet":                      "IsRetweet",
+	"Is_trial":                        "IsTrial",
+	"Last_click":                      "LastClick",
+	"Last_login":                      "LastLogin",
+	"Last_open":                       "LastOpen",
+	"Last_payment":                    "LastPayment",
+	"Last_retweet":                    "LastRetweet",
+	"Last_tweet":                      "LastTweet",
+	"Line_num":                        "LineNum",
+	"ListBatchUnsubsribeResponse":     "ListBatchUnsubscribeResponse",
+	"List_id":                         "ListID",
+	"List_name":                       "ListName",
+	"Member_rating":                   "MemberRating",
+	"Member_since":                    "MemberSince",
+	"New_visits":                      "NewVisits",
+	"Notify_email":                    "NotifyEmail",
+	"Notify_on":                       "NotifyOn",
+	"Open_count":                      "OpenCount",
+	"Opens_pct":                       "OpensPct",
+	"Order_date":                      "OrderDate",
+	"Order_desc":                      "OrderDesc",
+	"Order_id":                        "OrderID",
+	"Order_total":                     "OrderTotal",
+	"Other_adds":                      "OtherAdds",
+	"Other_removes":                   "OtherRemoves",
+	"Pending_monthly":                 "PendingMonthly",
+	"Plan_high":                       "PlanHigh",
+	"Plan_low":                        "PlanLow",
+	"Plan_start_date":                 "PlanStartDate",
+	"Plan_type":                       "PlanType",
+	"Product_category_id":             "ProductCategoryID",
+	"Product_category_name":           "ProductCategoryName",
+	"Product_id":                      "ProductID",
+	"Product_name":                    "ProductName",
+	"Product_sku":                     "ProductSKU",
+	"Reason_text":                     "ReasonText",
+	"Recipient_clicks":                "RecipientClicks",
+	"Recipient_likes":                 "RecipientLikes",
+	"Recipients_click":                "RecipientsClick",
+	"Recipients_click_a":              "RecipientsClickA",
+	"Recipients_click_b":              "RecipientsClickB",
+	"Referrals_this_month":            "ReferralsThisMonth",
+	"Region_detail":                   "RegionDetail",
+	"Screen_name":                     "ScreenName",
+	"Secure_url":                      "SecureURL",
+	"Segment_opts":                    "SegmentOpts",
+	"Segment_text":                    "SegmentText",
+	"Send_time":                       "SendTime",
+	"Ship_total":                      "ShipTotal",
+	"Signup_date":                     "SignupDate",
+	"Soft_bounce":                     "SoftBounce",
+	"Soft_bounces":                    "SoftBounces",
+	"Status_id":                       "StatusID",
+	"Store_id":                        "StoreID",
+	"Store_name":                      "StoreName",
+	"Success_count":                   "SuccessCount",
+	"Syntax_errors":                   "SyntaxErrors",
+	"Tax_total":                       "TaxTotal",
+	"Template_id":                     "TemplateID",
+	"Text_clicks":                     "TextClicks",
+	"This_month":                      "ThisMonth",
+	"Time_on_site":                    "TimeOnSite",
+	"Times_logged_in":                 "TimesLoggedIn",
+	"Timewarp_schedule":               "TimewarpSchedule",
+	"To_name":                         "ToName",
+	"Total_earned":                    "TotalEarned",
+	"Total_sent":                      "TotalSent",
+	"Type_opts":                       "TypeOpts",
+	"Tz_group":                        "TzGroup",
+	"Unique_clicks":                   "UniqueClicks",
+	"Unique_id":                       "UniqueID",
+	"Unique_likes":                    "UniqueLikes",
+	"Unique_opens":                    "UniqueOpens",
+	"Unsubs_a":                        "UnsubsA",
+	"Unsubs_b":                        "UnsubsB",
+	"Unsubs_pct":                      "UnsubsPct",
+	"Update_count":                    "UpdateCount",
+	"Update_time":                     "UpdateTime",
+	"Url":                             "URL",
+	"User_id":                         "UserID",
+	"Users_who_clicked":               "UsersWhoClicked",
+	"Web_id":                          "WebID",
+}
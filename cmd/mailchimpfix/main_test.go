@@ -0,0 +1,153 @@
+package main
+
+import (
+	"bytes"
+	"go/ast"
+	"go/format"
+	"go/importer"
+	"go/parser"
+	"go/token"
+	"go/types"
+	"os"
+	"strings"
+	"sync"
+	"testing"
+)
+
+const otherSrc = `package other
+
+type Thing struct {
+	Id     int
+	Web_id int
+}
+`
+
+var (
+	fset     = token.NewFileSet()
+	imp      *packageImporter
+	impOnce  sync.Once
+	impError error
+)
+
+//testImporter imports the mailchimp package checked from the source in the
+//repository, example.com/other checked from otherSrc and the standard library
+//from its export data
+func testImporter(t *testing.T) types.Importer {
+	impOnce.Do(func() {
+		imp = &packageImporter{checked: make(map[string]*types.Package), exports: importer.Default()}
+		pkgs, err := parser.ParseDir(fset, "../..", func(fi os.FileInfo) bool {
+			return !strings.HasSuffix(fi.Name(), "_test.go")
+		}, 0)
+		if err != nil {
+			impError = err
+			return
+		}
+		var files []*ast.File
+		for _, file := range pkgs["mailchimp"].Files {
+			files = append(files, file)
+		}
+		conf := types.Config{Importer: imp}
+		if imp.checked[importPath], err = conf.Check(importPath, fset, files, nil); err != nil {
+			impError = err
+			return
+		}
+		other, err := parser.ParseFile(fset, "other.go", otherSrc, 0)
+		if err != nil {
+			impError = err
+			return
+		}
+		imp.checked["example.com/other"], impError = conf.Check("example.com/other", fset, []*ast.File{other}, nil)
+	})
+	if impError != nil {
+		t.Fatal(impError)
+	}
+	return imp
+}
+
+func rewrite(t *testing.T, src string) (string, bool) {
+	imp := testImporter(t)
+	file, err := parser.ParseFile(fset, "x.go", src, parser.ParseComments)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, changed := fixPackage(fset, "example.com/x", []*ast.File{file}, imp)
+	var buf bytes.Buffer
+	if err := format.Node(&buf, fset, file); err != nil {
+		t.Fatal(err)
+	}
+	return buf.String(), len(changed) > 0
+}
+
+func TestFix(t *testing.T) {
+	src := `package x
+
+import "github.com/areed/mailchimp"
+
+func f(r *mailchimp.CampaignsResult) mailchimp.CampaignsResultDataSegment_opts {
+	_ = mailchimp.CampaignsResultData{Web_id: 1, Title: "a"}
+	_ = []*mailchimp.CampaignsResultData{{Id: "b"}}
+	_ = r.Data[0].Segment_opts.Conditions
+	return r.Data[0].Segment_opts
+}
+`
+	want := `package x
+
+import "github.com/areed/mailchimp"
+
+func f(r *mailchimp.CampaignsResult) mailchimp.CampaignsResultDataSegmentOpts {
+	_ = mailchimp.CampaignsResultData{WebID: 1, Title: "a"}
+	_ = []*mailchimp.CampaignsResultData{{ID: "b"}}
+	_ = r.Data[0].SegmentOpts.Conditions
+	return r.Data[0].SegmentOpts
+}
+`
+	got, changed := rewrite(t, src)
+	if !changed || got != want {
+		t.Errorf("fix: expected changed source\n%v\nbut got (changed %v)\n%v", want, changed, got)
+	}
+}
+
+func TestFixOtherTypes(t *testing.T) {
+	src := `package x
+
+import (
+	"example.com/other"
+	"github.com/areed/mailchimp"
+)
+
+type row struct {
+	Id  int
+	Url string
+}
+
+type campaign struct {
+	mailchimp.CampaignsResultData
+	Html string
+}
+
+func f(c campaign, o other.Thing) int {
+	r := row{Id: 1, Url: "a"}
+	_ = other.Thing{Id: r.Id, Web_id: o.Web_id}
+	_ = c.Html + r.Url + c.Archive_url
+	return o.Id + r.Id
+}
+`
+	want := strings.Replace(src, "c.Archive_url", "c.ArchiveURL", 1)
+	got, changed := rewrite(t, src)
+	if !changed || got != want {
+		t.Errorf("fix: expected only the field of the mailchimp type to be renamed\n%v\nbut got (changed %v)\n%v", want, changed, got)
+	}
+}
+
+func TestFixOtherPackages(t *testing.T) {
+	src := `package x
+
+import "example.com/other"
+
+var _ = other.Thing.Web_id
+`
+	got, changed := rewrite(t, src)
+	if changed || got != src {
+		t.Errorf("fix: expected files not importing mailchimp to be left alone but got\n%v", got)
+	}
+}
@@ -0,0 +1,525 @@
+//Command mailchimpgen generates the methods, params structs and result types
+//of Mailchimp 1.3 routines from a description of them, along with tests
+//decoding each routine's fixture strictly. It is run by go generate in the
+//mailchimp package:
+//
+//	mailchimpgen -o routines_gen.go -test routines_gen_test.go routines.json
+//
+//The description is a JSON object whose routines member lists the routines:
+//
+//	{
+//		"name": "listGrowthHistory",
+//		"doc": "accesses the growth history by month for a given list",
+//		"read": true,
+//		"params": [{"name": "id", "type": "string", "required": true}],
+//		"result": {"items": {"go": "ListGrowthHistoryElement", "fields": [...]}},
+//		"fixture": true
+//	}
+//
+//A routine is read if it only reads data, so that it is retried by default,
+//and paged if page_limit is set, the largest page Mailchimp accepts, which
+//also generates a Pager over the items of its data field unless
+//custom_pager leaves the Pager to be written by hand. A fixture is read
+//from json/<name>.json; only its first JSON value is used. The method is
+//named after the routine unless go names it.
+//
+//A routine with chunk_size sends its batch parameter chunk_size members at a
+//time when it has more, adding the result of each chunk to the first with
+//the combine method of the result type, written by hand.
+//
+//A routine with parameters has a params struct, whose Validate checks the
+//required parameters and then, if validate is set, returns the result of
+//its validate method, written by hand for checks such as those of the
+//fields of a struct parameter.
+//
+//Types in results are the primitives string, int, float, bool, time and any,
+//decoded as string, FlexInt, FlexFloat, FlexBool, ChimpTime and interface{},
+//or objects with fields, items for arrays or values for maps with string
+//keys. Structs are named after their parent, e.g. the items of the data
+//field of ListAbuseReportsResponse are ListAbuseReportsResponseDataItem,
+//unless go names them. An object with only go refers to a type declared
+//elsewhere, e.g. {"go": "MemberInfo"}. Named results are returned as
+//pointers. Parameter types are int, float, bool and time, which is a
+//ChimpTime in the params struct, left out of the request when zero, and may
+//be a time.Time in the parameters map, or Go types such as []string. Fields and parameters are
+//given idiomatic Go names unless go names them.
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/areed/mailchimp/cmd/internal/gofmt"
+)
+
+var (
+	output     = flag.String("o", "routines_gen.go", "the file to write the routines to")
+	testOutput = flag.String("test", "routines_gen_test.go", "the file to write the fixture tests to")
+)
+
+//description is the file describing the routines
+type description struct {
+	Routines []routine `json:"routines"`
+}
+
+type routine struct {
+	Name string `json:"name"`
+	//Go names the method if it isn't named after the routine
+	Go        string `json:"go"`
+	Doc       string `json:"doc"`
+	Read      bool   `json:"read"`
+	PageLimit int    `json:"page_limit"`
+	//CustomPager leaves the Pager of a paged routine to be written by hand
+	CustomPager bool `json:"custom_pager"`
+	//ChunkSize is the most members of the batch parameter sent in one request
+	ChunkSize int `json:"chunk_size"`
+	//Validate makes the params struct's Validate call its validate method,
+	//written by hand, once the required parameters are checked
+	Validate bool    `json:"validate"`
+	Params   []param `json:"params"`
+	Result   *schema `json:"result"`
+	Fixture  bool    `json:"fixture"`
+}
+
+type param struct {
+	Name     string `json:"name"`
+	Go       string `json:"go"`
+	Type     string `json:"type"`
+	Required bool   `json:"required"`
+	//Default true makes a bool parameter a *bool, so that false is sent
+	Default bool   `json:"default"`
+	Doc     string `json:"doc"`
+}
+
+//schema is the type of a result or of one of its fields. It is unmarshaled
+//from either a primitive's name or an object.
+type schema struct {
+	Primitive string
+	Go        string  `json:"go"`
+	Doc       string  `json:"doc"`
+	Fields    []field `json:"fields"`
+	Items     *schema `json:"items"`
+	Values    *schema `json:"values"`
+	//Name is the Go type of a primitive or a reference, or the name of the
+	//struct generated for an object
+	Name string `json:"-"`
+}
+
+type field struct {
+	Name string  `json:"name"`
+	Go   string  `json:"go"`
+	Doc  string  `json:"doc"`
+	Type *schema `json:"type"`
+}
+
+func (s *schema) UnmarshalJSON(b []byte) error {
+	if len(b) > 0 && b[0] == '"' {
+		return json.Unmarshal(b, &s.Primitive)
+	}
+	type plain schema
+	return json.Unmarshal(b, (*plain)(s))
+}
+
+var primitives = map[string]string{
+	"string": "string",
+	"int":    "FlexInt",
+	"float":  "FlexFloat",
+	"bool":   "FlexBool",
+	"time":   "ChimpTime",
+	"any":    "interface{}",
+}
+
+//results of these primitive types are parsed by the functions of the same name
+var scalarResults = map[string]struct{ goType, parse string }{
+	"bool":   {"bool", "parseBoolean"},
+	"int":    {"int", "parseInt"},
+	"string": {"string", "parseString"},
+}
+
+func main() {
+	flag.Usage = func() {
+		fmt.Fprintf(os.Stderr, "usage: mailchimpgen [-o file] [-test file] description.json\n")
+		flag.PrintDefaults()
+	}
+	flag.Parse()
+	if flag.NArg() != 1 {
+		flag.Usage()
+		os.Exit(2)
+	}
+	if err := run(flag.Arg(0)); err != nil {
+		fmt.Fprintln(os.Stderr, "mailchimpgen:", err)
+		os.Exit(1)
+	}
+}
+
+func run(path string) error {
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return err
+	}
+	var d description
+	if err := json.Unmarshal(b, &d); err != nil {
+		return fmt.Errorf("%v: %v", path, err)
+	}
+	src, test, err := generate(&d)
+	if err != nil {
+		return err
+	}
+	if err := os.WriteFile(*output, src, 0644); err != nil {
+		return err
+	}
+	return os.WriteFile(*testOutput, test, 0644)
+}
+
+//generate returns the source of the routines and of their tests
+func generate(d *description) ([]byte, []byte, error) {
+	sort.Slice(d.Routines, func(i, j int) bool { return d.Routines[i].Name < d.Routines[j].Name })
+	declared = make(map[string]bool)
+	var src, test bytes.Buffer
+	fmt.Fprintf(&src, "// Code generated by mailchimpgen; DO NOT EDIT.\n\npackage mailchimp\n\nimport \"context\"\n")
+	fmt.Fprintf(&test, "// Code generated by mailchimpgen; DO NOT EDIT.\n\npackage mailchimp\n")
+	pageLimits := make(map[string]int)
+	var read []string
+	fixtures := false
+	for i := range d.Routines {
+		r := &d.Routines[i]
+		if r.Name == "" || r.Result == nil {
+			return nil, nil, fmt.Errorf("routine %q needs a name and a result", r.Name)
+		}
+		if err := r.generate(&src); err != nil {
+			return nil, nil, fmt.Errorf("%v: %v", r.Name, err)
+		}
+		if r.Fixture {
+			if !fixtures {
+				fmt.Fprintf(&test, "\nimport (\n\"context\"\n\"testing\"\n)\n")
+				fixtures = true
+			}
+			r.generateTest(&test)
+		}
+		if r.Read {
+			read = append(read, r.Name)
+		}
+		if r.PageLimit > 0 {
+			pageLimits[r.Name] = r.PageLimit
+		}
+	}
+	fmt.Fprintf(&src, "\nfunc init() {\n")
+	for _, name := range read {
+		fmt.Fprintf(&src, "readMethods[%q] = true\n", name)
+	}
+	for _, r := range d.Routines {
+		if limit, ok := pageLimits[r.Name]; ok {
+			fmt.Fprintf(&src, "pageLimits[%q] = %d\n", r.Name, limit)
+		}
+	}
+	fmt.Fprintf(&src, "}\n")
+
+	formatted, err := gofmt.Source(src.Bytes())
+	if err != nil {
+		return nil, nil, fmt.Errorf("formatting routines: %v\n%s", err, src.Bytes())
+	}
+	formattedTest, err := gofmt.Source(test.Bytes())
+	if err != nil {
+		return nil, nil, fmt.Errorf("formatting tests: %v\n%s", err, test.Bytes())
+	}
+	return formatted, formattedTest, nil
+}
+
+func (r *routine) method() string {
+	if r.Go != "" {
+		return r.Go
+	}
+	return strings.ToUpper(r.Name[:1]) + r.Name[1:]
+}
+
+func (r *routine) link() string {
+	return "http://apidocs.mailchimp.com/api/1.3/" + strings.ToLower(r.Name) + ".func.php"
+}
+
+func (r *routine) generate(w *bytes.Buffer) error {
+	method := r.method()
+	var types bytes.Buffer
+	resultType, parse := "", ""
+	if scalar, ok := scalarResults[r.Result.Primitive]; ok {
+		resultType, parse = scalar.goType, scalar.parse
+	} else {
+		if r.Result.Go == "" && r.Result.Fields != nil {
+			r.Result.Go = method + "Result"
+		}
+		t, err := goType(&types, r.Result, method+"Result")
+		if err != nil {
+			return err
+		}
+		resultType = t
+		if r.Result.Go != "" {
+			resultType = "*" + t
+		}
+	}
+	w.Write(types.Bytes())
+
+	doc := method + " " + r.Doc
+	if r.ChunkSize > 0 {
+		doc += fmt.Sprintf(". Batches of more than %d members are sent %d at a time and their results combined.", r.ChunkSize, r.ChunkSize)
+	}
+	comment(w, doc)
+	fmt.Fprintf(w, "//%v\n", r.link())
+	fmt.Fprintf(w, "func (a *API) %v(parameters map[string]interface{}) (%v, error) {\n", method, resultType)
+	fmt.Fprintf(w, "return a.%vContext(context.Background(), parameters)\n}\n\n", method)
+	//time parameters given as time.Time are sent in ChimpTimeFormat
+	parameters := "parameters"
+	var times []string
+	for _, p := range r.Params {
+		if p.Type == "time" {
+			times = append(times, fmt.Sprintf("%q", p.Name))
+		}
+	}
+	if len(times) > 0 {
+		parameters = fmt.Sprintf("timeParameters(parameters, %v)", strings.Join(times, ", "))
+	}
+	switch {
+	case r.ChunkSize > 0:
+		if !strings.HasPrefix(resultType, "*") || len(times) > 0 {
+			return fmt.Errorf("a chunked routine needs a named result and no time parameters")
+		}
+		comment(w, method+"Context is "+method+" with a context. If the request for one chunk of a large batch fails, the combined results of the chunks before it are returned with the error, so that only the rest of the batch need be sent again.")
+		fmt.Fprintf(w, "func (a *API) %vContext(ctx context.Context, parameters map[string]interface{}) (%v, error) {\n", method, resultType)
+		fmt.Fprintf(w, "return parseChunks[%v](ctx, a, %q, parameters, %d)\n}\n", resultType[1:], r.Name, r.ChunkSize)
+	case parse != "":
+		fmt.Fprintf(w, "func (a *API) %vContext(ctx context.Context, parameters map[string]interface{}) (%v, error) {\n", method, resultType)
+		fmt.Fprintf(w, "return %v(ctx, a, %q, %v)\n}\n", parse, r.Name, parameters)
+	case strings.HasPrefix(resultType, "*"):
+		fmt.Fprintf(w, "func (a *API) %vContext(ctx context.Context, parameters map[string]interface{}) (retVal %v, err error) {\n", method, resultType)
+		fmt.Fprintf(w, "retVal = new(%v)\n", resultType[1:])
+		fmt.Fprintf(w, "err = parseJson(ctx, a, %q, %v, retVal)\nreturn\n}\n", r.Name, parameters)
+	default:
+		fmt.Fprintf(w, "func (a *API) %vContext(ctx context.Context, parameters map[string]interface{}) (retVal %v, err error) {\n", method, resultType)
+		fmt.Fprintf(w, "err = parseJson(ctx, a, %q, %v, &retVal)\nreturn\n}\n", r.Name, parameters)
+	}
+
+	if len(r.Params) > 0 {
+		if err := r.generateParams(w, resultType); err != nil {
+			return err
+		}
+	}
+	if r.PageLimit > 0 && !r.CustomPager {
+		return r.generatePager(w)
+	}
+	return nil
+}
+
+func (r *routine) generateParams(w *bytes.Buffer, resultType string) error {
+	method := r.method()
+	fmt.Fprintf(w, "\n//%vParams are the parameters of %v\n//%v\n", method, method, r.link())
+	fmt.Fprintf(w, "type %vParams struct {\n", method)
+	var required []string
+	for _, p := range r.Params {
+		name := p.Go
+		if name == "" {
+			name = goName(p.Name)
+		}
+		t := p.Type
+		switch p.Type {
+		case "int":
+		case "float":
+			t = "float64"
+		case "bool":
+			if p.Default {
+				t = "*bool"
+			}
+		case "time":
+			t = "ChimpTime"
+		case "":
+			return fmt.Errorf("parameter %v has no type", p.Name)
+		}
+		if p.Doc != "" {
+			comment(w, p.Doc)
+		}
+		fmt.Fprintf(w, "%v %v `json:\"%v,omitempty\"`\n", name, t, p.Name)
+		if p.Required {
+			switch {
+			case t == "string":
+				required = append(required, fmt.Sprintf("case p.%v == \"\":\nreturn ParamError{%q, %q}\n", name, r.Name, p.Name))
+			case t == "ChimpTime":
+				required = append(required, fmt.Sprintf("case p.%v.IsZero():\nreturn ParamError{%q, %q}\n", name, r.Name, p.Name))
+			case t == "int" || t == "float64":
+				required = append(required, fmt.Sprintf("case p.%v == 0:\nreturn ParamError{%q, %q}\n", name, r.Name, p.Name))
+			case strings.HasPrefix(t, "[]") || strings.HasPrefix(t, "map["):
+				required = append(required, fmt.Sprintf("case len(p.%v) == 0:\nreturn ParamError{%q, %q}\n", name, r.Name, p.Name))
+			case strings.HasPrefix(t, "*") || t == "interface{}":
+				required = append(required, fmt.Sprintf("case p.%v == nil:\nreturn ParamError{%q, %q}\n", name, r.Name, p.Name))
+			default:
+				return fmt.Errorf("required parameter %v has type %v, which cannot be checked", p.Name, t)
+			}
+		}
+	}
+	fmt.Fprintf(w, "}\n\nfunc (p *%vParams) Validate() error {\n", method)
+	if len(required) > 0 {
+		fmt.Fprintf(w, "switch {\n%v}\n", strings.Join(required, ""))
+	}
+	if r.Validate {
+		fmt.Fprintf(w, "return p.validate()\n}\n\n")
+	} else {
+		fmt.Fprintf(w, "return nil\n}\n\n")
+	}
+	fmt.Fprintf(w, "func (a *API) %vWithParams(ctx context.Context, params *%vParams) (%v, error) {\n", method, method, resultType)
+	fmt.Fprintf(w, "parameters, err := ToParameters(params)\nif err != nil {\nreturn %v, err\n}\n", zero(resultType))
+	fmt.Fprintf(w, "return a.%vContext(ctx, parameters)\n}\n", method)
+	return nil
+}
+
+func (r *routine) generatePager(w *bytes.Buffer) error {
+	var data *field
+	for i := range r.Result.Fields {
+		if r.Result.Fields[i].Name == "data" {
+			data = &r.Result.Fields[i]
+		}
+	}
+	if data == nil || data.Type.Items == nil {
+		return fmt.Errorf("a paged routine needs a result with a data array")
+	}
+	item := data.Type.Items.Name
+	method := r.method()
+	fmt.Fprintf(w, "\n//%vPager walks every page of %v\n", method, method)
+	fmt.Fprintf(w, "func (a *API) %vPager(ctx context.Context, parameters map[string]interface{}) *Pager[%v] {\n", method, item)
+	fmt.Fprintf(w, "return newPager(ctx, %q, parameters, fetchPage[%v](a, %q))\n}\n", r.Name, item, r.Name)
+	return nil
+}
+
+func (r *routine) generateTest(w *bytes.Buffer) {
+	method := r.method()
+	fmt.Fprintf(w, "\nfunc Test%vFixture(t *testing.T) {\n", method)
+	fmt.Fprintf(w, "api := fixtureAPI(t, %q)\n", r.Name)
+	fmt.Fprintf(w, "if _, err := api.%vContext(context.Background(), nil); err != nil {\n", method)
+	fmt.Fprintf(w, "t.Error(%q, err)\n}\n}\n", method+":")
+}
+
+//declared are the structs generated so far
+var declared map[string]bool
+
+//goType returns the Go type of s, writing the declarations of any structs it
+//needs to w. name is the name of s's struct if it is one and go doesn't name it.
+func goType(w *bytes.Buffer, s *schema, name string) (string, error) {
+	switch {
+	case s.Primitive != "":
+		t, ok := primitives[s.Primitive]
+		if !ok {
+			return "", fmt.Errorf("unknown type %v", s.Primitive)
+		}
+		s.Name = t
+		return t, nil
+	case s.Items != nil:
+		items, err := goType(w, s.Items, name+"Item")
+		if err != nil {
+			return "", err
+		}
+		if s.Go != "" {
+			comment(w, s.Go+" "+s.Doc)
+			fmt.Fprintf(w, "type %v []%v\n\n", s.Go, items)
+			return s.Go, nil
+		}
+		return "[]" + items, nil
+	case s.Values != nil:
+		values, err := goType(w, s.Values, name+"Item")
+		if err != nil {
+			return "", err
+		}
+		return "map[string]" + values, nil
+	case s.Fields != nil:
+		if s.Go != "" {
+			name = s.Go
+		}
+		s.Name = name
+		//a struct named by go may be shared by several fields
+		if declared[name] {
+			return name, nil
+		}
+		declared[name] = true
+		var body bytes.Buffer
+		for _, f := range s.Fields {
+			if f.Type == nil {
+				return "", fmt.Errorf("field %v of %v has no type", f.Name, name)
+			}
+			fieldName := f.Go
+			if fieldName == "" {
+				fieldName = goName(f.Name)
+			}
+			t, err := goType(w, f.Type, name+fieldName)
+			if err != nil {
+				return "", err
+			}
+			if f.Doc != "" {
+				comment(&body, f.Doc)
+			}
+			fmt.Fprintf(&body, "%v %v `json:\"%v\"`\n", fieldName, t, f.Name)
+		}
+		if s.Doc != "" {
+			comment(w, name+" "+s.Doc)
+		}
+		fmt.Fprintf(w, "type %v struct {\n%v}\n\n", name, body.String())
+		return name, nil
+	case s.Go != "":
+		//a reference to a struct declared elsewhere, or to any Go type
+		s.Name = s.Go
+		return s.Go, nil
+	}
+	return "", fmt.Errorf("%v has no type", name)
+}
+
+func zero(t string) string {
+	switch t {
+	case "bool":
+		return "false"
+	case "int":
+		return "0"
+	case "string":
+		return `""`
+	}
+	return "nil"
+}
+
+//initialisms are the words of Mailchimp's names that Go writes in capitals
+var initialisms = map[string]bool{
+	"aim": true, "api": true, "cid": true, "css": true, "euid": true, "fb": true,
+	"gmt": true, "html": true, "id": true, "ip": true, "leid": true, "rss": true,
+	"sku": true, "url": true, "utc": true,
+}
+
+//goName converts a Mailchimp name, e.g. merge_vars or EMAIL_TYPE, to a Go
+//name, e.g. MergeVars or EmailType
+func goName(name string) string {
+	var b strings.Builder
+	for _, word := range strings.Split(name, "_") {
+		lower := strings.ToLower(word)
+		switch {
+		case word == "":
+		case initialisms[lower]:
+			b.WriteString(strings.ToUpper(lower))
+		case word == strings.ToUpper(word):
+			b.WriteString(strings.ToUpper(lower[:1]) + lower[1:])
+		default:
+			b.WriteString(strings.ToUpper(word[:1]) + word[1:])
+		}
+	}
+	return b.String()
+}
+
+//comment writes text as a comment wrapped to about 80 columns
+func comment(w *bytes.Buffer, text string) {
+	line := "//"
+	for _, word := range strings.Fields(text) {
+		if len(line) > 2 && len(line)+1+len(word) > 80 {
+			fmt.Fprintln(w, line)
+			line = "//"
+		}
+		if len(line) > 2 {
+			line += " "
+		}
+		line += word
+	}
+	fmt.Fprintln(w, line)
+}
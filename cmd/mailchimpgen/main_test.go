@@ -0,0 +1,116 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"os"
+	"testing"
+)
+
+func TestGoName(t *testing.T) {
+	for name, want := range map[string]string{
+		"merge_vars":   "MergeVars",
+		"EMAIL_TYPE":   "EmailType",
+		"list_id":      "ListID",
+		"campaign_id":  "CampaignID",
+		"ip_opt":       "IPOpt",
+		"euid":         "EUID",
+		"double_optin": "DoubleOptin",
+		"emailCount":   "EmailCount",
+		"FNAME":        "Fname",
+	} {
+		if got := goName(name); got != want {
+			t.Errorf("goName(%q): expected %q but got %q", name, want, got)
+		}
+	}
+}
+
+//TestGenerated fails if routines.json has been changed without running go
+//generate
+func TestGenerated(t *testing.T) {
+	b, err := os.ReadFile("../../routines.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+	var d description
+	if err := json.Unmarshal(b, &d); err != nil {
+		t.Fatal(err)
+	}
+	src, test, err := generate(&d)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for file, want := range map[string][]byte{"../../routines_gen.go": src, "../../routines_gen_test.go": test} {
+		got, err := os.ReadFile(file)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !bytes.Equal(got, want) {
+			t.Error(file, "is out of date; run go generate")
+		}
+	}
+}
+
+func TestGenerateErrors(t *testing.T) {
+	for _, src := range []string{
+		`{"routines": [{"name": "ping"}]}`,
+		`{"routines": [{"name": "ping", "result": {"primitive": "complex"}}]}`,
+	} {
+		var d description
+		if err := json.Unmarshal([]byte(src), &d); err != nil {
+			t.Fatal(err)
+		}
+		if _, _, err := generate(&d); err == nil {
+			t.Error("expected an error generating", src)
+		}
+	}
+}
+
+func TestGenerateOptions(t *testing.T) {
+	d := description{Routines: []routine{{
+		Name:     "campaignSchedule",
+		Go:       "CampaignLater",
+		Validate: true,
+		Params: []param{
+			{Name: "cid", Type: "string", Required: true},
+			{Name: "schedule_time", Type: "time", Required: true},
+		},
+		Result: &schema{Primitive: "bool"},
+	}, {
+		Name:        "campaignEmailStatsAIMAll",
+		PageLimit:   1000,
+		CustomPager: true,
+		Params:      []param{{Name: "cid", Type: "string", Required: true}},
+		Result:      &schema{Fields: []field{{Name: "total", Type: &schema{Primitive: "int"}}}},
+	}, {
+		Name:      "listStaticSegmentMembersAdd",
+		ChunkSize: 5000,
+		Params:    []param{{Name: "batch", Type: "[]string", Required: true}},
+		Result:    &schema{Go: "StaticSegmentMembersResponse"},
+	}, {
+		Name:   "ping",
+		Result: &schema{Primitive: "string"},
+	}}}
+	src, _, err := generate(&d)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, want := range []string{
+		"func (a *API) CampaignLater(",
+		`parseBoolean(ctx, a, "campaignSchedule", timeParameters(parameters, "schedule_time"))`,
+		"return p.validate()",
+		"ScheduleTime ChimpTime `json:\"schedule_time,omitempty\"`",
+		"case p.ScheduleTime.IsZero():",
+		`pageLimits["campaignEmailStatsAIMAll"] = 1000`,
+		`return parseChunks[StaticSegmentMembersResponse](ctx, a, "listStaticSegmentMembersAdd", parameters, 5000)`,
+	} {
+		if !bytes.Contains(src, []byte(want)) {
+			t.Errorf("expected the generated code to contain %s", want)
+		}
+	}
+	for _, unwanted := range []string{"CampaignEmailStatsAIMAllPager", "PingParams"} {
+		if bytes.Contains(src, []byte(unwanted)) {
+			t.Errorf("expected the generated code not to contain %s", unwanted)
+		}
+	}
+}
@@ -0,0 +1,23 @@
+package main
+
+import (
+	"bytes"
+	"os"
+	"testing"
+)
+
+//TestGenerated fails if the service interfaces have been changed without
+//running go generate
+func TestGenerated(t *testing.T) {
+	want, err := mock("../..")
+	if err != nil {
+		t.Fatal(err)
+	}
+	got, err := os.ReadFile("../../mailchimpmock/mock.go")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(got, want) {
+		t.Error("mailchimpmock/mock.go is out of date; run go generate")
+	}
+}
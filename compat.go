@@ -0,0 +1,16 @@
+package mailchimp
+
+//The result types were given idiomatic names and explicit json tags, e.g.
+//CampaignsResultData.Web_id is now WebID, and fields holding dates are now
+//ChimpTime instead of string. Code written against the old names can be
+//updated with
+//
+//	go run github.com/areed/mailchimp/cmd/mailchimpfix ./...
+//
+//The old names of renamed types are kept below as aliases.
+
+//Deprecated: use CampaignsResultDataSegmentOpts
+type CampaignsResultDataSegment_opts = CampaignsResultDataSegmentOpts
+
+//Deprecated: use ListBatchUnsubscribeResponse
+type ListBatchUnsubsribeResponse = ListBatchUnsubscribeResponse
@@ -0,0 +1,104 @@
+package mailchimp
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/areed/mailchimp/mailchimptest"
+)
+
+//These tests are most useful with go test -race.
+
+func TestConcurrentSharedParameters(t *testing.T) {
+	chimp, server := fakeAPI(t)
+	server.Handle("campaignSchedule", func(p mailchimptest.Params) (interface{}, error) {
+		return true, nil
+	})
+	server.Handle("campaignMembers", func(p mailchimptest.Params) (interface{}, error) {
+		return map[string]interface{}{"total": 1, "data": []map[string]string{{"email": "a@example.com"}}}, nil
+	})
+	when := time.Date(2030, 1, 2, 3, 4, 5, 0, time.UTC)
+	parameters := map[string]interface{}{
+		"cid":           "a1b2c3",
+		"filters":       map[string]interface{}{"list_id": fakeList},
+		"schedule_time": when,
+	}
+
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(3)
+		go func() {
+			defer wg.Done()
+			if _, err := chimp.Campaigns(parameters); err != nil {
+				t.Error("Campaigns:", err)
+			}
+		}()
+		go func() {
+			defer wg.Done()
+			if _, err := chimp.CampaignSchedule(parameters); err != nil {
+				t.Error("CampaignSchedule:", err)
+			}
+		}()
+		go func() {
+			defer wg.Done()
+			_, err := chimp.CampaignMembersStream(context.Background(), parameters, func(CampaignMembersResultDataItem) error { return nil })
+			if err != nil {
+				t.Error("CampaignMembersStream:", err)
+			}
+		}()
+	}
+	wg.Wait()
+
+	if len(parameters) != 3 {
+		t.Error("expected the shared parameters to be left as they were but got", parameters)
+	}
+	if _, ok := parameters["apikey"]; ok {
+		t.Error("expected the API key not to be added to the shared parameters")
+	}
+	if parameters["schedule_time"] != when {
+		t.Error("CampaignSchedule: expected schedule_time to be left a time.Time but got", parameters["schedule_time"])
+	}
+	for _, call := range server.Calls() {
+		if call.Method == "campaignSchedule" && call.Params.String("schedule_time") != "2030-01-02 03:04:05" {
+			t.Error("CampaignSchedule: expected the time to be sent in Mailchimp's format but got", call.Params["schedule_time"])
+		}
+		if _, ok := call.Params["schedule_time_b"]; ok {
+			t.Error("CampaignSchedule: expected schedule_time_b not to be sent when it is not set")
+		}
+	}
+}
+
+func TestConcurrentPagers(t *testing.T) {
+	chimp, _ := fakeAPI(t)
+	for i := 0; i < 5; i++ {
+		if _, err := chimp.CampaignCreate(map[string]interface{}{
+			"type":    "regular",
+			"options": map[string]interface{}{"list_id": fakeList, "subject": "s", "from_email": "shop@example.com", "from_name": "Shop"},
+		}); err != nil {
+			t.Fatal("CampaignCreate:", err)
+		}
+	}
+	parameters := map[string]interface{}{"limit": 2}
+	var wg sync.WaitGroup
+	for i := 0; i < 4; i++ {
+		wg.Add(1)
+		go func(workers int) {
+			defer wg.Done()
+			pager := chimp.CampaignsPager(context.Background(), parameters).Parallel(workers)
+			defer pager.Close()
+			n := 0
+			for pager.Next() {
+				n++
+			}
+			if n != 5 || pager.Err() != nil {
+				t.Error("CampaignsPager: expected 5 campaigns but got", n, pager.Err())
+			}
+		}(i + 1)
+	}
+	wg.Wait()
+	if len(parameters) != 1 {
+		t.Error("CampaignsPager: expected the shared parameters to be left as they were but got", parameters)
+	}
+}
@@ -0,0 +1,219 @@
+package mailchimp
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+//APIError is returned by every method when Mailchimp answers a request with
+//an error. Err is usually a ChimpError; use errors.Is with the sentinel
+//errors below to test for a family of error codes, e.g.
+//
+//	if errors.Is(err, mailchimp.ErrEmailNotSubscribed) {
+type APIError struct {
+	Method     string
+	StatusCode int
+	Body       []byte
+	Err        error
+}
+
+func (e APIError) Error() string {
+	return fmt.Sprintf("%v: %v", e.Method, e.Err)
+}
+
+func (e APIError) Unwrap() error {
+	return e.Err
+}
+
+//DecodeError is returned when a response cannot be decoded into the result
+//type of a method, e.g. because Mailchimp changed the type of a field.
+//Field is the path of the offending field, such as "data.0.web_id", when it
+//is known.
+type DecodeError struct {
+	Method string
+	Field  string
+	Body   []byte
+	Err    error
+}
+
+func (e DecodeError) Error() string {
+	if e.Field == "" {
+		return fmt.Sprintf("%v: decoding response: %v", e.Method, e.Err)
+	}
+	return fmt.Sprintf("%v: decoding response field %v: %v", e.Method, e.Field, e.Err)
+}
+
+func (e DecodeError) Unwrap() error {
+	return e.Err
+}
+
+func newDecodeError(method string, body []byte, retVal interface{}, err error) DecodeError {
+	field := ""
+	var typeErr *json.UnmarshalTypeError
+	var timeErr TimeError
+	if errors.As(err, &typeErr) {
+		field = typeErr.Field
+	} else if msg := err.Error(); strings.HasPrefix(msg, unknownFieldPrefix) {
+		//the json package has no error type for unknown fields
+		field, _ = strconv.Unquote(strings.TrimPrefix(msg, unknownFieldPrefix))
+	}
+	if field == "" && retVal != nil && (typeErr != nil || errors.As(err, &timeErr)) {
+		//the json package adds no context to errors from UnmarshalJSON
+		//methods, e.g. those of the Flex types and ChimpTime, so find the
+		//field here
+		var v interface{}
+		if json.Unmarshal(body, &v) == nil {
+			field = strings.Join(locateField(v, reflect.TypeOf(retVal), nil), ".")
+		}
+	}
+	return DecodeError{method, field, body, err}
+}
+
+var unmarshalerType = reflect.TypeOf((*json.Unmarshaler)(nil)).Elem()
+
+//locateField returns the path to the first value in v, decoded from JSON
+//into interface{}, that cannot be unmarshaled into the corresponding part
+//of t, or nil if there is none
+func locateField(v interface{}, t reflect.Type, path []string) []string {
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	if reflect.PtrTo(t).Implements(unmarshalerType) {
+		b, _ := json.Marshal(v)
+		if json.Unmarshal(b, reflect.New(t).Interface()) != nil {
+			return path
+		}
+		return nil
+	}
+	switch v := v.(type) {
+	case map[string]interface{}:
+		keys := make([]string, 0, len(v))
+		for k := range v {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+		for _, k := range keys {
+			var elem reflect.Type
+			switch t.Kind() {
+			case reflect.Map:
+				elem = t.Elem()
+			case reflect.Struct:
+				if f, ok := fieldByJSONName(t, k); ok {
+					elem = f.Type
+				}
+			}
+			if elem == nil {
+				continue
+			}
+			if p := locateField(v[k], elem, append(path, k)); p != nil {
+				return p
+			}
+		}
+	case []interface{}:
+		if t.Kind() != reflect.Slice && t.Kind() != reflect.Array {
+			return nil
+		}
+		for i := range v {
+			if p := locateField(v[i], t.Elem(), append(path, strconv.Itoa(i))); p != nil {
+				return p
+			}
+		}
+	}
+	return nil
+}
+
+//fieldByJSONName finds the field of struct type t that the json package
+//would decode the key name into
+func fieldByJSONName(t reflect.Type, name string) (reflect.StructField, bool) {
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		if tag := strings.Split(f.Tag.Get("json"), ",")[0]; tag != "" {
+			if tag == name {
+				return f, true
+			}
+		} else if strings.EqualFold(f.Name, name) {
+			return f, true
+		}
+	}
+	return reflect.StructField{}, false
+}
+
+const unknownFieldPrefix = "json: unknown field "
+
+//ParamError is returned by the WithParams methods, without making a request,
+//when a required parameter is missing. It matches ErrValidation.
+type ParamError struct {
+	Method string
+	Param  string
+}
+
+func (e ParamError) Error() string {
+	return fmt.Sprintf("%v: missing required parameter %v", e.Method, e.Param)
+}
+
+func (e ParamError) Is(target error) bool {
+	return target == ErrValidation
+}
+
+//ErrMalformedResponse is wrapped in an APIError when the response body is not JSON
+var ErrMalformedResponse = errors.New("mailchimp: malformed response")
+
+//Sentinel errors matching families of the error codes documented at
+//http://apidocs.mailchimp.com/api/1.3/exceptions.field.php
+var (
+	ErrServer               = errors.New("mailchimp: server error")
+	ErrTooManyConnections   = errors.New("mailchimp: too many connections")
+	ErrUnknownMethod        = errors.New("mailchimp: unknown method")
+	ErrAccount              = errors.New("mailchimp: account error")
+	ErrList                 = errors.New("mailchimp: list error")
+	ErrListNotFound         = errors.New("mailchimp: list not found")
+	ErrAlreadySubscribed    = errors.New("mailchimp: email already subscribed")
+	ErrEmailNotSubscribed   = errors.New("mailchimp: email not subscribed")
+	ErrCampaign             = errors.New("mailchimp: campaign error")
+	ErrCampaignNotFound     = errors.New("mailchimp: campaign not found")
+	ErrCampaignNotReady     = errors.New("mailchimp: campaign not ready")
+	ErrValidation           = errors.New("mailchimp: validation error")
+	ErrInvalidEmail         = errors.New("mailchimp: invalid email address")
+	ErrInvalidMergeField    = errors.New("mailchimp: invalid merge field")
+	ErrInvalidInterestGroup = errors.New("mailchimp: invalid interest group")
+)
+
+//codeRange is an inclusive range of ChimpError codes
+type codeRange struct {
+	from, to int
+}
+
+var errorCodes = map[error][]codeRange{
+	ErrServer:               {{-99, -90}, {-50, -50}},
+	ErrTooManyConnections:   {{-50, -50}},
+	ErrUnknownMethod:        {{-32601, -32601}},
+	ErrAccount:              {{100, 199}},
+	ErrInvalidKey:           {{104, 104}, {106, 106}},
+	ErrList:                 {{200, 299}},
+	ErrListNotFound:         {{200, 200}},
+	ErrAlreadySubscribed:    {{214, 214}, {230, 230}},
+	ErrEmailNotSubscribed:   {{215, 215}, {231, 233}},
+	ErrCampaign:             {{300, 399}},
+	ErrCampaignNotFound:     {{300, 300}},
+	ErrCampaignNotReady:     {{301, 301}, {313, 314}, {319, 319}},
+	ErrValidation:           {{-32602, -32602}, {210, 213}, {220, 222}, {250, 254}, {270, 271}, {310, 312}, {315, 318}, {330, 330}, {350, 355}, {500, 599}},
+	ErrInvalidEmail:         {{502, 502}},
+	ErrInvalidMergeField:    {{250, 254}},
+	ErrInvalidInterestGroup: {{210, 210}, {270, 271}},
+}
+
+//Is reports whether the error's code belongs to the family of target, one
+//of the sentinel errors in this package
+func (e ChimpError) Is(target error) bool {
+	for _, r := range errorCodes[target] {
+		if e.Code >= r.from && e.Code <= r.to {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,124 @@
+package mailchimp
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestChimpErrorIs(t *testing.T) {
+	cases := []struct {
+		code    int
+		matches []error
+		not     []error
+	}{
+		{104, []error{ErrInvalidKey, ErrAccount}, []error{ErrList, ErrServer}},
+		{200, []error{ErrListNotFound, ErrList}, []error{ErrCampaign, ErrEmailNotSubscribed}},
+		{215, []error{ErrEmailNotSubscribed, ErrList}, []error{ErrListNotFound, ErrAlreadySubscribed}},
+		{230, []error{ErrAlreadySubscribed, ErrList}, []error{ErrEmailNotSubscribed}},
+		{301, []error{ErrCampaignNotReady, ErrCampaign}, []error{ErrCampaignNotFound}},
+		{502, []error{ErrInvalidEmail, ErrValidation}, []error{ErrList}},
+		{254, []error{ErrInvalidMergeField, ErrValidation, ErrList}, nil},
+		{-50, []error{ErrTooManyConnections, ErrServer}, []error{ErrValidation}},
+		{-32601, []error{ErrUnknownMethod}, []error{ErrServer}},
+	}
+	for _, c := range cases {
+		var err error = APIError{"test", 200, nil, ChimpError{"message", c.code}}
+		for _, target := range c.matches {
+			if !errors.Is(err, target) {
+				t.Errorf("code %d: expected a match with %v", c.code, target)
+			}
+		}
+		for _, target := range c.not {
+			if errors.Is(err, target) {
+				t.Errorf("code %d: unexpected match with %v", c.code, target)
+			}
+		}
+	}
+}
+
+func TestAPIError(t *testing.T) {
+	responses := map[string]struct {
+		status int
+		body   string
+	}{
+		"listAbuseReports": {200, `{"error":"Invalid Mailchimp List ID: abc","code":200}`},
+		"campaignDelete":   {503, `<html>Service Unavailable</html>`},
+		"campaignPause":    {200, `{"error":`},
+	}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		response := responses[r.URL.Query().Get("method")]
+		w.WriteHeader(response.status)
+		w.Write([]byte(response.body))
+	}))
+	defer server.Close()
+	api, err := NewClient("abc123-us1", WithBaseURL(server.URL+"/1.3/"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = api.ListAbuseReports(map[string]interface{}{"id": "abc"})
+	var apiErr APIError
+	if !errors.As(err, &apiErr) || !errors.Is(err, ErrListNotFound) {
+		t.Fatal("expected an APIError for a missing list but got", err)
+	}
+	if apiErr.Method != "listAbuseReports" || apiErr.StatusCode != 200 || string(apiErr.Body) != responses["listAbuseReports"].body {
+		t.Error("unexpected APIError", apiErr)
+	}
+	var chimpErr ChimpError
+	if !errors.As(err, &chimpErr) || chimpErr.Code != 200 {
+		t.Error("expected the APIError to wrap a ChimpError but got", apiErr.Err)
+	}
+
+	_, err = api.CampaignDelete(nil)
+	if !errors.As(err, &apiErr) || apiErr.StatusCode != 503 || errors.As(err, &chimpErr) {
+		t.Error("CampaignDelete: expected an APIError with status 503 but got", err)
+	}
+
+	_, err = api.CampaignPause(nil)
+	if !errors.Is(err, ErrMalformedResponse) {
+		t.Error("CampaignPause: expected ErrMalformedResponse but got", err)
+	}
+}
+
+func TestDecodeError(t *testing.T) {
+	body := `{"total":1,"data":[{"id":"abc","web_id":"not a number"}],"surprise":true}`
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(body))
+	}))
+	defer server.Close()
+	api, err := NewClient("abc123-us1", WithBaseURL(server.URL+"/1.3/"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	result, err := api.Campaigns(nil)
+	var decodeErr DecodeError
+	if !errors.As(err, &decodeErr) {
+		t.Fatal("Campaigns: expected a DecodeError but got", err)
+	}
+	if decodeErr.Method != "campaigns" || decodeErr.Field != "data.0.web_id" || string(decodeErr.Body) != body {
+		t.Error("Campaigns: unexpected DecodeError", decodeErr)
+	}
+	if result.Data[0].ID != "abc" {
+		t.Error("Campaigns: the fields that could be decoded should still be set")
+	}
+
+	body = `{"total":0,"data":[],"surprise":true}`
+	if _, err = api.Campaigns(nil); err != nil {
+		t.Error("Campaigns: unknown fields should be ignored by default but got", err)
+	}
+	strict, err := NewClient("abc123-us1", WithBaseURL(server.URL+"/1.3/"), WithStrictDecoding())
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = strict.Campaigns(nil)
+	if !errors.As(err, &decodeErr) || decodeErr.Field != "surprise" {
+		t.Error("Campaigns: expected a DecodeError for the unknown field but got", err)
+	}
+
+	body = `"12x"`
+	if _, err = api.FolderAdd(nil); !errors.As(err, &decodeErr) || decodeErr.Method != "folderAdd" {
+		t.Error("FolderAdd: expected a DecodeError but got", err)
+	}
+}
@@ -0,0 +1,590 @@
+package mailchimp
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/areed/mailchimp/mailchimptest"
+)
+
+const fakeList = "f6b4ea2a1c"
+
+//fakeAPI returns an API talking to a mailchimptest.Server that has one empty
+//list, fakeList
+func fakeAPI(t *testing.T) (*API, *mailchimptest.Server) {
+	server := mailchimptest.NewServer()
+	t.Cleanup(server.Close)
+	server.AddList(fakeList, "Customers")
+	api, err := NewClient(mailchimptest.Key, WithBaseURL(server.BaseURL()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return api, server
+}
+
+func TestFakeCampaignFlow(t *testing.T) {
+	chimp, server := fakeAPI(t)
+	server.AddMember(fakeList, mailchimptest.Member{Email: "one@example.com"})
+	server.AddMember(fakeList, mailchimptest.Member{Email: "two@example.com", Status: "unsubscribed"})
+	ctx := context.Background()
+
+	cid, err := chimp.CampaignCreateWithParams(ctx, &CampaignCreateParams{
+		Type: "regular",
+		Options: CampaignOptions{
+			ListID:    fakeList,
+			Subject:   "Spring sale",
+			FromEmail: "shop@example.com",
+			FromName:  "Shop",
+		},
+		Content: CampaignCreateContent{HTML: "<p>Sale</p>", Text: "Sale"},
+	})
+	if err != nil {
+		t.Fatal("CampaignCreate:", err)
+	}
+	if _, err := chimp.CampaignUpdate(map[string]interface{}{"cid": cid, "name": "title", "value": "Spring"}); err != nil {
+		t.Error("CampaignUpdate:", err)
+	}
+	campaigns, err := chimp.Campaigns(map[string]interface{}{"filters": map[string]interface{}{"list_id": fakeList}})
+	if err != nil {
+		t.Fatal("Campaigns:", err)
+	}
+	verify(t, "Campaigns total", 1, int(campaigns.Total))
+	verify(t, "Campaigns title", "Spring", campaigns.Data[0].Title)
+	verify(t, "Campaigns status", "save", campaigns.Data[0].Status)
+	if campaigns.Data[0].CreateTime.IsZero() || !campaigns.Data[0].SendTime.IsZero() {
+		t.Error("Campaigns: expected a create time and no send time but got", campaigns.Data[0].CreateTime, campaigns.Data[0].SendTime)
+	}
+	content, err := chimp.CampaignContent(map[string]interface{}{"cid": cid})
+	if err != nil || content.HTML != "<p>Sale</p>" {
+		t.Error("CampaignContent: expected the campaign's html but got", content, err)
+	}
+
+	if _, err := chimp.CampaignSchedule(map[string]interface{}{"cid": cid, "schedule_time": time.Now().Add(time.Hour)}); err != nil {
+		t.Error("CampaignSchedule:", err)
+	}
+	if c, _ := server.Campaign(cid); c.Status != "schedule" {
+		t.Error("CampaignSchedule: expected status schedule but got", c.Status)
+	}
+	if _, err := chimp.CampaignUnschedule(map[string]interface{}{"cid": cid}); err != nil {
+		t.Error("CampaignUnschedule:", err)
+	}
+	if _, err := chimp.CampaignPause(map[string]interface{}{"cid": cid}); !errors.Is(err, ErrCampaignNotReady) {
+		t.Error("CampaignPause: expected ErrCampaignNotReady for a regular campaign but got", err)
+	}
+	replica, err := chimp.CampaignReplicate(map[string]interface{}{"cid": cid})
+	if err != nil || replica == cid {
+		t.Error("CampaignReplicate: expected a new campaign id but got", replica, err)
+	}
+
+	if _, err := chimp.CampaignMembers(map[string]interface{}{"cid": cid}); !errors.Is(err, ErrCampaignNotReady) {
+		t.Error("CampaignMembers: expected ErrCampaignNotReady before sending but got", err)
+	}
+	if _, err := chimp.CampaignSendNow(map[string]interface{}{"cid": cid}); err != nil {
+		t.Error("CampaignSendNow:", err)
+	}
+	members, err := chimp.CampaignMembers(map[string]interface{}{"cid": cid})
+	if err != nil {
+		t.Fatal("CampaignMembers:", err)
+	}
+	verify(t, "CampaignMembers total", 1, int(members.Total))
+	verify(t, "CampaignMembers", "one@example.com", members.Data[0].Email)
+
+	if _, err := chimp.CampaignDelete(map[string]interface{}{"cid": cid}); err != nil {
+		t.Error("CampaignDelete:", err)
+	}
+	if _, err := chimp.CampaignContent(map[string]interface{}{"cid": cid}); !errors.Is(err, ErrCampaignNotFound) {
+		t.Error("CampaignContent: expected ErrCampaignNotFound after deleting but got", err)
+	}
+}
+
+func TestFakeCampaignsPager(t *testing.T) {
+	chimp, _ := fakeAPI(t)
+	for _, subject := range []string{"one", "two", "three"} {
+		if _, err := chimp.CampaignCreate(map[string]interface{}{
+			"type":    "regular",
+			"options": map[string]interface{}{"list_id": fakeList, "subject": subject, "from_email": "shop@example.com", "from_name": "Shop"},
+		}); err != nil {
+			t.Fatal("CampaignCreate:", err)
+		}
+	}
+	pager := chimp.CampaignsPager(context.Background(), map[string]interface{}{"limit": 2})
+	var subjects []string
+	for pager.Next() {
+		subjects = append(subjects, pager.Item().Subject)
+	}
+	if err := pager.Err(); err != nil {
+		t.Fatal("CampaignsPager:", err)
+	}
+	if len(subjects) != 3 || subjects[0] != "three" || subjects[2] != "one" {
+		t.Error("CampaignsPager: expected the campaigns newest first but got", subjects)
+	}
+}
+
+func TestFakeFolders(t *testing.T) {
+	chimp, _ := fakeAPI(t)
+	fid, err := chimp.FolderAdd(map[string]interface{}{"name": "Newsletters"})
+	if err != nil {
+		t.Fatal("FolderAdd:", err)
+	}
+	if _, err := chimp.FolderUpdate(map[string]interface{}{"fid": fid, "name": "News"}); err != nil {
+		t.Error("FolderUpdate:", err)
+	}
+	folders, err := chimp.Folders(nil)
+	if err != nil || len(folders) != 1 || folders[0].Name != "News" || int(folders[0].FolderID) != fid {
+		t.Error("Folders: expected the updated folder but got", folders, err)
+	}
+	if _, err := chimp.FolderDel(map[string]interface{}{"fid": fid}); err != nil {
+		t.Error("FolderDel:", err)
+	}
+	if _, err := chimp.FolderDel(map[string]interface{}{"fid": fid}); !errors.Is(err, ErrValidation) {
+		t.Error("FolderDel: expected ErrValidation for a deleted folder but got", err)
+	}
+}
+
+func TestFakeListFlow(t *testing.T) {
+	chimp, server := fakeAPI(t)
+	ctx := context.Background()
+	subscribed, err := chimp.ListBatchSubscribeWithParams(ctx, &ListBatchSubscribeParams{
+		ID: fakeList,
+		Batch: []map[string]interface{}{
+			{"EMAIL": "one@example.com", "FNAME": "One"},
+			{"EMAIL": "two@example.com"},
+			{"EMAIL": "not an address"},
+		},
+		DoubleOptin: Bool(false),
+	})
+	if err != nil {
+		t.Fatal("ListBatchSubscribe:", err)
+	}
+	verify(t, "ListBatchSubscribe add_count", 2, int(subscribed.AddCount))
+	verify(t, "ListBatchSubscribe error_count", 1, int(subscribed.ErrorCount))
+	if len(subscribed.Errors) != 1 || subscribed.Errors[0].Code != 502 {
+		t.Error("ListBatchSubscribe: expected an invalid email error but got", subscribed.Errors)
+	}
+	if member, _ := server.Member(fakeList, "one@example.com"); member.Merges["FNAME"] != "One" {
+		t.Error("ListBatchSubscribe: expected the FNAME merge field to be stored but got", member.Merges)
+	}
+
+	lists, err := chimp.ListsForEmail(map[string]interface{}{"email_address": "two@example.com"})
+	if err != nil || len(lists) != 1 || lists[0] != fakeList {
+		t.Error("ListsForEmail: expected", fakeList, "but got", lists, err)
+	}
+	unsubscribed, err := chimp.ListBatchUnsubscribe(map[string]interface{}{"id": fakeList, "emails": []string{"two@example.com", "three@example.com"}})
+	if err != nil {
+		t.Fatal("ListBatchUnsubscribe:", err)
+	}
+	verify(t, "ListBatchUnsubscribe success_count", 1, int(unsubscribed.SuccessCount))
+	verify(t, "ListBatchUnsubscribe error_count", 1, int(unsubscribed.ErrorCount))
+	if _, err := chimp.ListsForEmail(map[string]interface{}{"email_address": "two@example.com"}); !errors.Is(err, ErrEmailNotSubscribed) {
+		t.Error("ListsForEmail: expected ErrEmailNotSubscribed after unsubscribing but got", err)
+	}
+	if _, err := chimp.ListBatchSubscribe(map[string]interface{}{"id": "nosuchlist"}); !errors.Is(err, ErrListNotFound) {
+		t.Error("ListBatchSubscribe: expected ErrListNotFound but got", err)
+	}
+}
+
+func TestFakeMemberFlow(t *testing.T) {
+	chimp, server := fakeAPI(t)
+	ctx := context.Background()
+	grouping, err := chimp.ListInterestGroupingAdd(map[string]interface{}{"id": fakeList, "name": "Interests", "type": "checkboxes", "groups": []string{"Coffee", "Tea, Green"}})
+	if err != nil {
+		t.Fatal("ListInterestGroupingAdd:", err)
+	}
+	subscribed, err := chimp.ListSubscribeWithParams(ctx, &ListSubscribeParams{
+		ID:           fakeList,
+		EmailAddress: "jane@example.com",
+		MergeVars: &MergeVars{
+			Fields:    map[string]interface{}{"FNAME": "Jane"},
+			Groupings: []Grouping{{Name: "Interests", Groups: []string{"Tea, Green"}}},
+		},
+		DoubleOptin: Bool(false),
+	})
+	if err != nil || !subscribed {
+		t.Fatal("ListSubscribe:", subscribed, err)
+	}
+	_, err = chimp.ListSubscribeWithParams(ctx, &ListSubscribeParams{ID: fakeList, EmailAddress: "jane@example.com"})
+	if !errors.Is(err, ErrAlreadySubscribed) {
+		t.Error("ListSubscribe: expected ErrAlreadySubscribed subscribing twice but got", err)
+	}
+	_, err = chimp.ListSubscribeWithParams(ctx, &ListSubscribeParams{
+		ID:           fakeList,
+		EmailAddress: "joe@example.com",
+		MergeVars:    &MergeVars{Groupings: []Grouping{{ID: grouping, Groups: []string{"Cocoa"}}}},
+	})
+	if !errors.Is(err, ErrInvalidInterestGroup) {
+		t.Error("ListSubscribe: expected ErrInvalidInterestGroup but got", err)
+	}
+
+	_, err = chimp.ListUpdateMemberWithParams(ctx, &ListUpdateMemberParams{
+		ID:               fakeList,
+		EmailAddress:     "jane@example.com",
+		MergeVars:        &MergeVars{Fields: map[string]interface{}{"LNAME": "Doe"}, Groupings: []Grouping{{ID: grouping, Groups: []string{"Coffee"}}}, NewEmail: "jane.doe@example.com"},
+		ReplaceInterests: Bool(false),
+	})
+	if err != nil {
+		t.Fatal("ListUpdateMember:", err)
+	}
+	if _, ok := server.Member(fakeList, "jane@example.com"); ok {
+		t.Error("ListUpdateMember: expected NewEmail to change the member's address")
+	}
+
+	info, err := chimp.ListMemberInfoWithParams(ctx, &ListMemberInfoParams{ID: fakeList, EmailAddress: []string{"jane.doe@example.com", "nobody@example.com"}})
+	if err != nil {
+		t.Fatal("ListMemberInfo:", err)
+	}
+	verify(t, "ListMemberInfo success", 1, int(info.Success))
+	verify(t, "ListMemberInfo errors", 1, int(info.Errors))
+	if len(info.Data) != 2 {
+		t.Fatal("ListMemberInfo: expected 2 members but got", info.Data)
+	}
+	jane := info.Data[0]
+	verify(t, "ListMemberInfo status", "subscribed", jane.Status)
+	verify(t, "ListMemberInfo FNAME", "Jane", jane.Merges.String("FNAME"))
+	verify(t, "ListMemberInfo LNAME", "Doe", jane.Merges.String("LNAME"))
+	verify(t, "ListMemberInfo EMAIL", "jane.doe@example.com", jane.Merges.String("EMAIL"))
+	if len(jane.Merges.Groupings) != 1 || !reflect.DeepEqual(jane.Merges.Groupings[0].Groups, []string{"Tea, Green", "Coffee"}) {
+		t.Error("ListMemberInfo: expected the member to be in both groups but got", jane.Merges.Groupings)
+	}
+	if info.Data[1].Error == "" {
+		t.Error("ListMemberInfo: expected an error for a member not in the list")
+	}
+	activity, err := chimp.ListMemberActivityWithParams(ctx, &ListMemberActivityParams{ID: fakeList, EmailAddress: []string{jane.ID}})
+	if err != nil || len(activity.Data) != 1 {
+		t.Error("ListMemberActivity: expected the activity of one member by unique id but got", activity, err)
+	}
+
+	if _, err := chimp.ListUnsubscribeWithParams(ctx, &ListUnsubscribeParams{ID: fakeList, EmailAddress: "jane.doe@example.com"}); err != nil {
+		t.Fatal("ListUnsubscribe:", err)
+	}
+	if member, _ := server.Member(fakeList, "jane.doe@example.com"); member.Status != "unsubscribed" {
+		t.Error("ListUnsubscribe: expected the member to be unsubscribed but got", member.Status)
+	}
+	_, err = chimp.ListUnsubscribeWithParams(ctx, &ListUnsubscribeParams{ID: fakeList, EmailAddress: "jane.doe@example.com"})
+	if !errors.Is(err, ErrEmailNotSubscribed) {
+		t.Error("ListUnsubscribe: expected ErrEmailNotSubscribed unsubscribing twice but got", err)
+	}
+}
+
+func TestFakeListMembers(t *testing.T) {
+	chimp, server := fakeAPI(t)
+	ctx := context.Background()
+	now := time.Date(2013, 3, 1, 12, 0, 0, 0, time.UTC)
+	server.Now = func() time.Time { return now }
+	for _, email := range []string{"a@example.com", "b@example.com", "c@example.com"} {
+		server.AddMember(fakeList, mailchimptest.Member{Email: email, Merges: map[string]interface{}{"FNAME": "Jan"}})
+	}
+	synced := now.Add(time.Hour)
+	now = synced.Add(time.Minute)
+	if _, err := chimp.ListUpdateMember(map[string]interface{}{"id": fakeList, "email_address": "b@example.com", "merge_vars": map[string]interface{}{"FNAME": "Bea"}}); err != nil {
+		t.Fatal("ListUpdateMember:", err)
+	}
+	if _, err := chimp.ListUnsubscribe(map[string]interface{}{"id": fakeList, "email_address": "c@example.com"}); err != nil {
+		t.Fatal("ListUnsubscribe:", err)
+	}
+
+	pager := chimp.ListMembersPager(ctx, map[string]interface{}{"id": fakeList, "limit": 1})
+	var emails []string
+	for pager.Next() {
+		emails = append(emails, pager.Item().Email)
+	}
+	if pager.Err() != nil || !reflect.DeepEqual(emails, []string{"a@example.com", "b@example.com"}) {
+		t.Error("ListMembersPager: expected the two subscribed members but got", emails, pager.Err())
+	}
+
+	changed := chimp.ListMembersChangedSince(ctx, fakeList, "", synced)
+	emails = nil
+	for changed.Next() {
+		emails = append(emails, changed.Item().Email)
+		verify(t, "ListMembersChangedSince timestamp", now, changed.Item().Timestamp.Time)
+	}
+	if changed.Err() != nil || !reflect.DeepEqual(emails, []string{"b@example.com"}) {
+		t.Error("ListMembersChangedSince: expected the updated member but got", emails, changed.Err())
+	}
+	unsubscribed := chimp.ListMembersChangedSince(ctx, fakeList, "unsubscribed", synced)
+	if !unsubscribed.Next() || unsubscribed.Item().Email != "c@example.com" || unsubscribed.Item().Reason == "" {
+		t.Error("ListMembersChangedSince: expected the unsubscribed member with a reason but got", unsubscribed.Item(), unsubscribed.Err())
+	}
+
+	found, err := chimp.SearchMembersWithParams(ctx, &SearchMembersParams{Query: "b@example.com", ID: fakeList})
+	if err != nil {
+		t.Fatal("SearchMembers:", err)
+	}
+	if found.ExactMatches.Total != 1 || found.ExactMatches.Members[0].Merges.String("FNAME") != "Bea" {
+		t.Error("SearchMembers: expected one exact match but got", found.ExactMatches)
+	}
+	search := chimp.SearchMembersPager(ctx, map[string]interface{}{"query": "jan"})
+	n := 0
+	for search.Next() {
+		n++
+	}
+	if n != 2 || search.Err() != nil {
+		t.Error("SearchMembersPager: expected the two members named Jan but got", n, search.Err())
+	}
+	if call := server.Calls()[len(server.Calls())-1]; call.Params["offset"] != 0.0 || call.Params["start"] != nil {
+		t.Error("SearchMembersPager: expected pages to be chosen by offset but got", call.Params)
+	}
+}
+
+func TestFakeMergeVars(t *testing.T) {
+	chimp, server := fakeAPI(t)
+	ctx := context.Background()
+	server.AddMember(fakeList, mailchimptest.Member{Email: "a@example.com", Merges: map[string]interface{}{"FNAME": "Ann"}})
+	_, err := chimp.ListMergeVarAddWithParams(ctx, &ListMergeVarAddParams{
+		ID:      fakeList,
+		Tag:     "FAV_COLOR",
+		Name:    "Favourite Colour",
+		Options: &MergeVarOptions{FieldType: "dropdown", Choices: []string{"Red", "Blue"}, Required: Bool(true), DefaultValue: "Blue"},
+	})
+	if err != nil {
+		t.Fatal("ListMergeVarAdd:", err)
+	}
+	_, err = chimp.ListMergeVarAddWithParams(ctx, &ListMergeVarAddParams{ID: fakeList, Tag: "FAV_COLOR", Name: "Again"})
+	if !errors.Is(err, ErrValidation) {
+		t.Error("ListMergeVarAdd: expected a validation error adding a tag twice but got", err)
+	}
+	if _, err := chimp.ListMergeVarUpdateWithParams(ctx, &ListMergeVarUpdateParams{ID: fakeList, Tag: "FAV_COLOR", Options: &MergeVarOptions{Name: "Colour", Show: Bool(false)}}); err != nil {
+		t.Fatal("ListMergeVarUpdate:", err)
+	}
+	vars, err := chimp.ListMergeVarsWithParams(ctx, &ListMergeVarsParams{ID: fakeList})
+	if err != nil {
+		t.Fatal("ListMergeVars:", err)
+	}
+	verify(t, "ListMergeVars", 4, len(vars))
+	color := vars[len(vars)-1]
+	verify(t, "ListMergeVars name", "Colour", color.Name)
+	verify(t, "ListMergeVars req", FlexBool(true), color.Required)
+	verify(t, "ListMergeVars show", FlexBool(false), color.Show)
+	verify(t, "ListMergeVars default", "Blue", color.Default)
+	if !reflect.DeepEqual(color.Choices, []string{"Red", "Blue"}) {
+		t.Error("ListMergeVars: expected the choices to be kept but got", color.Choices)
+	}
+
+	if _, err := chimp.ListMergeVarSetValueWithParams(ctx, &ListMergeVarSetValueParams{ID: fakeList, Tag: "FAV_COLOR", Value: "Red"}); err != nil {
+		t.Fatal("ListMergeVarSetValue:", err)
+	}
+	if member, _ := server.Member(fakeList, "a@example.com"); member.Merges["FAV_COLOR"] != "Red" {
+		t.Error("ListMergeVarSetValue: expected every member's value to be set but got", member.Merges)
+	}
+	if _, err := chimp.ListMergeVarResetWithParams(ctx, &ListMergeVarResetParams{ID: fakeList, Tag: "FNAME"}); err != nil {
+		t.Fatal("ListMergeVarReset:", err)
+	}
+	if _, err := chimp.ListMergeVarDelWithParams(ctx, &ListMergeVarDelParams{ID: fakeList, Tag: "FAV_COLOR"}); err != nil {
+		t.Fatal("ListMergeVarDel:", err)
+	}
+	if member, _ := server.Member(fakeList, "a@example.com"); len(member.Merges) != 0 {
+		t.Error("ListMergeVarReset and ListMergeVarDel: expected the member's values to be cleared but got", member.Merges)
+	}
+	if _, err := chimp.ListMergeVarDel(map[string]interface{}{"id": fakeList, "tag": "EMAIL"}); err == nil {
+		t.Error("ListMergeVarDel: expected an error deleting the EMAIL field")
+	}
+}
+
+func TestFakeStaticSegments(t *testing.T) {
+	chimp, server := fakeAPI(t)
+	ctx := context.Background()
+	//the most members ListStaticSegmentMembersAdd sends in one request
+	const chunkSize = 5000
+	batch := make([]string, chunkSize+2)
+	for i := range batch {
+		batch[i] = fmt.Sprintf("member%d@example.com", i)
+		server.AddMember(fakeList, mailchimptest.Member{Email: batch[i]})
+	}
+	batch = append(batch, "nobody@example.com")
+	segID, err := chimp.ListStaticSegmentAddWithParams(ctx, &ListStaticSegmentAddParams{ID: fakeList, Name: "Early adopters"})
+	if err != nil {
+		t.Fatal("ListStaticSegmentAdd:", err)
+	}
+
+	added, err := chimp.ListStaticSegmentMembersAddWithParams(ctx, &ListStaticSegmentMembersAddParams{ID: fakeList, SegID: segID, Batch: batch})
+	if err != nil {
+		t.Fatal("ListStaticSegmentMembersAdd:", err)
+	}
+	verify(t, "ListStaticSegmentMembersAdd success", chunkSize+2, int(added.Success))
+	if len(added.Errors) != 1 || added.Errors[0].Email != "nobody@example.com" {
+		t.Error("ListStaticSegmentMembersAdd: expected an error for the unknown member but got", added.Errors)
+	}
+	if err := added.Err(); !errors.Is(err, ErrEmailNotSubscribed) {
+		t.Error("ListStaticSegmentMembersAdd: expected the errors to match ErrEmailNotSubscribed but got", err)
+	}
+	requests := 0
+	for _, call := range server.Calls() {
+		if call.Method == "listStaticSegmentMembersAdd" {
+			requests++
+		}
+	}
+	verify(t, "ListStaticSegmentMembersAdd requests", 2, requests)
+
+	removed, err := chimp.ListStaticSegmentMembersDelWithParams(ctx, &ListStaticSegmentMembersDelParams{ID: fakeList, SegID: segID, Batch: batch[:2]})
+	if err != nil || removed.Success != 2 || removed.Err() != nil {
+		t.Error("ListStaticSegmentMembersDel: expected two members to be removed but got", removed, err)
+	}
+	segments, err := chimp.ListStaticSegmentsWithParams(ctx, &ListStaticSegmentsParams{ID: fakeList})
+	if err != nil || len(segments) != 1 {
+		t.Fatal("ListStaticSegments: expected one segment but got", segments, err)
+	}
+	verify(t, "ListStaticSegments member_count", chunkSize, int(segments[0].MemberCount))
+	info, err := chimp.ListMemberInfo(map[string]interface{}{"id": fakeList, "email_address": []string{batch[2]}})
+	if err != nil || len(info.Data[0].StaticSegments) != 1 || int(info.Data[0].StaticSegments[0].ID) != segID {
+		t.Error("ListMemberInfo: expected the member's static segment but got", info, err)
+	}
+
+	if _, err := chimp.ListStaticSegmentResetWithParams(ctx, &ListStaticSegmentResetParams{ID: fakeList, SegID: segID}); err != nil {
+		t.Fatal("ListStaticSegmentReset:", err)
+	}
+	if segments, _ := chimp.ListStaticSegments(map[string]interface{}{"id": fakeList}); segments[0].MemberCount != 0 || segments[0].LastReset.IsZero() {
+		t.Error("ListStaticSegmentReset: expected the segment to be emptied but got", segments[0])
+	}
+	if _, err := chimp.ListStaticSegmentDelWithParams(ctx, &ListStaticSegmentDelParams{ID: fakeList, SegID: segID}); err != nil {
+		t.Fatal("ListStaticSegmentDel:", err)
+	}
+	if segments, _ := chimp.ListStaticSegments(map[string]interface{}{"id": fakeList}); len(segments) != 0 {
+		t.Error("ListStaticSegmentDel: expected the segment to be deleted but got", segments)
+	}
+}
+
+func TestFakeWebhooks(t *testing.T) {
+	chimp, _ := fakeAPI(t)
+	ctx := context.Background()
+	if _, err := chimp.ListWebhookAddWithParams(ctx, &ListWebhookAddParams{ID: fakeList, URL: "https://example.com/defaults"}); err != nil {
+		t.Fatal("ListWebhookAdd:", err)
+	}
+	hook := Webhook{
+		URL:     "https://example.com/mailchimp",
+		Actions: WebhookActions{Subscribe: true, Unsubscribe: true},
+		Sources: WebhookSources{User: true, Admin: true, API: true},
+	}
+	for i, expected := range []bool{true, false} {
+		if changed, err := chimp.EnsureWebhook(ctx, fakeList, hook); err != nil || changed != expected {
+			t.Fatalf("EnsureWebhook %d: expected changed to be %v but got %v, %v", i, expected, changed, err)
+		}
+	}
+	hook.Actions = AllWebhookActions()
+	if changed, err := chimp.EnsureWebhook(ctx, fakeList, hook); err != nil || !changed {
+		t.Fatal("EnsureWebhook: expected the webhook's actions to be updated but got", changed, err)
+	}
+
+	hooks, err := chimp.ListWebhooksWithParams(ctx, &ListWebhooksParams{ID: fakeList})
+	if err != nil {
+		t.Fatal("ListWebhooks:", err)
+	}
+	expected := []Webhook{
+		{URL: "https://example.com/defaults", Actions: AllWebhookActions(), Sources: WebhookSources{User: true, Admin: true}},
+		hook,
+	}
+	if !reflect.DeepEqual(hooks, expected) {
+		t.Errorf("ListWebhooks: expected %+v but got %+v", expected, hooks)
+	}
+
+	if _, err := chimp.ListWebhookAddWithParams(ctx, &ListWebhookAddParams{ID: fakeList, URL: "not a url"}); err == nil {
+		t.Error("ListWebhookAdd: expected an error for an invalid url")
+	}
+	if _, err := chimp.ListWebhookDelWithParams(ctx, &ListWebhookDelParams{ID: fakeList, URL: hook.URL}); err != nil {
+		t.Fatal("ListWebhookDel:", err)
+	}
+	if hooks, _ := chimp.ListWebhooks(map[string]interface{}{"id": fakeList}); len(hooks) != 1 {
+		t.Error("ListWebhookDel: expected one webhook to be left but got", hooks)
+	}
+}
+
+func TestFakeInterestGroupings(t *testing.T) {
+	chimp, _ := fakeAPI(t)
+	if _, err := chimp.ListInterestGroupings(map[string]interface{}{"id": fakeList}); !errors.Is(err, ErrList) {
+		t.Error("ListInterestGroupings: expected a list error before adding a grouping but got", err)
+	}
+	id, err := chimp.ListInterestGroupingAdd(map[string]interface{}{"id": fakeList, "name": "Interests", "type": "checkboxes", "groups": []string{"Shoes"}})
+	if err != nil {
+		t.Fatal("ListInterestGroupingAdd:", err)
+	}
+	if _, err := chimp.ListInterestGroupingUpdate(map[string]interface{}{"grouping_id": id, "name": "name", "value": "Products"}); err != nil {
+		t.Error("ListInterestGroupingUpdate:", err)
+	}
+	if _, err := chimp.ListInterestGroupAdd(map[string]interface{}{"id": fakeList, "group_name": "Hats", "grouping_id": id}); err != nil {
+		t.Error("ListInterestGroupAdd:", err)
+	}
+	if _, err := chimp.ListInterestGroupAdd(map[string]interface{}{"id": fakeList, "group_name": "Hats", "grouping_id": id}); !errors.Is(err, ErrInvalidInterestGroup) {
+		t.Error("ListInterestGroupAdd: expected ErrInvalidInterestGroup for a duplicate group but got", err)
+	}
+	if _, err := chimp.ListInterestGroupUpdate(map[string]interface{}{"id": fakeList, "old_name": "Shoes", "new_name": "Boots", "grouping_id": id}); err != nil {
+		t.Error("ListInterestGroupUpdate:", err)
+	}
+	if _, err := chimp.ListInterestGroupDel(map[string]interface{}{"id": fakeList, "group_name": "Hats", "grouping_id": id}); err != nil {
+		t.Error("ListInterestGroupDel:", err)
+	}
+	groupings, err := chimp.ListInterestGroupings(map[string]interface{}{"id": fakeList})
+	if err != nil {
+		t.Fatal("ListInterestGroupings:", err)
+	}
+	verify(t, "ListInterestGroupings", id, int(groupings[0].ID))
+	verify(t, "ListInterestGroupings", "Products", groupings[0].Name)
+	if len(groupings[0].Groups) != 1 || groupings[0].Groups[0].Name != "Boots" {
+		t.Error("ListInterestGroupings: expected only the Boots group but got", groupings[0].Groups)
+	}
+	if _, err := chimp.ListInterestGroupingDel(map[string]interface{}{"grouping_id": id}); err != nil {
+		t.Error("ListInterestGroupingDel:", err)
+	}
+}
+
+func TestFakeEcommOrders(t *testing.T) {
+	chimp, server := fakeAPI(t)
+	ctx := context.Background()
+	cid, err := chimp.CampaignCreate(map[string]interface{}{
+		"type":    "regular",
+		"options": map[string]interface{}{"list_id": fakeList, "subject": "Sale", "from_email": "shop@example.com", "from_name": "Shop"},
+	})
+	if err != nil {
+		t.Fatal("CampaignCreate:", err)
+	}
+	order := EcommOrder{
+		ID:        "1001",
+		Email:     "one@example.com",
+		Total:     25.5,
+		StoreID:   "store1",
+		StoreName: "Shop",
+		Items:     []EcommOrderItem{{ProductID: 7, ProductName: "Boots", CategoryID: 3, CategoryName: "Shoes", Qty: 1, Cost: 25.5}},
+	}
+	if _, err := chimp.EcommOrderAddWithParams(ctx, &EcommOrderAddParams{Order: order}); err != nil {
+		t.Fatal("EcommOrderAdd:", err)
+	}
+	if _, err := chimp.EcommOrderAddWithParams(ctx, &EcommOrderAddParams{Order: order}); !errors.Is(err, ErrValidation) {
+		t.Error("EcommOrderAdd: expected ErrValidation for a duplicate order but got", err)
+	}
+	order.ID, order.CampaignID, order.EmailID = "1002", cid, "a1b2c3d4e5"
+	if _, err := chimp.CampaignEcommOrderAddWithParams(ctx, &CampaignEcommOrderAddParams{Order: order}); err != nil {
+		t.Fatal("CampaignEcommOrderAdd:", err)
+	}
+
+	orders, err := chimp.EcommOrders(nil)
+	if err != nil {
+		t.Fatal("EcommOrders:", err)
+	}
+	verify(t, "EcommOrders total", 2, int(orders.Total))
+	verify(t, "EcommOrders", "Boots", orders.Data[0].Lines[0].ProductName)
+	verify(t, "EcommOrders", 25.5, float64(orders.Data[0].OrderTotal))
+	campaignOrders, err := chimp.CampaignEcommOrders(map[string]interface{}{"cid": cid})
+	if err != nil {
+		t.Fatal("CampaignEcommOrders:", err)
+	}
+	verify(t, "CampaignEcommOrders total", 1, int(campaignOrders.Total))
+	verify(t, "CampaignEcommOrders", "Shoes", campaignOrders.Data[0].Lines[0].ProductCategoryName)
+
+	if _, err := chimp.EcommOrderDel(map[string]interface{}{"store_id": "store1", "order_id": "1001"}); err != nil {
+		t.Error("EcommOrderDel:", err)
+	}
+	verify(t, "EcommOrderDel", 1, len(server.Orders()))
+}
+
+func TestFakeInvalidKey(t *testing.T) {
+	server := mailchimptest.NewServer()
+	defer server.Close()
+	chimp, err := NewClient("abc123-us1", WithBaseURL(server.BaseURL()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := chimp.Ping(); !errors.Is(err, ErrInvalidKey) {
+		t.Error("Ping: expected ErrInvalidKey but got", err)
+	}
+}
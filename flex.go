@@ -0,0 +1,94 @@
+package mailchimp
+
+import (
+	"bytes"
+	"encoding/json"
+	"reflect"
+	"strconv"
+)
+
+//Mailchimp sends some numbers and booleans as strings, e.g. "members":"14",
+//and sometimes sends "" or null for missing values. The Flex types accept
+//all of these, leaving the zero value for "" and null, and marshal as plain
+//JSON numbers and booleans.
+
+//FlexInt is an int that unmarshals from a JSON number or a quoted number
+type FlexInt int
+
+//FlexFloat is a float64 that unmarshals from a JSON number or a quoted number
+type FlexFloat float64
+
+//FlexBool is a bool that unmarshals from a JSON boolean, 0 or 1, or a
+//quoted form of any of these
+type FlexBool bool
+
+var (
+	flexIntType   = reflect.TypeOf(FlexInt(0))
+	flexFloatType = reflect.TypeOf(FlexFloat(0))
+	flexBoolType  = reflect.TypeOf(FlexBool(false))
+)
+
+//flexValue strips the quotes from a JSON string, returning nil for "" and null
+func flexValue(data []byte) ([]byte, error) {
+	if bytes.Equal(data, []byte("null")) {
+		return nil, nil
+	}
+	if len(data) > 0 && data[0] == '"' {
+		var s string
+		if err := json.Unmarshal(data, &s); err != nil {
+			return nil, err
+		}
+		data = bytes.TrimSpace([]byte(s))
+	}
+	if len(data) == 0 {
+		return nil, nil
+	}
+	return data, nil
+}
+
+func (i *FlexInt) UnmarshalJSON(data []byte) error {
+	v, err := flexValue(data)
+	if err != nil || v == nil {
+		*i = 0
+		return err
+	}
+	n, err := strconv.ParseInt(string(v), 10, 0)
+	if err != nil {
+		//some counts are sent as floats, e.g. 12.0
+		f, ferr := strconv.ParseFloat(string(v), 64)
+		if ferr != nil || f != float64(int(f)) {
+			return &json.UnmarshalTypeError{Value: string(data), Type: flexIntType}
+		}
+		n = int64(f)
+	}
+	*i = FlexInt(n)
+	return nil
+}
+
+func (f *FlexFloat) UnmarshalJSON(data []byte) error {
+	v, err := flexValue(data)
+	if err != nil || v == nil {
+		*f = 0
+		return err
+	}
+	n, err := strconv.ParseFloat(string(v), 64)
+	if err != nil {
+		return &json.UnmarshalTypeError{Value: string(data), Type: flexFloatType}
+	}
+	*f = FlexFloat(n)
+	return nil
+}
+
+func (b *FlexBool) UnmarshalJSON(data []byte) error {
+	v, err := flexValue(data)
+	if err != nil || v == nil {
+		*b = false
+		return err
+	}
+	t, err := strconv.ParseBool(string(v))
+	if err != nil {
+		return &json.UnmarshalTypeError{Value: string(data), Type: flexBoolType}
+	}
+	*b = FlexBool(t)
+	return nil
+}
@@ -0,0 +1,77 @@
+package mailchimp
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestFlexInt(t *testing.T) {
+	for input, expected := range map[string]FlexInt{`14`: 14, `"14"`: 14, `" 7 "`: 7, `""`: 0, `null`: 0, `-3`: -3, `12.0`: 12} {
+		var i FlexInt = 99
+		if err := json.Unmarshal([]byte(input), &i); err != nil || i != expected {
+			t.Errorf("FlexInt %s: expected %d but got %d, %v", input, expected, i, err)
+		}
+	}
+	for _, input := range []string{`"abc"`, `1.5`, `true`, `{}`} {
+		var i FlexInt
+		if err := json.Unmarshal([]byte(input), &i); err == nil {
+			t.Errorf("FlexInt %s: expected an error", input)
+		}
+	}
+}
+
+func TestFlexFloat(t *testing.T) {
+	for input, expected := range map[string]FlexFloat{`0.83`: 0.83, `"0.83"`: 0.83, `""`: 0, `null`: 0, `"2"`: 2} {
+		var f FlexFloat = 99
+		if err := json.Unmarshal([]byte(input), &f); err != nil || f != expected {
+			t.Errorf("FlexFloat %s: expected %v but got %v, %v", input, expected, f, err)
+		}
+	}
+	var f FlexFloat
+	if err := json.Unmarshal([]byte(`"n/a"`), &f); err == nil {
+		t.Error(`FlexFloat "n/a": expected an error`)
+	}
+}
+
+func TestFlexBool(t *testing.T) {
+	for input, expected := range map[string]FlexBool{`true`: true, `false`: false, `"true"`: true, `1`: true, `"0"`: false, `""`: false, `null`: false} {
+		var b FlexBool = !expected
+		if err := json.Unmarshal([]byte(input), &b); err != nil || b != expected {
+			t.Errorf("FlexBool %s: expected %v but got %v, %v", input, expected, b, err)
+		}
+	}
+	var b FlexBool
+	if err := json.Unmarshal([]byte(`"maybe"`), &b); err == nil {
+		t.Error(`FlexBool "maybe": expected an error`)
+	}
+}
+
+func TestFlexFixtures(t *testing.T) {
+	clients := new(ListClientsResponse)
+	if err := populate("listClients", clients); err != nil {
+		t.Fatal(err)
+	}
+	verify(t, "ListClientsResponse", 9, int(clients.Mobile.Clients[0].Members))
+	verify(t, "ListClientsResponse", 14, int(clients.Desktop.Clients[0].Members))
+	if clients.Desktop.Penetration != 0.83050847457627 {
+		t.Error("ListClientsResponse: expected 0.83050847457627 but got", clients.Desktop.Penetration)
+	}
+
+	history := make(ListGrowthHistoryResponse, 0)
+	if err := populate("listGrowthHistory", &history); err != nil {
+		t.Fatal(err)
+	}
+	verify(t, "ListGrowthHistory", 5, int(history[0].Month.Month()))
+	verify(t, "ListGrowthHistory", 2, int(history[1].Existing))
+	verify(t, "ListGrowthHistory", 1, int(history[2].Imports))
+	verify(t, "ListGrowthHistory", 1, int(history[3].Optins))
+}
+
+func TestFlexDecodeErrorField(t *testing.T) {
+	body := []byte(`{"total":"1","data":[{"id":"a"},{"id":"b","web_id":"twelve"}]}`)
+	result := new(CampaignsResult)
+	err := newDecodeError("campaigns", body, result, json.Unmarshal(body, result))
+	if err.Field != "data.1.web_id" {
+		t.Error("expected the field path data.1.web_id but got", err.Field)
+	}
+}
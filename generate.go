@@ -0,0 +1,8 @@
+package mailchimp
+
+//The routines described in routines.json are generated into routines_gen.go,
+//with a test decoding the fixture of each in routines_gen_test.go. Run go
+//generate ./... after changing the description, which also updates the mock
+//in package mailchimpmock.
+
+//go:generate go run ./cmd/mailchimpgen -o routines_gen.go -test routines_gen_test.go routines.json
@@ -0,0 +1,109 @@
+package mailchimp
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+)
+
+//Limiter keeps requests under an account's limits by allowing at most
+//perSecond requests per second on average, with bursts of up to burst
+//requests, and at most maxConcurrent requests in flight at once.
+//
+//A Limiter is safe for concurrent use. Mailchimp applies its limits per
+//account, so every API that uses the same key should share one Limiter.
+type Limiter struct {
+	mu     sync.Mutex
+	rate   float64
+	burst  float64
+	tokens float64
+	last   time.Time
+	slots  chan struct{}
+}
+
+//NewLimiter creates a Limiter. A perSecond of zero leaves the request rate
+//unlimited and a maxConcurrent of zero leaves concurrency unlimited.
+func NewLimiter(perSecond float64, burst int, maxConcurrent int) (*Limiter, error) {
+	if perSecond < 0 || maxConcurrent < 0 {
+		return nil, errors.New("mailchimp: negative Limiter setting")
+	}
+	if perSecond > 0 && burst < 1 {
+		return nil, errors.New("mailchimp: Limiter burst must be at least 1")
+	}
+	l := &Limiter{rate: perSecond, burst: float64(burst), tokens: float64(burst)}
+	if maxConcurrent > 0 {
+		l.slots = make(chan struct{}, maxConcurrent)
+	}
+	return l, nil
+}
+
+//WithLimiter makes every request wait for permission from limiter
+func WithLimiter(limiter *Limiter) Option {
+	return func(a *API) error {
+		a.limiter = limiter
+		return nil
+	}
+}
+
+//acquire blocks until a request may start or ctx is done. The returned
+//function must be called once the request has finished.
+func (l *Limiter) acquire(ctx context.Context) (release func(), err error) {
+	if l == nil {
+		return func() {}, nil
+	}
+	if l.slots != nil {
+		select {
+		case l.slots <- struct{}{}:
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+	}
+	release = func() {
+		if l.slots != nil {
+			<-l.slots
+		}
+	}
+	if delay := l.reserve(); delay > 0 {
+		timer := time.NewTimer(delay)
+		defer timer.Stop()
+		select {
+		case <-timer.C:
+		case <-ctx.Done():
+			l.cancel()
+			release()
+			return nil, ctx.Err()
+		}
+	}
+	return release, nil
+}
+
+//reserve takes a token from the bucket, returning how long to wait until
+//the token is actually available
+func (l *Limiter) reserve() time.Duration {
+	if l.rate == 0 {
+		return 0
+	}
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	now := time.Now()
+	if !l.last.IsZero() {
+		l.tokens += now.Sub(l.last).Seconds() * l.rate
+		if l.tokens > l.burst {
+			l.tokens = l.burst
+		}
+	}
+	l.last = now
+	l.tokens--
+	if l.tokens >= 0 {
+		return 0
+	}
+	return time.Duration(-l.tokens / l.rate * float64(time.Second))
+}
+
+//cancel returns a token taken by reserve for a request that never started
+func (l *Limiter) cancel() {
+	l.mu.Lock()
+	l.tokens++
+	l.mu.Unlock()
+}
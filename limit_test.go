@@ -0,0 +1,107 @@
+package mailchimp
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestLimiterConcurrency(t *testing.T) {
+	var inFlight, peak int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		n := atomic.AddInt32(&inFlight, 1)
+		for {
+			p := atomic.LoadInt32(&peak)
+			if n <= p || atomic.CompareAndSwapInt32(&peak, p, n) {
+				break
+			}
+		}
+		time.Sleep(10 * time.Millisecond)
+		atomic.AddInt32(&inFlight, -1)
+		w.Write([]byte(`{"total":0,"data":[]}`))
+	}))
+	defer server.Close()
+	limiter, err := NewLimiter(0, 0, 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	api, err := NewClient("abc123-us1", WithBaseURL(server.URL+"/1.3/"), WithLimiter(limiter))
+	if err != nil {
+		t.Fatal(err)
+	}
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if _, err := api.CampaignMembers(map[string]interface{}{"cid": "abc"}); err != nil {
+				t.Error("CampaignMembers:", err)
+			}
+		}()
+	}
+	wg.Wait()
+	if peak > 2 {
+		t.Error("expected at most 2 requests in flight but saw", peak)
+	}
+}
+
+func TestLimiterRate(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`true`))
+	}))
+	defer server.Close()
+	limiter, err := NewLimiter(100, 2, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	api, err := NewClient("abc123-us1", WithBaseURL(server.URL+"/1.3/"), WithLimiter(limiter))
+	if err != nil {
+		t.Fatal(err)
+	}
+	start := time.Now()
+	for i := 0; i < 7; i++ {
+		if _, err := api.CampaignPause(nil); err != nil {
+			t.Fatal("CampaignPause:", err)
+		}
+	}
+	//a burst of 2 followed by 5 requests at 100 per second
+	if elapsed := time.Since(start); elapsed < 40*time.Millisecond {
+		t.Error("requests were not rate limited; 7 requests took", elapsed)
+	}
+}
+
+func TestLimiterCanceled(t *testing.T) {
+	limiter, err := NewLimiter(0.001, 1, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	api, err := NewClient("abc123-us1", WithBaseURL("http://127.0.0.1:0/1.3/"), WithLimiter(limiter))
+	if err != nil {
+		t.Fatal(err)
+	}
+	limiter.reserve()
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	_, err = api.PingContext(ctx)
+	var timeout TimeoutError
+	if !errors.As(err, &timeout) {
+		t.Error("PingContext: expected a TimeoutError but got", err)
+	}
+	if limiter.tokens < -0.5 {
+		t.Error("the canceled request's token was not returned to the bucket")
+	}
+}
+
+func TestNewLimiterInvalid(t *testing.T) {
+	if _, err := NewLimiter(-1, 1, 0); err == nil {
+		t.Error("NewLimiter: expected an error for a negative rate")
+	}
+	if _, err := NewLimiter(10, 0, 0); err == nil {
+		t.Error("NewLimiter: expected an error for a zero burst")
+	}
+}
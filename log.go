@@ -0,0 +1,60 @@
+package mailchimp
+
+import (
+	"bytes"
+	"context"
+	"log/slog"
+	"regexp"
+	"time"
+)
+
+//Logger receives one record for every request made to Mailchimp.
+//*slog.Logger satisfies this interface.
+type Logger interface {
+	Log(ctx context.Context, level slog.Level, msg string, args ...interface{})
+}
+
+//WithLogger records the routine, latency and HTTP status of every request
+//to logger. Successful requests are logged at slog.LevelDebug and failed
+//ones at slog.LevelError.
+func WithLogger(logger Logger) Option {
+	return func(a *API) error {
+		a.logger = logger
+		return nil
+	}
+}
+
+//WithLogBodies adds the request and response bodies to each log record, with
+//the API key and email addresses masked. It has no effect without WithLogger.
+func WithLogBodies() Option {
+	return func(a *API) error {
+		a.logBodies = true
+		return nil
+	}
+}
+
+var emailAddress = regexp.MustCompile(`[^\s"'<>@,;:\\/]+@[^\s"'<>@,;:\\/]+\.[A-Za-z]+`)
+
+//redact masks the API key and every email address in b
+func (a *API) redact(b []byte) string {
+	if a.Key != "" {
+		b = bytes.ReplaceAll(b, []byte(a.Key), []byte("*****-"+a.datacenter))
+	}
+	return string(emailAddress.ReplaceAll(b, []byte("*****@*****")))
+}
+
+func (a *API) logRequest(ctx context.Context, method string, start time.Time, status int, request, response []byte, err error) {
+	if a.logger == nil {
+		return
+	}
+	level := slog.LevelDebug
+	args := []interface{}{"method", method, "latency", time.Since(start), "status", status}
+	if err != nil {
+		level = slog.LevelError
+		args = append(args, "error", a.redact([]byte(err.Error())))
+	}
+	if a.logBodies {
+		args = append(args, "request", a.redact(request), "response", a.redact(response))
+	}
+	a.logger.Log(ctx, level, "mailchimp request", args...)
+}
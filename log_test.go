@@ -0,0 +1,41 @@
+package mailchimp
+
+import (
+	"bytes"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestLoggerRedactsKeyAndEmails(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"error":"jane.doe@example.com is not subscribed","code":215}`))
+	}))
+	defer server.Close()
+	buf := new(bytes.Buffer)
+	logger := slog.New(slog.NewTextHandler(buf, &slog.HandlerOptions{Level: slog.LevelDebug}))
+	api, err := NewClient("0123456789abcdef-us1",
+		WithBaseURL(server.URL+"/1.3/"),
+		WithLogger(logger),
+		WithLogBodies())
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = api.ListsForEmail(map[string]interface{}{"email_address": "jane.doe@example.com"})
+	if err == nil {
+		t.Fatal("ListsForEmail: expected an error")
+	}
+	record := buf.String()
+	for _, want := range []string{"level=ERROR", "method=listsForEmail", "status=200", "latency=", "*****@*****", "*****-us1"} {
+		if !strings.Contains(record, want) {
+			t.Errorf("log record is missing %q: %s", want, record)
+		}
+	}
+	for _, secret := range []string{"0123456789abcdef", "jane.doe", "example.com"} {
+		if strings.Contains(record, secret) {
+			t.Errorf("log record leaks %q: %s", secret, record)
+		}
+	}
+}
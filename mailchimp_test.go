@@ -5,10 +5,14 @@ import "path/filepath"
 import "os"
 import "testing"
 import "encoding/json"
+import "context"
+import "errors"
+import "net/http"
+import "net/http/httptest"
+import "time"
 
 //import "bytes"
 //import "strings"
-//import "time"
 //import "fmt"
 
 var CID = os.Getenv("MAILCHIMPCID")
@@ -45,15 +49,31 @@ func populate(filename string, response interface{}) error {
 			break
 		}
 	}
-	//some response types have an alterJson method that needs
-	//to be called before unmarshalling
-	switch r := response.(type) {
-	case alterJsoner:
-		json.Unmarshal(r.alterJson(b), response)
-	default:
-		json.Unmarshal(b, response)
+	return json.Unmarshal(b, response)
+}
+
+//fixtureAPI returns an API whose requests are all answered with the first JSON
+//value in json/<filename>.json, decoding responses strictly so that fields
+//missing from the result types are reported
+func fixtureAPI(t *testing.T, filename string) *API {
+	file, err := os.Open(filepath.Join("json", filename+".json"))
+	if err != nil {
+		t.Fatal(err)
 	}
-	return nil
+	defer file.Close()
+	var fixture json.RawMessage
+	if err := json.NewDecoder(file).Decode(&fixture); err != nil {
+		t.Fatal(filename, err)
+	}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write(fixture)
+	}))
+	t.Cleanup(server.Close)
+	api, err := NewClient("abc123-us1", WithBaseURL(server.URL+"/1.3/"), WithStrictDecoding())
+	if err != nil {
+		t.Fatal(err)
+	}
+	return api
 }
 
 func verify(t *testing.T, name string, expected interface{}, actual interface{}) {
@@ -72,7 +92,7 @@ func TestCampaignContent(t *testing.T) {
 	if err != nil {
 		t.Error("mailchimp.CampaignContent:", err)
 	}
-	if !strings.Contains(result.Html, "<head>") {
+	if !strings.Contains(result.HTML, "<head>") {
 		t.Error("mailchimp.CampaignContent: the Html field of the returned struct does not look like html")
 	}
 }
@@ -273,8 +293,8 @@ func TestCampaignShareReport(t *testing.T) {
 	if err != nil {
 		t.Error("mailchimp.CampaignShareReport:", err)
 	}
-	if !strings.HasPrefix(result.Url, "http") {
-		t.Error("Expected result.Url to be a valid url but got", result.Url)
+	if !strings.HasPrefix(result.URL, "http") {
+		t.Error("Expected result.URL to be a valid url but got", result.URL)
 	}
 }
 */
@@ -458,8 +478,8 @@ func TestCampaignStats(t *testing.T) {
 	if err != nil {
 		t.Error("mailchimp.CampaignStats", err)
 	}
-	if result.Emails_sent <= 0 {
-		t.Error("mailchimp.CampaignStats: expected emails_sent to be positive but got", result.Emails_sent)
+	if result.EmailsSent <= 0 {
+		t.Error("mailchimp.CampaignStats: expected emails_sent to be positive but got", result.EmailsSent)
 	}
 }
 */
@@ -591,8 +611,8 @@ func TestEcommOrders(t *testing.T) {
 	if err != nil {
 		t.Error("mailchimp.EcommOrders", err)
 	}
-	if result.Data[0].Lines[0].Line_num != 1 {
-		t.Error("mailchimp.EcommOrders: expected first line_num of first order returned to be 1 but got", result.Data[0].Lines[0].Line_num)
+	if result.Data[0].Lines[0].LineNum != 1 {
+		t.Error("mailchimp.EcommOrders: expected first line_num of first order returned to be 1 but got", result.Data[0].Lines[0].LineNum)
 	}
 }
 */
@@ -638,8 +658,8 @@ func TestFolders(t *testing.T) {
 	if err != nil {
 		t.Error("mailchimp.Folders", err)
 	}
-	if result[0].Folder_id <= 0 {
-		t.Error("mailchimp.Folders: expected first Folder_id to be a positive integer but got", result[0].Folder_id)
+	if result[0].FolderID <= 0 {
+		t.Error("mailchimp.Folders: expected first Folder_id to be a positive integer but got", result[0].FolderID)
 	}
 }
 */
@@ -818,15 +838,15 @@ func TestListActivityElement(t *testing.T) {
 	response := make([]ListActivityElement, 0)
 	populate("listActivity", &response)
 
-	a := response[0].User_id
+	a := response[0].UserID
 	if a != 1234567 {
 		t.Error("ListActivity: expected 1234567 but got", a)
 	}
-	b := response[0].Recipient_clicks
+	b := response[0].RecipientClicks
 	if b != 40 {
 		t.Error("ListActivity: expected 40 but got", b)
 	}
-	c := response[1].Other_adds
+	c := response[1].OtherAdds
 	if c != 0 {
 		t.Error("ListActivity: expected 0 but got", c)
 	}
@@ -861,10 +881,10 @@ func TestListBatchSubscribe(t *testing.T) {
 	if err != nil {
 		t.Error("mailchimp.ListBatchSubscribe", err)
 	}
-	if result.Add_count + result.Update_count != 1 {
+	if result.AddCount + result.UpdateCount != 1 {
 		t.Error("mailchimp.ListBatchSubscribe: Expected email to be subscribed or updated but it was not")
 	}
-	if len(result.Errors) != result.Error_count {
+	if len(result.Errors) != int(result.ErrorCount) {
 		t.Error("mailchimp.ListBatchSubscribe: The error_count should equal the length of errors array")
 	}
 }
@@ -885,10 +905,10 @@ func TestListBatchUnsubscribe(t *testing.T) {
 	if err != nil {
 		t.Error("mailchimp.ListBatchUnsubscribe", err)
 	}
-	if result.Success_count + result.Error_count != 1 {
+	if result.SuccessCount + result.ErrorCount != 1 {
 		t.Error("mailchimp.ListBatchUnsubscribe: Expected Success_count or Error_count to be 1")
 	}
-	if len(result.Errors) != result.Error_count {
+	if len(result.Errors) != int(result.ErrorCount) {
 		t.Error("mailchimp.ListBatchUnsubscribe: Number of items in array doesn't equal Error_count")
 	}
 }
@@ -930,9 +950,9 @@ func TestListGrowthHistoryElement(t *testing.T) {
 	populate("listGrowthHistory", &response)
 
 	verify(t, "ListGrowthHistory", 5, int(response[0].Month.Month()))
-	verify(t, "ListGrowthHistory", 2, response[1].Existing)
-	verify(t, "ListGrowthHistory", 1, response[2].Imports)
-	verify(t, "ListGrowthHistory", 1, response[3].Optins)
+	verify(t, "ListGrowthHistory", 2, int(response[1].Existing))
+	verify(t, "ListGrowthHistory", 1, int(response[2].Imports))
+	verify(t, "ListGrowthHistory", 1, int(response[3].Optins))
 }
 func TestListGrowthHistory(t *testing.T) {
 	_, err := chimp.ListGrowthHistory(map[string]interface{}{"id": LIST})
@@ -1027,8 +1047,8 @@ func TestListInterestGroupings (t *testing.T) {
   if err != nil {
     t.Error("ListInterestGroupings", err)
   }
-  if result[0].Id != id {
-    t.Error("ListInterestGroupings: Expected", id, "but got", result[0].Id)
+  if result[0].ID != id {
+    t.Error("ListInterestGroupings: Expected", id, "but got", result[0].ID)
   }
   if result[0].Groups[0].Name != "vegetarian" {
     t.Error("ListInterestGroupings: Expected vegetarian but got", result[0].Groups[0].Name)
@@ -1049,3 +1069,41 @@ func TestListInterestGroupingDel (t *testing.T) {
   }
 }
 */
+
+func TestContextDeadline(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		select {
+		case <-r.Context().Done():
+		case <-time.After(250 * time.Millisecond):
+		}
+	}))
+	defer server.Close()
+	api, err := NewClient("abc-us1", WithBaseURL(server.URL+"/1.3/"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	_, err = api.PingContext(ctx)
+	var timeout TimeoutError
+	if !errors.As(err, &timeout) {
+		t.Fatal("PingContext: expected a TimeoutError but got", err)
+	}
+	if !timeout.Timeout() || timeout.Method != "ping" {
+		t.Error("PingContext: unexpected TimeoutError", timeout)
+	}
+}
+
+func TestContextCanceled(t *testing.T) {
+	api, err := NewClient("abc-us1", WithBaseURL("http://127.0.0.1:0/1.3/"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	_, err = api.CampaignsContext(ctx, nil)
+	var timeout TimeoutError
+	if !errors.As(err, &timeout) || timeout.Timeout() {
+		t.Error("CampaignsContext: expected a canceled TimeoutError but got", err)
+	}
+}
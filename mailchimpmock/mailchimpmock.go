@@ -0,0 +1,70 @@
+//Package mailchimpmock provides Mock, a mailchimp.Client for unit tests of
+//code that depends on Client or one of its service interfaces:
+//
+//	mock := new(mailchimpmock.Mock)
+//	mock.CampaignContentFunc = func(ctx context.Context, parameters map[string]interface{}) (*mailchimp.CampaignContentResult, error) {
+//		return &mailchimp.CampaignContentResult{HTML: "<p>Hi</p>"}, nil
+//	}
+//	err := archive(mock, "a1b2c3")
+//	calls := mock.Calls()
+//
+//The methods of Mock are generated from the interfaces by
+//cmd/mailchimpmockgen.
+package mailchimpmock
+
+//go:generate go run ../cmd/mailchimpmockgen -o mock.go ..
+
+import "github.com/areed/mailchimp"
+
+var _ mailchimp.Client = (*Mock)(nil)
+
+//Call is a call made to a Mock
+type Call struct {
+	//Method is the name of the method called, e.g. CampaignsContext
+	Method string
+	//Args are the arguments of the call, apart from its context
+	Args []interface{}
+}
+
+//NotMockedError is returned by the methods of a Mock whose function field
+//is nil
+type NotMockedError struct {
+	Method string
+}
+
+func (e NotMockedError) Error() string {
+	return "mailchimpmock: " + e.Method + " is not mocked"
+}
+
+//Calls returns the calls made to the Mock, in order
+func (m *Mock) Calls() []Call {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return append([]Call(nil), m.calls...)
+}
+
+//CallsTo returns the calls made to the named method, in order
+func (m *Mock) CallsTo(method string) []Call {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	var calls []Call
+	for _, c := range m.calls {
+		if c.Method == method {
+			calls = append(calls, c)
+		}
+	}
+	return calls
+}
+
+//Reset forgets the calls made to the Mock
+func (m *Mock) Reset() {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.calls = nil
+}
+
+func (m *Mock) record(method string, args ...interface{}) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.calls = append(m.calls, Call{method, args})
+}
@@ -0,0 +1,3337 @@
+// Code generated by mailchimpmockgen; DO NOT EDIT.
+
+package mailchimpmock
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/areed/mailchimp"
+)
+
+//Mock is a mailchimp.Client that records its calls and answers them with
+//the function fields of the same name. A method and its Context variant
+//share a field, and a WithParams method without a field of its own uses
+//the routine's field with the params converted to parameters. Calls to
+//methods whose field is nil fail with a NotMockedError. Set the fields
+//before the Mock is used.
+type Mock struct {
+	mu    sync.Mutex
+	calls []Call
+
+	CampaignAbuseReportsFunc                     func(ctx context.Context, parameters map[string]interface{}) (*mailchimp.CampaignAbuseReportsResult, error)
+	CampaignAbuseReportsPagerFunc                func(ctx context.Context, parameters map[string]interface{}) *mailchimp.Pager[mailchimp.CampaignAbuseReportsResultDataItem]
+	CampaignAbuseReportsWithParamsFunc           func(ctx context.Context, params *mailchimp.CampaignAbuseReportsParams) (*mailchimp.CampaignAbuseReportsResult, error)
+	CampaignAdviceFunc                           func(ctx context.Context, parameters map[string]interface{}) ([]mailchimp.CampaignAdviceResultItem, error)
+	CampaignAdviceWithParamsFunc                 func(ctx context.Context, params *mailchimp.CampaignAdviceParams) ([]mailchimp.CampaignAdviceResultItem, error)
+	CampaignAnalyticsFunc                        func(ctx context.Context, parameters map[string]interface{}) (*mailchimp.CampaignAnalyticsResult, error)
+	CampaignAnalyticsWithParamsFunc              func(ctx context.Context, params *mailchimp.CampaignAnalyticsParams) (*mailchimp.CampaignAnalyticsResult, error)
+	CampaignBounceMessageFunc                    func(ctx context.Context, parameters map[string]interface{}) (*mailchimp.CampaignBounceMessageResult, error)
+	CampaignBounceMessageWithParamsFunc          func(ctx context.Context, params *mailchimp.CampaignBounceMessageParams) (*mailchimp.CampaignBounceMessageResult, error)
+	CampaignBounceMessagesFunc                   func(ctx context.Context, parameters map[string]interface{}) (*mailchimp.CampaignBounceMessagesResult, error)
+	CampaignBounceMessagesPagerFunc              func(ctx context.Context, parameters map[string]interface{}) *mailchimp.Pager[mailchimp.CampaignBounceMessageResult]
+	CampaignBounceMessagesWithParamsFunc         func(ctx context.Context, params *mailchimp.CampaignBounceMessagesParams) (*mailchimp.CampaignBounceMessagesResult, error)
+	CampaignClickDetailAIMFunc                   func(ctx context.Context, parameters map[string]interface{}) (*mailchimp.CampaignClickDetailAIMResult, error)
+	CampaignClickDetailAIMPagerFunc              func(ctx context.Context, parameters map[string]interface{}) *mailchimp.Pager[mailchimp.CampaignClickDetailAIMResultDataItem]
+	CampaignClickDetailAIMStreamFunc             func(ctx context.Context, parameters map[string]interface{}, fn func(mailchimp.CampaignClickDetailAIMResultDataItem) error) (int, error)
+	CampaignClickDetailAIMWithParamsFunc         func(ctx context.Context, params *mailchimp.CampaignClickDetailAIMParams) (*mailchimp.CampaignClickDetailAIMResult, error)
+	CampaignClickStatsFunc                       func(ctx context.Context, parameters map[string]interface{}) (map[string]mailchimp.CampaignClickStatsResultItem, error)
+	CampaignClickStatsWithParamsFunc             func(ctx context.Context, params *mailchimp.CampaignClickStatsParams) (map[string]mailchimp.CampaignClickStatsResultItem, error)
+	CampaignContentFunc                          func(ctx context.Context, parameters map[string]interface{}) (*mailchimp.CampaignContentResult, error)
+	CampaignContentWithParamsFunc                func(ctx context.Context, params *mailchimp.CampaignContentParams) (*mailchimp.CampaignContentResult, error)
+	CampaignCreateFunc                           func(ctx context.Context, parameters map[string]interface{}) (string, error)
+	CampaignCreateWithParamsFunc                 func(ctx context.Context, params *mailchimp.CampaignCreateParams) (string, error)
+	CampaignDeleteFunc                           func(ctx context.Context, parameters map[string]interface{}) (bool, error)
+	CampaignDeleteWithParamsFunc                 func(ctx context.Context, params *mailchimp.CampaignDeleteParams) (bool, error)
+	CampaignEcommOrderAddFunc                    func(ctx context.Context, parameters map[string]interface{}) (bool, error)
+	CampaignEcommOrderAddWithParamsFunc          func(ctx context.Context, params *mailchimp.CampaignEcommOrderAddParams) (bool, error)
+	CampaignEcommOrdersFunc                      func(ctx context.Context, parameters map[string]interface{}) (*mailchimp.CampaignEcommOrdersResult, error)
+	CampaignEcommOrdersPagerFunc                 func(ctx context.Context, parameters map[string]interface{}) *mailchimp.Pager[mailchimp.CampaignEcommOrdersResultDataItem]
+	CampaignEcommOrdersWithParamsFunc            func(ctx context.Context, params *mailchimp.CampaignEcommOrdersParams) (*mailchimp.CampaignEcommOrdersResult, error)
+	CampaignEepUrlStatsFunc                      func(ctx context.Context, parameters map[string]interface{}) (*mailchimp.CampaignEepUrlStatsResult, error)
+	CampaignEepUrlStatsWithParamsFunc            func(ctx context.Context, params *mailchimp.CampaignEepUrlStatsParams) (*mailchimp.CampaignEepUrlStatsResult, error)
+	CampaignEmailDomainPerformanceFunc           func(ctx context.Context, parameters map[string]interface{}) ([]mailchimp.CampaignEmailDomainPerformanceResultItem, error)
+	CampaignEmailDomainPerformanceWithParamsFunc func(ctx context.Context, params *mailchimp.CampaignEmailDomainPerformanceParams) ([]mailchimp.CampaignEmailDomainPerformanceResultItem, error)
+	CampaignEmailStatsAIMAllFunc                 func(ctx context.Context, parameters map[string]interface{}) (*mailchimp.CampaignEmailStatsAIMAllResult, error)
+	CampaignEmailStatsAIMAllPagerFunc            func(ctx context.Context, parameters map[string]interface{}) *mailchimp.Pager[mailchimp.CampaignEmailStatsAIMAllItem]
+	CampaignEmailStatsAIMAllStreamFunc           func(ctx context.Context, parameters map[string]interface{}, fn func(mailchimp.CampaignEmailStatsAIMAllItem) error) (int, error)
+	CampaignEmailStatsAIMAllWithParamsFunc       func(ctx context.Context, params *mailchimp.CampaignEmailStatsAIMAllParams) (*mailchimp.CampaignEmailStatsAIMAllResult, error)
+	CampaignEmailStatsAIMFunc                    func(ctx context.Context, parameters map[string]interface{}) (*mailchimp.CampaignEmailStatsAIMResult, error)
+	CampaignEmailStatsAIMWithParamsFunc          func(ctx context.Context, params *mailchimp.CampaignEmailStatsAIMParams) (*mailchimp.CampaignEmailStatsAIMResult, error)
+	CampaignGeoOpensFunc                         func(ctx context.Context, parameters map[string]interface{}) ([]mailchimp.CampaignGeoOpensResultItem, error)
+	CampaignGeoOpensForCountryFunc               func(ctx context.Context, parameters map[string]interface{}) ([]mailchimp.CampaignGeoOpensForCountryReturnItem, error)
+	CampaignGeoOpensForCountryWithParamsFunc     func(ctx context.Context, params *mailchimp.CampaignGeoOpensForCountryParams) ([]mailchimp.CampaignGeoOpensForCountryReturnItem, error)
+	CampaignGeoOpensWithParamsFunc               func(ctx context.Context, params *mailchimp.CampaignGeoOpensParams) ([]mailchimp.CampaignGeoOpensResultItem, error)
+	CampaignMembersFunc                          func(ctx context.Context, parameters map[string]interface{}) (*mailchimp.CampaignMembersResult, error)
+	CampaignMembersPagerFunc                     func(ctx context.Context, parameters map[string]interface{}) *mailchimp.Pager[mailchimp.CampaignMembersResultDataItem]
+	CampaignMembersStreamFunc                    func(ctx context.Context, parameters map[string]interface{}, fn func(mailchimp.CampaignMembersResultDataItem) error) (int, error)
+	CampaignMembersWithParamsFunc                func(ctx context.Context, params *mailchimp.CampaignMembersParams) (*mailchimp.CampaignMembersResult, error)
+	CampaignNotOpenedAIMFunc                     func(ctx context.Context, parameters map[string]interface{}) (*mailchimp.CampaignNotOpenedAIMResult, error)
+	CampaignNotOpenedAIMPagerFunc                func(ctx context.Context, parameters map[string]interface{}) *mailchimp.Pager[string]
+	CampaignNotOpenedAIMStreamFunc               func(ctx context.Context, parameters map[string]interface{}, fn func(string) error) (int, error)
+	CampaignNotOpenedAIMWithParamsFunc           func(ctx context.Context, params *mailchimp.CampaignNotOpenedAIMParams) (*mailchimp.CampaignNotOpenedAIMResult, error)
+	CampaignOpenedAIMFunc                        func(ctx context.Context, parameters map[string]interface{}) (*mailchimp.CampaignOpenedAIMResult, error)
+	CampaignOpenedAIMPagerFunc                   func(ctx context.Context, parameters map[string]interface{}) *mailchimp.Pager[mailchimp.CampaignOpenedAIMResultDataItem]
+	CampaignOpenedAIMStreamFunc                  func(ctx context.Context, parameters map[string]interface{}, fn func(mailchimp.CampaignOpenedAIMResultDataItem) error) (int, error)
+	CampaignOpenedAIMWithParamsFunc              func(ctx context.Context, params *mailchimp.CampaignOpenedAIMParams) (*mailchimp.CampaignOpenedAIMResult, error)
+	CampaignPauseFunc                            func(ctx context.Context, parameters map[string]interface{}) (bool, error)
+	CampaignPauseWithParamsFunc                  func(ctx context.Context, params *mailchimp.CampaignPauseParams) (bool, error)
+	CampaignReplicateFunc                        func(ctx context.Context, parameters map[string]interface{}) (string, error)
+	CampaignReplicateWithParamsFunc              func(ctx context.Context, params *mailchimp.CampaignReplicateParams) (string, error)
+	CampaignResumeFunc                           func(ctx context.Context, parameters map[string]interface{}) (bool, error)
+	CampaignResumeWithParamsFunc                 func(ctx context.Context, params *mailchimp.CampaignResumeParams) (bool, error)
+	CampaignScheduleFunc                         func(ctx context.Context, parameters map[string]interface{}) (bool, error)
+	CampaignScheduleWithParamsFunc               func(ctx context.Context, params *mailchimp.CampaignScheduleParams) (bool, error)
+	CampaignSegmentTestFunc                      func(ctx context.Context, parameters map[string]interface{}) (int, error)
+	CampaignSegmentTestWithParamsFunc            func(ctx context.Context, params *mailchimp.CampaignSegmentTestParams) (int, error)
+	CampaignSendNowFunc                          func(ctx context.Context, parameters map[string]interface{}) (bool, error)
+	CampaignSendNowWithParamsFunc                func(ctx context.Context, params *mailchimp.CampaignSendNowParams) (bool, error)
+	CampaignSendTestFunc                         func(ctx context.Context, parameters map[string]interface{}) (bool, error)
+	CampaignSendTestWithParamsFunc               func(ctx context.Context, params *mailchimp.CampaignSendTestParams) (bool, error)
+	CampaignShareReportFunc                      func(ctx context.Context, parameters map[string]interface{}) (*mailchimp.CampaignShareReportResult, error)
+	CampaignShareReportWithParamsFunc            func(ctx context.Context, params *mailchimp.CampaignShareReportParams) (*mailchimp.CampaignShareReportResult, error)
+	CampaignStatsFunc                            func(ctx context.Context, parameters map[string]interface{}) (*mailchimp.CampaignStatsResult, error)
+	CampaignStatsWithParamsFunc                  func(ctx context.Context, params *mailchimp.CampaignStatsParams) (*mailchimp.CampaignStatsResult, error)
+	CampaignTemplateContentFunc                  func(ctx context.Context, parameters map[string]interface{}) (map[string]interface{}, error)
+	CampaignTemplateContentWithParamsFunc        func(ctx context.Context, params *mailchimp.CampaignTemplateContentParams) (map[string]interface{}, error)
+	CampaignUnscheduleFunc                       func(ctx context.Context, parameters map[string]interface{}) (bool, error)
+	CampaignUnscheduleWithParamsFunc             func(ctx context.Context, params *mailchimp.CampaignUnscheduleParams) (bool, error)
+	CampaignUnsubscribesFunc                     func(ctx context.Context, parameters map[string]interface{}) (*mailchimp.CampaignUnsubscribesResult, error)
+	CampaignUnsubscribesPagerFunc                func(ctx context.Context, parameters map[string]interface{}) *mailchimp.Pager[mailchimp.CampaignUnsubscribesResultDataItem]
+	CampaignUnsubscribesWithParamsFunc           func(ctx context.Context, params *mailchimp.CampaignUnsubscribesParams) (*mailchimp.CampaignUnsubscribesResult, error)
+	CampaignUpdateFunc                           func(ctx context.Context, parameters map[string]interface{}) (bool, error)
+	CampaignUpdateWithParamsFunc                 func(ctx context.Context, params *mailchimp.CampaignUpdateParams) (bool, error)
+	CampaignsFunc                                func(ctx context.Context, parameters map[string]interface{}) (*mailchimp.CampaignsResult, error)
+	CampaignsForEmailFunc                        func(ctx context.Context, parameters map[string]interface{}) ([]string, error)
+	CampaignsForEmailWithParamsFunc              func(ctx context.Context, params *mailchimp.CampaignsForEmailParams) ([]string, error)
+	CampaignsPagerFunc                           func(ctx context.Context, parameters map[string]interface{}) *mailchimp.Pager[mailchimp.CampaignsResultData]
+	CampaignsWithParamsFunc                      func(ctx context.Context, params *mailchimp.CampaignsParams) (*mailchimp.CampaignsResult, error)
+	ChimpChatterFunc                             func(ctx context.Context, parameters map[string]interface{}) ([]mailchimp.ChimpChatterResultItem, error)
+	EcommOrderAddFunc                            func(ctx context.Context, parameters map[string]interface{}) (bool, error)
+	EcommOrderAddWithParamsFunc                  func(ctx context.Context, params *mailchimp.EcommOrderAddParams) (bool, error)
+	EcommOrderDelFunc                            func(ctx context.Context, parameters map[string]interface{}) (bool, error)
+	EcommOrderDelWithParamsFunc                  func(ctx context.Context, params *mailchimp.EcommOrderDelParams) (bool, error)
+	EcommOrdersFunc                              func(ctx context.Context, parameters map[string]interface{}) (*mailchimp.EcommOrdersResult, error)
+	EcommOrdersPagerFunc                         func(ctx context.Context, parameters map[string]interface{}) *mailchimp.Pager[mailchimp.EcommOrdersResultDataItem]
+	EcommOrdersWithParamsFunc                    func(ctx context.Context, params *mailchimp.EcommOrdersParams) (*mailchimp.EcommOrdersResult, error)
+	EnsureWebhookFunc                            func(ctx context.Context, id string, hook mailchimp.Webhook) (bool, error)
+	FolderAddFunc                                func(ctx context.Context, parameters map[string]interface{}) (int, error)
+	FolderAddWithParamsFunc                      func(ctx context.Context, params *mailchimp.FolderAddParams) (int, error)
+	FolderDelFunc                                func(ctx context.Context, parameters map[string]interface{}) (bool, error)
+	FolderDelWithParamsFunc                      func(ctx context.Context, params *mailchimp.FolderDelParams) (bool, error)
+	FolderUpdateFunc                             func(ctx context.Context, parameters map[string]interface{}) (bool, error)
+	FolderUpdateWithParamsFunc                   func(ctx context.Context, params *mailchimp.FolderUpdateParams) (bool, error)
+	FoldersFunc                                  func(ctx context.Context, parameters map[string]interface{}) ([]mailchimp.FoldersResultItem, error)
+	FoldersWithParamsFunc                        func(ctx context.Context, params *mailchimp.FoldersParams) ([]mailchimp.FoldersResultItem, error)
+	GenerateTextFunc                             func(ctx context.Context, parameters map[string]interface{}) (string, error)
+	GenerateTextWithParamsFunc                   func(ctx context.Context, params *mailchimp.GenerateTextParams) (string, error)
+	GetAccountDetailsFunc                        func(ctx context.Context, parameters map[string]interface{}) (*mailchimp.GetAccountDetailsResult, error)
+	GetAccountDetailsWithParamsFunc              func(ctx context.Context, params *mailchimp.GetAccountDetailsParams) (*mailchimp.GetAccountDetailsResult, error)
+	GetVerifiedDomainsFunc                       func(ctx context.Context, parameters map[string]interface{}) ([]mailchimp.GetVerifiedDomainsResultItem, error)
+	GmonkeyActivityFunc                          func(ctx context.Context, parameters map[string]interface{}) ([]mailchimp.GmonkeyActivityResultItem, error)
+	GmonkeyAddFunc                               func(ctx context.Context, parameters map[string]interface{}) (*mailchimp.GmonkeyAddResult, error)
+	GmonkeyAddWithParamsFunc                     func(ctx context.Context, params *mailchimp.GmonkeyAddParams) (*mailchimp.GmonkeyAddResult, error)
+	GmonkeyDelFunc                               func(ctx context.Context, parameters map[string]interface{}) (*mailchimp.GmonkeyDelResult, error)
+	GmonkeyDelWithParamsFunc                     func(ctx context.Context, params *mailchimp.GmonkeyDelParams) (*mailchimp.GmonkeyDelResult, error)
+	GmonkeyMembersFunc                           func(ctx context.Context, parameters map[string]interface{}) ([]mailchimp.GmonkeyMembersItem, error)
+	InlineCssFunc                                func(ctx context.Context, parameters map[string]interface{}) (string, error)
+	InlineCssWithParamsFunc                      func(ctx context.Context, params *mailchimp.InlineCssParams) (string, error)
+	ListAbuseReportsFunc                         func(ctx context.Context, parameters map[string]interface{}) (*mailchimp.ListAbuseReportsResponse, error)
+	ListAbuseReportsPagerFunc                    func(ctx context.Context, parameters map[string]interface{}) *mailchimp.Pager[mailchimp.ListAbuseReportsResponseDataItem]
+	ListAbuseReportsWithParamsFunc               func(ctx context.Context, params *mailchimp.ListAbuseReportsParams) (*mailchimp.ListAbuseReportsResponse, error)
+	ListActivityFunc                             func(ctx context.Context, parameters map[string]interface{}) ([]mailchimp.ListActivityElement, error)
+	ListActivityWithParamsFunc                   func(ctx context.Context, params *mailchimp.ListActivityParams) ([]mailchimp.ListActivityElement, error)
+	ListBatchSubscribeFunc                       func(ctx context.Context, parameters map[string]interface{}) (*mailchimp.ListBatchSubscribeResponse, error)
+	ListBatchSubscribeWithParamsFunc             func(ctx context.Context, params *mailchimp.ListBatchSubscribeParams) (*mailchimp.ListBatchSubscribeResponse, error)
+	ListBatchUnsubscribeFunc                     func(ctx context.Context, parameters map[string]interface{}) (*mailchimp.ListBatchUnsubscribeResponse, error)
+	ListBatchUnsubscribeWithParamsFunc           func(ctx context.Context, params *mailchimp.ListBatchUnsubscribeParams) (*mailchimp.ListBatchUnsubscribeResponse, error)
+	ListClientsFunc                              func(ctx context.Context, parameters map[string]interface{}) (*mailchimp.ListClientsResponse, error)
+	ListClientsWithParamsFunc                    func(ctx context.Context, params *mailchimp.ListClientsParams) (*mailchimp.ListClientsResponse, error)
+	ListGrowthHistoryFunc                        func(ctx context.Context, parameters map[string]interface{}) (*mailchimp.ListGrowthHistoryResponse, error)
+	ListGrowthHistoryWithParamsFunc              func(ctx context.Context, params *mailchimp.ListGrowthHistoryParams) (*mailchimp.ListGrowthHistoryResponse, error)
+	ListInterestGroupAddFunc                     func(ctx context.Context, parameters map[string]interface{}) (bool, error)
+	ListInterestGroupAddWithParamsFunc           func(ctx context.Context, params *mailchimp.ListInterestGroupAddParams) (bool, error)
+	ListInterestGroupDelFunc                     func(ctx context.Context, parameters map[string]interface{}) (bool, error)
+	ListInterestGroupDelWithParamsFunc           func(ctx context.Context, params *mailchimp.ListInterestGroupDelParams) (bool, error)
+	ListInterestGroupUpdateFunc                  func(ctx context.Context, parameters map[string]interface{}) (bool, error)
+	ListInterestGroupUpdateWithParamsFunc        func(ctx context.Context, params *mailchimp.ListInterestGroupUpdateParams) (bool, error)
+	ListInterestGroupingAddFunc                  func(ctx context.Context, parameters map[string]interface{}) (int, error)
+	ListInterestGroupingAddWithParamsFunc        func(ctx context.Context, params *mailchimp.ListInterestGroupingAddParams) (int, error)
+	ListInterestGroupingDelFunc                  func(ctx context.Context, parameters map[string]interface{}) (bool, error)
+	ListInterestGroupingDelWithParamsFunc        func(ctx context.Context, params *mailchimp.ListInterestGroupingDelParams) (bool, error)
+	ListInterestGroupingUpdateFunc               func(ctx context.Context, parameters map[string]interface{}) (bool, error)
+	ListInterestGroupingUpdateWithParamsFunc     func(ctx context.Context, params *mailchimp.ListInterestGroupingUpdateParams) (bool, error)
+	ListInterestGroupingsFunc                    func(ctx context.Context, parameters map[string]interface{}) ([]mailchimp.ListInterestGroupingsElement, error)
+	ListInterestGroupingsWithParamsFunc          func(ctx context.Context, params *mailchimp.ListInterestGroupingsParams) ([]mailchimp.ListInterestGroupingsElement, error)
+	ListMemberActivityFunc                       func(ctx context.Context, parameters map[string]interface{}) (*mailchimp.ListMemberActivityResponse, error)
+	ListMemberActivityWithParamsFunc             func(ctx context.Context, params *mailchimp.ListMemberActivityParams) (*mailchimp.ListMemberActivityResponse, error)
+	ListMemberInfoFunc                           func(ctx context.Context, parameters map[string]interface{}) (*mailchimp.ListMemberInfoResponse, error)
+	ListMemberInfoWithParamsFunc                 func(ctx context.Context, params *mailchimp.ListMemberInfoParams) (*mailchimp.ListMemberInfoResponse, error)
+	ListMembersChangedSinceFunc                  func(ctx context.Context, id string, status string, since time.Time) *mailchimp.Pager[mailchimp.ListMember]
+	ListMembersFunc                              func(ctx context.Context, parameters map[string]interface{}) (*mailchimp.ListMembersResponse, error)
+	ListMembersPagerFunc                         func(ctx context.Context, parameters map[string]interface{}) *mailchimp.Pager[mailchimp.ListMember]
+	ListMembersWithParamsFunc                    func(ctx context.Context, params *mailchimp.ListMembersParams) (*mailchimp.ListMembersResponse, error)
+	ListMergeVarAddFunc                          func(ctx context.Context, parameters map[string]interface{}) (bool, error)
+	ListMergeVarAddWithParamsFunc                func(ctx context.Context, params *mailchimp.ListMergeVarAddParams) (bool, error)
+	ListMergeVarDelFunc                          func(ctx context.Context, parameters map[string]interface{}) (bool, error)
+	ListMergeVarDelWithParamsFunc                func(ctx context.Context, params *mailchimp.ListMergeVarDelParams) (bool, error)
+	ListMergeVarResetFunc                        func(ctx context.Context, parameters map[string]interface{}) (bool, error)
+	ListMergeVarResetWithParamsFunc              func(ctx context.Context, params *mailchimp.ListMergeVarResetParams) (bool, error)
+	ListMergeVarSetValueFunc                     func(ctx context.Context, parameters map[string]interface{}) (bool, error)
+	ListMergeVarSetValueWithParamsFunc           func(ctx context.Context, params *mailchimp.ListMergeVarSetValueParams) (bool, error)
+	ListMergeVarUpdateFunc                       func(ctx context.Context, parameters map[string]interface{}) (bool, error)
+	ListMergeVarUpdateWithParamsFunc             func(ctx context.Context, params *mailchimp.ListMergeVarUpdateParams) (bool, error)
+	ListMergeVarsFunc                            func(ctx context.Context, parameters map[string]interface{}) ([]mailchimp.MergeVar, error)
+	ListMergeVarsWithParamsFunc                  func(ctx context.Context, params *mailchimp.ListMergeVarsParams) ([]mailchimp.MergeVar, error)
+	ListStaticSegmentAddFunc                     func(ctx context.Context, parameters map[string]interface{}) (int, error)
+	ListStaticSegmentAddWithParamsFunc           func(ctx context.Context, params *mailchimp.ListStaticSegmentAddParams) (int, error)
+	ListStaticSegmentDelFunc                     func(ctx context.Context, parameters map[string]interface{}) (bool, error)
+	ListStaticSegmentDelWithParamsFunc           func(ctx context.Context, params *mailchimp.ListStaticSegmentDelParams) (bool, error)
+	ListStaticSegmentMembersAddFunc              func(ctx context.Context, parameters map[string]interface{}) (*mailchimp.StaticSegmentMembersResponse, error)
+	ListStaticSegmentMembersAddWithParamsFunc    func(ctx context.Context, params *mailchimp.ListStaticSegmentMembersAddParams) (*mailchimp.StaticSegmentMembersResponse, error)
+	ListStaticSegmentMembersDelFunc              func(ctx context.Context, parameters map[string]interface{}) (*mailchimp.StaticSegmentMembersResponse, error)
+	ListStaticSegmentMembersDelWithParamsFunc    func(ctx context.Context, params *mailchimp.ListStaticSegmentMembersDelParams) (*mailchimp.StaticSegmentMembersResponse, error)
+	ListStaticSegmentResetFunc                   func(ctx context.Context, parameters map[string]interface{}) (bool, error)
+	ListStaticSegmentResetWithParamsFunc         func(ctx context.Context, params *mailchimp.ListStaticSegmentResetParams) (bool, error)
+	ListStaticSegmentsFunc                       func(ctx context.Context, parameters map[string]interface{}) ([]mailchimp.StaticSegment, error)
+	ListStaticSegmentsWithParamsFunc             func(ctx context.Context, params *mailchimp.ListStaticSegmentsParams) ([]mailchimp.StaticSegment, error)
+	ListSubscribeFunc                            func(ctx context.Context, parameters map[string]interface{}) (bool, error)
+	ListSubscribeWithParamsFunc                  func(ctx context.Context, params *mailchimp.ListSubscribeParams) (bool, error)
+	ListUnsubscribeFunc                          func(ctx context.Context, parameters map[string]interface{}) (bool, error)
+	ListUnsubscribeWithParamsFunc                func(ctx context.Context, params *mailchimp.ListUnsubscribeParams) (bool, error)
+	ListUpdateMemberFunc                         func(ctx context.Context, parameters map[string]interface{}) (bool, error)
+	ListUpdateMemberWithParamsFunc               func(ctx context.Context, params *mailchimp.ListUpdateMemberParams) (bool, error)
+	ListWebhookAddFunc                           func(ctx context.Context, parameters map[string]interface{}) (bool, error)
+	ListWebhookAddWithParamsFunc                 func(ctx context.Context, params *mailchimp.ListWebhookAddParams) (bool, error)
+	ListWebhookDelFunc                           func(ctx context.Context, parameters map[string]interface{}) (bool, error)
+	ListWebhookDelWithParamsFunc                 func(ctx context.Context, params *mailchimp.ListWebhookDelParams) (bool, error)
+	ListWebhooksFunc                             func(ctx context.Context, parameters map[string]interface{}) ([]mailchimp.Webhook, error)
+	ListWebhooksWithParamsFunc                   func(ctx context.Context, params *mailchimp.ListWebhooksParams) ([]mailchimp.Webhook, error)
+	ListsForEmailFunc                            func(ctx context.Context, parameters map[string]interface{}) ([]string, error)
+	ListsForEmailWithParamsFunc                  func(ctx context.Context, params *mailchimp.ListsForEmailParams) ([]string, error)
+	PingFunc                                     func(ctx context.Context) (string, error)
+	SearchMembersFunc                            func(ctx context.Context, parameters map[string]interface{}) (*mailchimp.SearchMembersResponse, error)
+	SearchMembersPagerFunc                       func(ctx context.Context, parameters map[string]interface{}) *mailchimp.Pager[mailchimp.MemberInfo]
+	SearchMembersWithParamsFunc                  func(ctx context.Context, params *mailchimp.SearchMembersParams) (*mailchimp.SearchMembersResponse, error)
+}
+
+func (m *Mock) CampaignAbuseReports(parameters map[string]interface{}) (r0 *mailchimp.CampaignAbuseReportsResult, err error) {
+	m.record("CampaignAbuseReports", parameters)
+	if m.CampaignAbuseReportsFunc == nil {
+		err = NotMockedError{"CampaignAbuseReports"}
+		return
+	}
+	return m.CampaignAbuseReportsFunc(context.Background(), parameters)
+}
+
+func (m *Mock) CampaignAbuseReportsContext(ctx context.Context, parameters map[string]interface{}) (r0 *mailchimp.CampaignAbuseReportsResult, err error) {
+	m.record("CampaignAbuseReportsContext", parameters)
+	if m.CampaignAbuseReportsFunc == nil {
+		err = NotMockedError{"CampaignAbuseReportsContext"}
+		return
+	}
+	return m.CampaignAbuseReportsFunc(ctx, parameters)
+}
+
+func (m *Mock) CampaignAbuseReportsPager(ctx context.Context, parameters map[string]interface{}) (r0 *mailchimp.Pager[mailchimp.CampaignAbuseReportsResultDataItem]) {
+	m.record("CampaignAbuseReportsPager", parameters)
+	if m.CampaignAbuseReportsPagerFunc == nil {
+		return mailchimp.PagerOf[mailchimp.CampaignAbuseReportsResultDataItem](nil, NotMockedError{"CampaignAbuseReportsPager"})
+	}
+	return m.CampaignAbuseReportsPagerFunc(ctx, parameters)
+}
+
+func (m *Mock) CampaignAbuseReportsWithParams(ctx context.Context, params *mailchimp.CampaignAbuseReportsParams) (r0 *mailchimp.CampaignAbuseReportsResult, err error) {
+	m.record("CampaignAbuseReportsWithParams", params)
+	if m.CampaignAbuseReportsWithParamsFunc != nil {
+		return m.CampaignAbuseReportsWithParamsFunc(ctx, params)
+	}
+	parameters, err := mailchimp.ToParameters(params)
+	if err != nil {
+		return
+	}
+	if m.CampaignAbuseReportsFunc == nil {
+		err = NotMockedError{"CampaignAbuseReportsWithParams"}
+		return
+	}
+	return m.CampaignAbuseReportsFunc(ctx, parameters)
+}
+
+func (m *Mock) CampaignAdvice(parameters map[string]interface{}) (r0 []mailchimp.CampaignAdviceResultItem, err error) {
+	m.record("CampaignAdvice", parameters)
+	if m.CampaignAdviceFunc == nil {
+		err = NotMockedError{"CampaignAdvice"}
+		return
+	}
+	return m.CampaignAdviceFunc(context.Background(), parameters)
+}
+
+func (m *Mock) CampaignAdviceContext(ctx context.Context, parameters map[string]interface{}) (r0 []mailchimp.CampaignAdviceResultItem, err error) {
+	m.record("CampaignAdviceContext", parameters)
+	if m.CampaignAdviceFunc == nil {
+		err = NotMockedError{"CampaignAdviceContext"}
+		return
+	}
+	return m.CampaignAdviceFunc(ctx, parameters)
+}
+
+func (m *Mock) CampaignAdviceWithParams(ctx context.Context, params *mailchimp.CampaignAdviceParams) (r0 []mailchimp.CampaignAdviceResultItem, err error) {
+	m.record("CampaignAdviceWithParams", params)
+	if m.CampaignAdviceWithParamsFunc != nil {
+		return m.CampaignAdviceWithParamsFunc(ctx, params)
+	}
+	parameters, err := mailchimp.ToParameters(params)
+	if err != nil {
+		return
+	}
+	if m.CampaignAdviceFunc == nil {
+		err = NotMockedError{"CampaignAdviceWithParams"}
+		return
+	}
+	return m.CampaignAdviceFunc(ctx, parameters)
+}
+
+func (m *Mock) CampaignAnalytics(parameters map[string]interface{}) (r0 *mailchimp.CampaignAnalyticsResult, err error) {
+	m.record("CampaignAnalytics", parameters)
+	if m.CampaignAnalyticsFunc == nil {
+		err = NotMockedError{"CampaignAnalytics"}
+		return
+	}
+	return m.CampaignAnalyticsFunc(context.Background(), parameters)
+}
+
+func (m *Mock) CampaignAnalyticsContext(ctx context.Context, parameters map[string]interface{}) (r0 *mailchimp.CampaignAnalyticsResult, err error) {
+	m.record("CampaignAnalyticsContext", parameters)
+	if m.CampaignAnalyticsFunc == nil {
+		err = NotMockedError{"CampaignAnalyticsContext"}
+		return
+	}
+	return m.CampaignAnalyticsFunc(ctx, parameters)
+}
+
+func (m *Mock) CampaignAnalyticsWithParams(ctx context.Context, params *mailchimp.CampaignAnalyticsParams) (r0 *mailchimp.CampaignAnalyticsResult, err error) {
+	m.record("CampaignAnalyticsWithParams", params)
+	if m.CampaignAnalyticsWithParamsFunc != nil {
+		return m.CampaignAnalyticsWithParamsFunc(ctx, params)
+	}
+	parameters, err := mailchimp.ToParameters(params)
+	if err != nil {
+		return
+	}
+	if m.CampaignAnalyticsFunc == nil {
+		err = NotMockedError{"CampaignAnalyticsWithParams"}
+		return
+	}
+	return m.CampaignAnalyticsFunc(ctx, parameters)
+}
+
+func (m *Mock) CampaignBounceMessage(parameters map[string]interface{}) (r0 *mailchimp.CampaignBounceMessageResult, err error) {
+	m.record("CampaignBounceMessage", parameters)
+	if m.CampaignBounceMessageFunc == nil {
+		err = NotMockedError{"CampaignBounceMessage"}
+		return
+	}
+	return m.CampaignBounceMessageFunc(context.Background(), parameters)
+}
+
+func (m *Mock) CampaignBounceMessageContext(ctx context.Context, parameters map[string]interface{}) (r0 *mailchimp.CampaignBounceMessageResult, err error) {
+	m.record("CampaignBounceMessageContext", parameters)
+	if m.CampaignBounceMessageFunc == nil {
+		err = NotMockedError{"CampaignBounceMessageContext"}
+		return
+	}
+	return m.CampaignBounceMessageFunc(ctx, parameters)
+}
+
+func (m *Mock) CampaignBounceMessageWithParams(ctx context.Context, params *mailchimp.CampaignBounceMessageParams) (r0 *mailchimp.CampaignBounceMessageResult, err error) {
+	m.record("CampaignBounceMessageWithParams", params)
+	if m.CampaignBounceMessageWithParamsFunc != nil {
+		return m.CampaignBounceMessageWithParamsFunc(ctx, params)
+	}
+	parameters, err := mailchimp.ToParameters(params)
+	if err != nil {
+		return
+	}
+	if m.CampaignBounceMessageFunc == nil {
+		err = NotMockedError{"CampaignBounceMessageWithParams"}
+		return
+	}
+	return m.CampaignBounceMessageFunc(ctx, parameters)
+}
+
+func (m *Mock) CampaignBounceMessages(parameters map[string]interface{}) (r0 *mailchimp.CampaignBounceMessagesResult, err error) {
+	m.record("CampaignBounceMessages", parameters)
+	if m.CampaignBounceMessagesFunc == nil {
+		err = NotMockedError{"CampaignBounceMessages"}
+		return
+	}
+	return m.CampaignBounceMessagesFunc(context.Background(), parameters)
+}
+
+func (m *Mock) CampaignBounceMessagesContext(ctx context.Context, parameters map[string]interface{}) (r0 *mailchimp.CampaignBounceMessagesResult, err error) {
+	m.record("CampaignBounceMessagesContext", parameters)
+	if m.CampaignBounceMessagesFunc == nil {
+		err = NotMockedError{"CampaignBounceMessagesContext"}
+		return
+	}
+	return m.CampaignBounceMessagesFunc(ctx, parameters)
+}
+
+func (m *Mock) CampaignBounceMessagesPager(ctx context.Context, parameters map[string]interface{}) (r0 *mailchimp.Pager[mailchimp.CampaignBounceMessageResult]) {
+	m.record("CampaignBounceMessagesPager", parameters)
+	if m.CampaignBounceMessagesPagerFunc == nil {
+		return mailchimp.PagerOf[mailchimp.CampaignBounceMessageResult](nil, NotMockedError{"CampaignBounceMessagesPager"})
+	}
+	return m.CampaignBounceMessagesPagerFunc(ctx, parameters)
+}
+
+func (m *Mock) CampaignBounceMessagesWithParams(ctx context.Context, params *mailchimp.CampaignBounceMessagesParams) (r0 *mailchimp.CampaignBounceMessagesResult, err error) {
+	m.record("CampaignBounceMessagesWithParams", params)
+	if m.CampaignBounceMessagesWithParamsFunc != nil {
+		return m.CampaignBounceMessagesWithParamsFunc(ctx, params)
+	}
+	parameters, err := mailchimp.ToParameters(params)
+	if err != nil {
+		return
+	}
+	if m.CampaignBounceMessagesFunc == nil {
+		err = NotMockedError{"CampaignBounceMessagesWithParams"}
+		return
+	}
+	return m.CampaignBounceMessagesFunc(ctx, parameters)
+}
+
+func (m *Mock) CampaignClickDetailAIM(parameters map[string]interface{}) (r0 *mailchimp.CampaignClickDetailAIMResult, err error) {
+	m.record("CampaignClickDetailAIM", parameters)
+	if m.CampaignClickDetailAIMFunc == nil {
+		err = NotMockedError{"CampaignClickDetailAIM"}
+		return
+	}
+	return m.CampaignClickDetailAIMFunc(context.Background(), parameters)
+}
+
+func (m *Mock) CampaignClickDetailAIMContext(ctx context.Context, parameters map[string]interface{}) (r0 *mailchimp.CampaignClickDetailAIMResult, err error) {
+	m.record("CampaignClickDetailAIMContext", parameters)
+	if m.CampaignClickDetailAIMFunc == nil {
+		err = NotMockedError{"CampaignClickDetailAIMContext"}
+		return
+	}
+	return m.CampaignClickDetailAIMFunc(ctx, parameters)
+}
+
+func (m *Mock) CampaignClickDetailAIMPager(ctx context.Context, parameters map[string]interface{}) (r0 *mailchimp.Pager[mailchimp.CampaignClickDetailAIMResultDataItem]) {
+	m.record("CampaignClickDetailAIMPager", parameters)
+	if m.CampaignClickDetailAIMPagerFunc == nil {
+		return mailchimp.PagerOf[mailchimp.CampaignClickDetailAIMResultDataItem](nil, NotMockedError{"CampaignClickDetailAIMPager"})
+	}
+	return m.CampaignClickDetailAIMPagerFunc(ctx, parameters)
+}
+
+func (m *Mock) CampaignClickDetailAIMStream(ctx context.Context, parameters map[string]interface{}, fn func(mailchimp.CampaignClickDetailAIMResultDataItem) error) (r0 int, err error) {
+	m.record("CampaignClickDetailAIMStream", parameters, fn)
+	if m.CampaignClickDetailAIMStreamFunc == nil {
+		err = NotMockedError{"CampaignClickDetailAIMStream"}
+		return
+	}
+	return m.CampaignClickDetailAIMStreamFunc(ctx, parameters, fn)
+}
+
+func (m *Mock) CampaignClickDetailAIMWithParams(ctx context.Context, params *mailchimp.CampaignClickDetailAIMParams) (r0 *mailchimp.CampaignClickDetailAIMResult, err error) {
+	m.record("CampaignClickDetailAIMWithParams", params)
+	if m.CampaignClickDetailAIMWithParamsFunc != nil {
+		return m.CampaignClickDetailAIMWithParamsFunc(ctx, params)
+	}
+	parameters, err := mailchimp.ToParameters(params)
+	if err != nil {
+		return
+	}
+	if m.CampaignClickDetailAIMFunc == nil {
+		err = NotMockedError{"CampaignClickDetailAIMWithParams"}
+		return
+	}
+	return m.CampaignClickDetailAIMFunc(ctx, parameters)
+}
+
+func (m *Mock) CampaignClickStats(parameters map[string]interface{}) (r0 map[string]mailchimp.CampaignClickStatsResultItem, err error) {
+	m.record("CampaignClickStats", parameters)
+	if m.CampaignClickStatsFunc == nil {
+		err = NotMockedError{"CampaignClickStats"}
+		return
+	}
+	return m.CampaignClickStatsFunc(context.Background(), parameters)
+}
+
+func (m *Mock) CampaignClickStatsContext(ctx context.Context, parameters map[string]interface{}) (r0 map[string]mailchimp.CampaignClickStatsResultItem, err error) {
+	m.record("CampaignClickStatsContext", parameters)
+	if m.CampaignClickStatsFunc == nil {
+		err = NotMockedError{"CampaignClickStatsContext"}
+		return
+	}
+	return m.CampaignClickStatsFunc(ctx, parameters)
+}
+
+func (m *Mock) CampaignClickStatsWithParams(ctx context.Context, params *mailchimp.CampaignClickStatsParams) (r0 map[string]mailchimp.CampaignClickStatsResultItem, err error) {
+	m.record("CampaignClickStatsWithParams", params)
+	if m.CampaignClickStatsWithParamsFunc != nil {
+		return m.CampaignClickStatsWithParamsFunc(ctx, params)
+	}
+	parameters, err := mailchimp.ToParameters(params)
+	if err != nil {
+		return
+	}
+	if m.CampaignClickStatsFunc == nil {
+		err = NotMockedError{"CampaignClickStatsWithParams"}
+		return
+	}
+	return m.CampaignClickStatsFunc(ctx, parameters)
+}
+
+func (m *Mock) CampaignContent(parameters map[string]interface{}) (r0 *mailchimp.CampaignContentResult, err error) {
+	m.record("CampaignContent", parameters)
+	if m.CampaignContentFunc == nil {
+		err = NotMockedError{"CampaignContent"}
+		return
+	}
+	return m.CampaignContentFunc(context.Background(), parameters)
+}
+
+func (m *Mock) CampaignContentContext(ctx context.Context, parameters map[string]interface{}) (r0 *mailchimp.CampaignContentResult, err error) {
+	m.record("CampaignContentContext", parameters)
+	if m.CampaignContentFunc == nil {
+		err = NotMockedError{"CampaignContentContext"}
+		return
+	}
+	return m.CampaignContentFunc(ctx, parameters)
+}
+
+func (m *Mock) CampaignContentWithParams(ctx context.Context, params *mailchimp.CampaignContentParams) (r0 *mailchimp.CampaignContentResult, err error) {
+	m.record("CampaignContentWithParams", params)
+	if m.CampaignContentWithParamsFunc != nil {
+		return m.CampaignContentWithParamsFunc(ctx, params)
+	}
+	parameters, err := mailchimp.ToParameters(params)
+	if err != nil {
+		return
+	}
+	if m.CampaignContentFunc == nil {
+		err = NotMockedError{"CampaignContentWithParams"}
+		return
+	}
+	return m.CampaignContentFunc(ctx, parameters)
+}
+
+func (m *Mock) CampaignCreate(parameters map[string]interface{}) (r0 string, err error) {
+	m.record("CampaignCreate", parameters)
+	if m.CampaignCreateFunc == nil {
+		err = NotMockedError{"CampaignCreate"}
+		return
+	}
+	return m.CampaignCreateFunc(context.Background(), parameters)
+}
+
+func (m *Mock) CampaignCreateContext(ctx context.Context, parameters map[string]interface{}) (r0 string, err error) {
+	m.record("CampaignCreateContext", parameters)
+	if m.CampaignCreateFunc == nil {
+		err = NotMockedError{"CampaignCreateContext"}
+		return
+	}
+	return m.CampaignCreateFunc(ctx, parameters)
+}
+
+func (m *Mock) CampaignCreateWithParams(ctx context.Context, params *mailchimp.CampaignCreateParams) (r0 string, err error) {
+	m.record("CampaignCreateWithParams", params)
+	if m.CampaignCreateWithParamsFunc != nil {
+		return m.CampaignCreateWithParamsFunc(ctx, params)
+	}
+	parameters, err := mailchimp.ToParameters(params)
+	if err != nil {
+		return
+	}
+	if m.CampaignCreateFunc == nil {
+		err = NotMockedError{"CampaignCreateWithParams"}
+		return
+	}
+	return m.CampaignCreateFunc(ctx, parameters)
+}
+
+func (m *Mock) CampaignDelete(parameters map[string]interface{}) (r0 bool, err error) {
+	m.record("CampaignDelete", parameters)
+	if m.CampaignDeleteFunc == nil {
+		err = NotMockedError{"CampaignDelete"}
+		return
+	}
+	return m.CampaignDeleteFunc(context.Background(), parameters)
+}
+
+func (m *Mock) CampaignDeleteContext(ctx context.Context, parameters map[string]interface{}) (r0 bool, err error) {
+	m.record("CampaignDeleteContext", parameters)
+	if m.CampaignDeleteFunc == nil {
+		err = NotMockedError{"CampaignDeleteContext"}
+		return
+	}
+	return m.CampaignDeleteFunc(ctx, parameters)
+}
+
+func (m *Mock) CampaignDeleteWithParams(ctx context.Context, params *mailchimp.CampaignDeleteParams) (r0 bool, err error) {
+	m.record("CampaignDeleteWithParams", params)
+	if m.CampaignDeleteWithParamsFunc != nil {
+		return m.CampaignDeleteWithParamsFunc(ctx, params)
+	}
+	parameters, err := mailchimp.ToParameters(params)
+	if err != nil {
+		return
+	}
+	if m.CampaignDeleteFunc == nil {
+		err = NotMockedError{"CampaignDeleteWithParams"}
+		return
+	}
+	return m.CampaignDeleteFunc(ctx, parameters)
+}
+
+func (m *Mock) CampaignEcommOrderAdd(parameters map[string]interface{}) (r0 bool, err error) {
+	m.record("CampaignEcommOrderAdd", parameters)
+	if m.CampaignEcommOrderAddFunc == nil {
+		err = NotMockedError{"CampaignEcommOrderAdd"}
+		return
+	}
+	return m.CampaignEcommOrderAddFunc(context.Background(), parameters)
+}
+
+func (m *Mock) CampaignEcommOrderAddContext(ctx context.Context, parameters map[string]interface{}) (r0 bool, err error) {
+	m.record("CampaignEcommOrderAddContext", parameters)
+	if m.CampaignEcommOrderAddFunc == nil {
+		err = NotMockedError{"CampaignEcommOrderAddContext"}
+		return
+	}
+	return m.CampaignEcommOrderAddFunc(ctx, parameters)
+}
+
+func (m *Mock) CampaignEcommOrderAddWithParams(ctx context.Context, params *mailchimp.CampaignEcommOrderAddParams) (r0 bool, err error) {
+	m.record("CampaignEcommOrderAddWithParams", params)
+	if m.CampaignEcommOrderAddWithParamsFunc != nil {
+		return m.CampaignEcommOrderAddWithParamsFunc(ctx, params)
+	}
+	parameters, err := mailchimp.ToParameters(params)
+	if err != nil {
+		return
+	}
+	if m.CampaignEcommOrderAddFunc == nil {
+		err = NotMockedError{"CampaignEcommOrderAddWithParams"}
+		return
+	}
+	return m.CampaignEcommOrderAddFunc(ctx, parameters)
+}
+
+func (m *Mock) CampaignEcommOrders(parameters map[string]interface{}) (r0 *mailchimp.CampaignEcommOrdersResult, err error) {
+	m.record("CampaignEcommOrders", parameters)
+	if m.CampaignEcommOrdersFunc == nil {
+		err = NotMockedError{"CampaignEcommOrders"}
+		return
+	}
+	return m.CampaignEcommOrdersFunc(context.Background(), parameters)
+}
+
+func (m *Mock) CampaignEcommOrdersContext(ctx context.Context, parameters map[string]interface{}) (r0 *mailchimp.CampaignEcommOrdersResult, err error) {
+	m.record("CampaignEcommOrdersContext", parameters)
+	if m.CampaignEcommOrdersFunc == nil {
+		err = NotMockedError{"CampaignEcommOrdersContext"}
+		return
+	}
+	return m.CampaignEcommOrdersFunc(ctx, parameters)
+}
+
+func (m *Mock) CampaignEcommOrdersPager(ctx context.Context, parameters map[string]interface{}) (r0 *mailchimp.Pager[mailchimp.CampaignEcommOrdersResultDataItem]) {
+	m.record("CampaignEcommOrdersPager", parameters)
+	if m.CampaignEcommOrdersPagerFunc == nil {
+		return mailchimp.PagerOf[mailchimp.CampaignEcommOrdersResultDataItem](nil, NotMockedError{"CampaignEcommOrdersPager"})
+	}
+	return m.CampaignEcommOrdersPagerFunc(ctx, parameters)
+}
+
+func (m *Mock) CampaignEcommOrdersWithParams(ctx context.Context, params *mailchimp.CampaignEcommOrdersParams) (r0 *mailchimp.CampaignEcommOrdersResult, err error) {
+	m.record("CampaignEcommOrdersWithParams", params)
+	if m.CampaignEcommOrdersWithParamsFunc != nil {
+		return m.CampaignEcommOrdersWithParamsFunc(ctx, params)
+	}
+	parameters, err := mailchimp.ToParameters(params)
+	if err != nil {
+		return
+	}
+	if m.CampaignEcommOrdersFunc == nil {
+		err = NotMockedError{"CampaignEcommOrdersWithParams"}
+		return
+	}
+	return m.CampaignEcommOrdersFunc(ctx, parameters)
+}
+
+func (m *Mock) CampaignEepUrlStats(parameters map[string]interface{}) (r0 *mailchimp.CampaignEepUrlStatsResult, err error) {
+	m.record("CampaignEepUrlStats", parameters)
+	if m.CampaignEepUrlStatsFunc == nil {
+		err = NotMockedError{"CampaignEepUrlStats"}
+		return
+	}
+	return m.CampaignEepUrlStatsFunc(context.Background(), parameters)
+}
+
+func (m *Mock) CampaignEepUrlStatsContext(ctx context.Context, parameters map[string]interface{}) (r0 *mailchimp.CampaignEepUrlStatsResult, err error) {
+	m.record("CampaignEepUrlStatsContext", parameters)
+	if m.CampaignEepUrlStatsFunc == nil {
+		err = NotMockedError{"CampaignEepUrlStatsContext"}
+		return
+	}
+	return m.CampaignEepUrlStatsFunc(ctx, parameters)
+}
+
+func (m *Mock) CampaignEepUrlStatsWithParams(ctx context.Context, params *mailchimp.CampaignEepUrlStatsParams) (r0 *mailchimp.CampaignEepUrlStatsResult, err error) {
+	m.record("CampaignEepUrlStatsWithParams", params)
+	if m.CampaignEepUrlStatsWithParamsFunc != nil {
+		return m.CampaignEepUrlStatsWithParamsFunc(ctx, params)
+	}
+	parameters, err := mailchimp.ToParameters(params)
+	if err != nil {
+		return
+	}
+	if m.CampaignEepUrlStatsFunc == nil {
+		err = NotMockedError{"CampaignEepUrlStatsWithParams"}
+		return
+	}
+	return m.CampaignEepUrlStatsFunc(ctx, parameters)
+}
+
+func (m *Mock) CampaignEmailDomainPerformance(parameters map[string]interface{}) (r0 []mailchimp.CampaignEmailDomainPerformanceResultItem, err error) {
+	m.record("CampaignEmailDomainPerformance", parameters)
+	if m.CampaignEmailDomainPerformanceFunc == nil {
+		err = NotMockedError{"CampaignEmailDomainPerformance"}
+		return
+	}
+	return m.CampaignEmailDomainPerformanceFunc(context.Background(), parameters)
+}
+
+func (m *Mock) CampaignEmailDomainPerformanceContext(ctx context.Context, parameters map[string]interface{}) (r0 []mailchimp.CampaignEmailDomainPerformanceResultItem, err error) {
+	m.record("CampaignEmailDomainPerformanceContext", parameters)
+	if m.CampaignEmailDomainPerformanceFunc == nil {
+		err = NotMockedError{"CampaignEmailDomainPerformanceContext"}
+		return
+	}
+	return m.CampaignEmailDomainPerformanceFunc(ctx, parameters)
+}
+
+func (m *Mock) CampaignEmailDomainPerformanceWithParams(ctx context.Context, params *mailchimp.CampaignEmailDomainPerformanceParams) (r0 []mailchimp.CampaignEmailDomainPerformanceResultItem, err error) {
+	m.record("CampaignEmailDomainPerformanceWithParams", params)
+	if m.CampaignEmailDomainPerformanceWithParamsFunc != nil {
+		return m.CampaignEmailDomainPerformanceWithParamsFunc(ctx, params)
+	}
+	parameters, err := mailchimp.ToParameters(params)
+	if err != nil {
+		return
+	}
+	if m.CampaignEmailDomainPerformanceFunc == nil {
+		err = NotMockedError{"CampaignEmailDomainPerformanceWithParams"}
+		return
+	}
+	return m.CampaignEmailDomainPerformanceFunc(ctx, parameters)
+}
+
+func (m *Mock) CampaignEmailStatsAIM(parameters map[string]interface{}) (r0 *mailchimp.CampaignEmailStatsAIMResult, err error) {
+	m.record("CampaignEmailStatsAIM", parameters)
+	if m.CampaignEmailStatsAIMFunc == nil {
+		err = NotMockedError{"CampaignEmailStatsAIM"}
+		return
+	}
+	return m.CampaignEmailStatsAIMFunc(context.Background(), parameters)
+}
+
+func (m *Mock) CampaignEmailStatsAIMAll(parameters map[string]interface{}) (r0 *mailchimp.CampaignEmailStatsAIMAllResult, err error) {
+	m.record("CampaignEmailStatsAIMAll", parameters)
+	if m.CampaignEmailStatsAIMAllFunc == nil {
+		err = NotMockedError{"CampaignEmailStatsAIMAll"}
+		return
+	}
+	return m.CampaignEmailStatsAIMAllFunc(context.Background(), parameters)
+}
+
+func (m *Mock) CampaignEmailStatsAIMAllContext(ctx context.Context, parameters map[string]interface{}) (r0 *mailchimp.CampaignEmailStatsAIMAllResult, err error) {
+	m.record("CampaignEmailStatsAIMAllContext", parameters)
+	if m.CampaignEmailStatsAIMAllFunc == nil {
+		err = NotMockedError{"CampaignEmailStatsAIMAllContext"}
+		return
+	}
+	return m.CampaignEmailStatsAIMAllFunc(ctx, parameters)
+}
+
+func (m *Mock) CampaignEmailStatsAIMAllPager(ctx context.Context, parameters map[string]interface{}) (r0 *mailchimp.Pager[mailchimp.CampaignEmailStatsAIMAllItem]) {
+	m.record("CampaignEmailStatsAIMAllPager", parameters)
+	if m.CampaignEmailStatsAIMAllPagerFunc == nil {
+		return mailchimp.PagerOf[mailchimp.CampaignEmailStatsAIMAllItem](nil, NotMockedError{"CampaignEmailStatsAIMAllPager"})
+	}
+	return m.CampaignEmailStatsAIMAllPagerFunc(ctx, parameters)
+}
+
+func (m *Mock) CampaignEmailStatsAIMAllStream(ctx context.Context, parameters map[string]interface{}, fn func(mailchimp.CampaignEmailStatsAIMAllItem) error) (r0 int, err error) {
+	m.record("CampaignEmailStatsAIMAllStream", parameters, fn)
+	if m.CampaignEmailStatsAIMAllStreamFunc == nil {
+		err = NotMockedError{"CampaignEmailStatsAIMAllStream"}
+		return
+	}
+	return m.CampaignEmailStatsAIMAllStreamFunc(ctx, parameters, fn)
+}
+
+func (m *Mock) CampaignEmailStatsAIMAllWithParams(ctx context.Context, params *mailchimp.CampaignEmailStatsAIMAllParams) (r0 *mailchimp.CampaignEmailStatsAIMAllResult, err error) {
+	m.record("CampaignEmailStatsAIMAllWithParams", params)
+	if m.CampaignEmailStatsAIMAllWithParamsFunc != nil {
+		return m.CampaignEmailStatsAIMAllWithParamsFunc(ctx, params)
+	}
+	parameters, err := mailchimp.ToParameters(params)
+	if err != nil {
+		return
+	}
+	if m.CampaignEmailStatsAIMAllFunc == nil {
+		err = NotMockedError{"CampaignEmailStatsAIMAllWithParams"}
+		return
+	}
+	return m.CampaignEmailStatsAIMAllFunc(ctx, parameters)
+}
+
+func (m *Mock) CampaignEmailStatsAIMContext(ctx context.Context, parameters map[string]interface{}) (r0 *mailchimp.CampaignEmailStatsAIMResult, err error) {
+	m.record("CampaignEmailStatsAIMContext", parameters)
+	if m.CampaignEmailStatsAIMFunc == nil {
+		err = NotMockedError{"CampaignEmailStatsAIMContext"}
+		return
+	}
+	return m.CampaignEmailStatsAIMFunc(ctx, parameters)
+}
+
+func (m *Mock) CampaignEmailStatsAIMWithParams(ctx context.Context, params *mailchimp.CampaignEmailStatsAIMParams) (r0 *mailchimp.CampaignEmailStatsAIMResult, err error) {
+	m.record("CampaignEmailStatsAIMWithParams", params)
+	if m.CampaignEmailStatsAIMWithParamsFunc != nil {
+		return m.CampaignEmailStatsAIMWithParamsFunc(ctx, params)
+	}
+	parameters, err := mailchimp.ToParameters(params)
+	if err != nil {
+		return
+	}
+	if m.CampaignEmailStatsAIMFunc == nil {
+		err = NotMockedError{"CampaignEmailStatsAIMWithParams"}
+		return
+	}
+	return m.CampaignEmailStatsAIMFunc(ctx, parameters)
+}
+
+func (m *Mock) CampaignGeoOpens(parameters map[string]interface{}) (r0 []mailchimp.CampaignGeoOpensResultItem, err error) {
+	m.record("CampaignGeoOpens", parameters)
+	if m.CampaignGeoOpensFunc == nil {
+		err = NotMockedError{"CampaignGeoOpens"}
+		return
+	}
+	return m.CampaignGeoOpensFunc(context.Background(), parameters)
+}
+
+func (m *Mock) CampaignGeoOpensContext(ctx context.Context, parameters map[string]interface{}) (r0 []mailchimp.CampaignGeoOpensResultItem, err error) {
+	m.record("CampaignGeoOpensContext", parameters)
+	if m.CampaignGeoOpensFunc == nil {
+		err = NotMockedError{"CampaignGeoOpensContext"}
+		return
+	}
+	return m.CampaignGeoOpensFunc(ctx, parameters)
+}
+
+func (m *Mock) CampaignGeoOpensForCountry(parameters map[string]interface{}) (r0 []mailchimp.CampaignGeoOpensForCountryReturnItem, err error) {
+	m.record("CampaignGeoOpensForCountry", parameters)
+	if m.CampaignGeoOpensForCountryFunc == nil {
+		err = NotMockedError{"CampaignGeoOpensForCountry"}
+		return
+	}
+	return m.CampaignGeoOpensForCountryFunc(context.Background(), parameters)
+}
+
+func (m *Mock) CampaignGeoOpensForCountryContext(ctx context.Context, parameters map[string]interface{}) (r0 []mailchimp.CampaignGeoOpensForCountryReturnItem, err error) {
+	m.record("CampaignGeoOpensForCountryContext", parameters)
+	if m.CampaignGeoOpensForCountryFunc == nil {
+		err = NotMockedError{"CampaignGeoOpensForCountryContext"}
+		return
+	}
+	return m.CampaignGeoOpensForCountryFunc(ctx, parameters)
+}
+
+func (m *Mock) CampaignGeoOpensForCountryWithParams(ctx context.Context, params *mailchimp.CampaignGeoOpensForCountryParams) (r0 []mailchimp.CampaignGeoOpensForCountryReturnItem, err error) {
+	m.record("CampaignGeoOpensForCountryWithParams", params)
+	if m.CampaignGeoOpensForCountryWithParamsFunc != nil {
+		return m.CampaignGeoOpensForCountryWithParamsFunc(ctx, params)
+	}
+	parameters, err := mailchimp.ToParameters(params)
+	if err != nil {
+		return
+	}
+	if m.CampaignGeoOpensForCountryFunc == nil {
+		err = NotMockedError{"CampaignGeoOpensForCountryWithParams"}
+		return
+	}
+	return m.CampaignGeoOpensForCountryFunc(ctx, parameters)
+}
+
+func (m *Mock) CampaignGeoOpensWithParams(ctx context.Context, params *mailchimp.CampaignGeoOpensParams) (r0 []mailchimp.CampaignGeoOpensResultItem, err error) {
+	m.record("CampaignGeoOpensWithParams", params)
+	if m.CampaignGeoOpensWithParamsFunc != nil {
+		return m.CampaignGeoOpensWithParamsFunc(ctx, params)
+	}
+	parameters, err := mailchimp.ToParameters(params)
+	if err != nil {
+		return
+	}
+	if m.CampaignGeoOpensFunc == nil {
+		err = NotMockedError{"CampaignGeoOpensWithParams"}
+		return
+	}
+	return m.CampaignGeoOpensFunc(ctx, parameters)
+}
+
+func (m *Mock) CampaignMembers(parameters map[string]interface{}) (r0 *mailchimp.CampaignMembersResult, err error) {
+	m.record("CampaignMembers", parameters)
+	if m.CampaignMembersFunc == nil {
+		err = NotMockedError{"CampaignMembers"}
+		return
+	}
+	return m.CampaignMembersFunc(context.Background(), parameters)
+}
+
+func (m *Mock) CampaignMembersContext(ctx context.Context, parameters map[string]interface{}) (r0 *mailchimp.CampaignMembersResult, err error) {
+	m.record("CampaignMembersContext", parameters)
+	if m.CampaignMembersFunc == nil {
+		err = NotMockedError{"CampaignMembersContext"}
+		return
+	}
+	return m.CampaignMembersFunc(ctx, parameters)
+}
+
+func (m *Mock) CampaignMembersPager(ctx context.Context, parameters map[string]interface{}) (r0 *mailchimp.Pager[mailchimp.CampaignMembersResultDataItem]) {
+	m.record("CampaignMembersPager", parameters)
+	if m.CampaignMembersPagerFunc == nil {
+		return mailchimp.PagerOf[mailchimp.CampaignMembersResultDataItem](nil, NotMockedError{"CampaignMembersPager"})
+	}
+	return m.CampaignMembersPagerFunc(ctx, parameters)
+}
+
+func (m *Mock) CampaignMembersStream(ctx context.Context, parameters map[string]interface{}, fn func(mailchimp.CampaignMembersResultDataItem) error) (r0 int, err error) {
+	m.record("CampaignMembersStream", parameters, fn)
+	if m.CampaignMembersStreamFunc == nil {
+		err = NotMockedError{"CampaignMembersStream"}
+		return
+	}
+	return m.CampaignMembersStreamFunc(ctx, parameters, fn)
+}
+
+func (m *Mock) CampaignMembersWithParams(ctx context.Context, params *mailchimp.CampaignMembersParams) (r0 *mailchimp.CampaignMembersResult, err error) {
+	m.record("CampaignMembersWithParams", params)
+	if m.CampaignMembersWithParamsFunc != nil {
+		return m.CampaignMembersWithParamsFunc(ctx, params)
+	}
+	parameters, err := mailchimp.ToParameters(params)
+	if err != nil {
+		return
+	}
+	if m.CampaignMembersFunc == nil {
+		err = NotMockedError{"CampaignMembersWithParams"}
+		return
+	}
+	return m.CampaignMembersFunc(ctx, parameters)
+}
+
+func (m *Mock) CampaignNotOpenedAIM(parameters map[string]interface{}) (r0 *mailchimp.CampaignNotOpenedAIMResult, err error) {
+	m.record("CampaignNotOpenedAIM", parameters)
+	if m.CampaignNotOpenedAIMFunc == nil {
+		err = NotMockedError{"CampaignNotOpenedAIM"}
+		return
+	}
+	return m.CampaignNotOpenedAIMFunc(context.Background(), parameters)
+}
+
+func (m *Mock) CampaignNotOpenedAIMContext(ctx context.Context, parameters map[string]interface{}) (r0 *mailchimp.CampaignNotOpenedAIMResult, err error) {
+	m.record("CampaignNotOpenedAIMContext", parameters)
+	if m.CampaignNotOpenedAIMFunc == nil {
+		err = NotMockedError{"CampaignNotOpenedAIMContext"}
+		return
+	}
+	return m.CampaignNotOpenedAIMFunc(ctx, parameters)
+}
+
+func (m *Mock) CampaignNotOpenedAIMPager(ctx context.Context, parameters map[string]interface{}) (r0 *mailchimp.Pager[string]) {
+	m.record("CampaignNotOpenedAIMPager", parameters)
+	if m.CampaignNotOpenedAIMPagerFunc == nil {
+		return mailchimp.PagerOf[string](nil, NotMockedError{"CampaignNotOpenedAIMPager"})
+	}
+	return m.CampaignNotOpenedAIMPagerFunc(ctx, parameters)
+}
+
+func (m *Mock) CampaignNotOpenedAIMStream(ctx context.Context, parameters map[string]interface{}, fn func(string) error) (r0 int, err error) {
+	m.record("CampaignNotOpenedAIMStream", parameters, fn)
+	if m.CampaignNotOpenedAIMStreamFunc == nil {
+		err = NotMockedError{"CampaignNotOpenedAIMStream"}
+		return
+	}
+	return m.CampaignNotOpenedAIMStreamFunc(ctx, parameters, fn)
+}
+
+func (m *Mock) CampaignNotOpenedAIMWithParams(ctx context.Context, params *mailchimp.CampaignNotOpenedAIMParams) (r0 *mailchimp.CampaignNotOpenedAIMResult, err error) {
+	m.record("CampaignNotOpenedAIMWithParams", params)
+	if m.CampaignNotOpenedAIMWithParamsFunc != nil {
+		return m.CampaignNotOpenedAIMWithParamsFunc(ctx, params)
+	}
+	parameters, err := mailchimp.ToParameters(params)
+	if err != nil {
+		return
+	}
+	if m.CampaignNotOpenedAIMFunc == nil {
+		err = NotMockedError{"CampaignNotOpenedAIMWithParams"}
+		return
+	}
+	return m.CampaignNotOpenedAIMFunc(ctx, parameters)
+}
+
+func (m *Mock) CampaignOpenedAIM(parameters map[string]interface{}) (r0 *mailchimp.CampaignOpenedAIMResult, err error) {
+	m.record("CampaignOpenedAIM", parameters)
+	if m.CampaignOpenedAIMFunc == nil {
+		err = NotMockedError{"CampaignOpenedAIM"}
+		return
+	}
+	return m.CampaignOpenedAIMFunc(context.Background(), parameters)
+}
+
+func (m *Mock) CampaignOpenedAIMContext(ctx context.Context, parameters map[string]interface{}) (r0 *mailchimp.CampaignOpenedAIMResult, err error) {
+	m.record("CampaignOpenedAIMContext", parameters)
+	if m.CampaignOpenedAIMFunc == nil {
+		err = NotMockedError{"CampaignOpenedAIMContext"}
+		return
+	}
+	return m.CampaignOpenedAIMFunc(ctx, parameters)
+}
+
+func (m *Mock) CampaignOpenedAIMPager(ctx context.Context, parameters map[string]interface{}) (r0 *mailchimp.Pager[mailchimp.CampaignOpenedAIMResultDataItem]) {
+	m.record("CampaignOpenedAIMPager", parameters)
+	if m.CampaignOpenedAIMPagerFunc == nil {
+		return mailchimp.PagerOf[mailchimp.CampaignOpenedAIMResultDataItem](nil, NotMockedError{"CampaignOpenedAIMPager"})
+	}
+	return m.CampaignOpenedAIMPagerFunc(ctx, parameters)
+}
+
+func (m *Mock) CampaignOpenedAIMStream(ctx context.Context, parameters map[string]interface{}, fn func(mailchimp.CampaignOpenedAIMResultDataItem) error) (r0 int, err error) {
+	m.record("CampaignOpenedAIMStream", parameters, fn)
+	if m.CampaignOpenedAIMStreamFunc == nil {
+		err = NotMockedError{"CampaignOpenedAIMStream"}
+		return
+	}
+	return m.CampaignOpenedAIMStreamFunc(ctx, parameters, fn)
+}
+
+func (m *Mock) CampaignOpenedAIMWithParams(ctx context.Context, params *mailchimp.CampaignOpenedAIMParams) (r0 *mailchimp.CampaignOpenedAIMResult, err error) {
+	m.record("CampaignOpenedAIMWithParams", params)
+	if m.CampaignOpenedAIMWithParamsFunc != nil {
+		return m.CampaignOpenedAIMWithParamsFunc(ctx, params)
+	}
+	parameters, err := mailchimp.ToParameters(params)
+	if err != nil {
+		return
+	}
+	if m.CampaignOpenedAIMFunc == nil {
+		err = NotMockedError{"CampaignOpenedAIMWithParams"}
+		return
+	}
+	return m.CampaignOpenedAIMFunc(ctx, parameters)
+}
+
+func (m *Mock) CampaignPause(parameters map[string]interface{}) (r0 bool, err error) {
+	m.record("CampaignPause", parameters)
+	if m.CampaignPauseFunc == nil {
+		err = NotMockedError{"CampaignPause"}
+		return
+	}
+	return m.CampaignPauseFunc(context.Background(), parameters)
+}
+
+func (m *Mock) CampaignPauseContext(ctx context.Context, parameters map[string]interface{}) (r0 bool, err error) {
+	m.record("CampaignPauseContext", parameters)
+	if m.CampaignPauseFunc == nil {
+		err = NotMockedError{"CampaignPauseContext"}
+		return
+	}
+	return m.CampaignPauseFunc(ctx, parameters)
+}
+
+func (m *Mock) CampaignPauseWithParams(ctx context.Context, params *mailchimp.CampaignPauseParams) (r0 bool, err error) {
+	m.record("CampaignPauseWithParams", params)
+	if m.CampaignPauseWithParamsFunc != nil {
+		return m.CampaignPauseWithParamsFunc(ctx, params)
+	}
+	parameters, err := mailchimp.ToParameters(params)
+	if err != nil {
+		return
+	}
+	if m.CampaignPauseFunc == nil {
+		err = NotMockedError{"CampaignPauseWithParams"}
+		return
+	}
+	return m.CampaignPauseFunc(ctx, parameters)
+}
+
+func (m *Mock) CampaignReplicate(parameters map[string]interface{}) (r0 string, err error) {
+	m.record("CampaignReplicate", parameters)
+	if m.CampaignReplicateFunc == nil {
+		err = NotMockedError{"CampaignReplicate"}
+		return
+	}
+	return m.CampaignReplicateFunc(context.Background(), parameters)
+}
+
+func (m *Mock) CampaignReplicateContext(ctx context.Context, parameters map[string]interface{}) (r0 string, err error) {
+	m.record("CampaignReplicateContext", parameters)
+	if m.CampaignReplicateFunc == nil {
+		err = NotMockedError{"CampaignReplicateContext"}
+		return
+	}
+	return m.CampaignReplicateFunc(ctx, parameters)
+}
+
+func (m *Mock) CampaignReplicateWithParams(ctx context.Context, params *mailchimp.CampaignReplicateParams) (r0 string, err error) {
+	m.record("CampaignReplicateWithParams", params)
+	if m.CampaignReplicateWithParamsFunc != nil {
+		return m.CampaignReplicateWithParamsFunc(ctx, params)
+	}
+	parameters, err := mailchimp.ToParameters(params)
+	if err != nil {
+		return
+	}
+	if m.CampaignReplicateFunc == nil {
+		err = NotMockedError{"CampaignReplicateWithParams"}
+		return
+	}
+	return m.CampaignReplicateFunc(ctx, parameters)
+}
+
+func (m *Mock) CampaignResume(parameters map[string]interface{}) (r0 bool, err error) {
+	m.record("CampaignResume", parameters)
+	if m.CampaignResumeFunc == nil {
+		err = NotMockedError{"CampaignResume"}
+		return
+	}
+	return m.CampaignResumeFunc(context.Background(), parameters)
+}
+
+func (m *Mock) CampaignResumeContext(ctx context.Context, parameters map[string]interface{}) (r0 bool, err error) {
+	m.record("CampaignResumeContext", parameters)
+	if m.CampaignResumeFunc == nil {
+		err = NotMockedError{"CampaignResumeContext"}
+		return
+	}
+	return m.CampaignResumeFunc(ctx, parameters)
+}
+
+func (m *Mock) CampaignResumeWithParams(ctx context.Context, params *mailchimp.CampaignResumeParams) (r0 bool, err error) {
+	m.record("CampaignResumeWithParams", params)
+	if m.CampaignResumeWithParamsFunc != nil {
+		return m.CampaignResumeWithParamsFunc(ctx, params)
+	}
+	parameters, err := mailchimp.ToParameters(params)
+	if err != nil {
+		return
+	}
+	if m.CampaignResumeFunc == nil {
+		err = NotMockedError{"CampaignResumeWithParams"}
+		return
+	}
+	return m.CampaignResumeFunc(ctx, parameters)
+}
+
+func (m *Mock) CampaignSchedule(parameters map[string]interface{}) (r0 bool, err error) {
+	m.record("CampaignSchedule", parameters)
+	if m.CampaignScheduleFunc == nil {
+		err = NotMockedError{"CampaignSchedule"}
+		return
+	}
+	return m.CampaignScheduleFunc(context.Background(), parameters)
+}
+
+func (m *Mock) CampaignScheduleContext(ctx context.Context, parameters map[string]interface{}) (r0 bool, err error) {
+	m.record("CampaignScheduleContext", parameters)
+	if m.CampaignScheduleFunc == nil {
+		err = NotMockedError{"CampaignScheduleContext"}
+		return
+	}
+	return m.CampaignScheduleFunc(ctx, parameters)
+}
+
+func (m *Mock) CampaignScheduleWithParams(ctx context.Context, params *mailchimp.CampaignScheduleParams) (r0 bool, err error) {
+	m.record("CampaignScheduleWithParams", params)
+	if m.CampaignScheduleWithParamsFunc != nil {
+		return m.CampaignScheduleWithParamsFunc(ctx, params)
+	}
+	parameters, err := mailchimp.ToParameters(params)
+	if err != nil {
+		return
+	}
+	if m.CampaignScheduleFunc == nil {
+		err = NotMockedError{"CampaignScheduleWithParams"}
+		return
+	}
+	return m.CampaignScheduleFunc(ctx, parameters)
+}
+
+func (m *Mock) CampaignSegmentTest(parameters map[string]interface{}) (r0 int, err error) {
+	m.record("CampaignSegmentTest", parameters)
+	if m.CampaignSegmentTestFunc == nil {
+		err = NotMockedError{"CampaignSegmentTest"}
+		return
+	}
+	return m.CampaignSegmentTestFunc(context.Background(), parameters)
+}
+
+func (m *Mock) CampaignSegmentTestContext(ctx context.Context, parameters map[string]interface{}) (r0 int, err error) {
+	m.record("CampaignSegmentTestContext", parameters)
+	if m.CampaignSegmentTestFunc == nil {
+		err = NotMockedError{"CampaignSegmentTestContext"}
+		return
+	}
+	return m.CampaignSegmentTestFunc(ctx, parameters)
+}
+
+func (m *Mock) CampaignSegmentTestWithParams(ctx context.Context, params *mailchimp.CampaignSegmentTestParams) (r0 int, err error) {
+	m.record("CampaignSegmentTestWithParams", params)
+	if m.CampaignSegmentTestWithParamsFunc != nil {
+		return m.CampaignSegmentTestWithParamsFunc(ctx, params)
+	}
+	parameters, err := mailchimp.ToParameters(params)
+	if err != nil {
+		return
+	}
+	if m.CampaignSegmentTestFunc == nil {
+		err = NotMockedError{"CampaignSegmentTestWithParams"}
+		return
+	}
+	return m.CampaignSegmentTestFunc(ctx, parameters)
+}
+
+func (m *Mock) CampaignSendNow(parameters map[string]interface{}) (r0 bool, err error) {
+	m.record("CampaignSendNow", parameters)
+	if m.CampaignSendNowFunc == nil {
+		err = NotMockedError{"CampaignSendNow"}
+		return
+	}
+	return m.CampaignSendNowFunc(context.Background(), parameters)
+}
+
+func (m *Mock) CampaignSendNowContext(ctx context.Context, parameters map[string]interface{}) (r0 bool, err error) {
+	m.record("CampaignSendNowContext", parameters)
+	if m.CampaignSendNowFunc == nil {
+		err = NotMockedError{"CampaignSendNowContext"}
+		return
+	}
+	return m.CampaignSendNowFunc(ctx, parameters)
+}
+
+func (m *Mock) CampaignSendNowWithParams(ctx context.Context, params *mailchimp.CampaignSendNowParams) (r0 bool, err error) {
+	m.record("CampaignSendNowWithParams", params)
+	if m.CampaignSendNowWithParamsFunc != nil {
+		return m.CampaignSendNowWithParamsFunc(ctx, params)
+	}
+	parameters, err := mailchimp.ToParameters(params)
+	if err != nil {
+		return
+	}
+	if m.CampaignSendNowFunc == nil {
+		err = NotMockedError{"CampaignSendNowWithParams"}
+		return
+	}
+	return m.CampaignSendNowFunc(ctx, parameters)
+}
+
+func (m *Mock) CampaignSendTest(parameters map[string]interface{}) (r0 bool, err error) {
+	m.record("CampaignSendTest", parameters)
+	if m.CampaignSendTestFunc == nil {
+		err = NotMockedError{"CampaignSendTest"}
+		return
+	}
+	return m.CampaignSendTestFunc(context.Background(), parameters)
+}
+
+func (m *Mock) CampaignSendTestContext(ctx context.Context, parameters map[string]interface{}) (r0 bool, err error) {
+	m.record("CampaignSendTestContext", parameters)
+	if m.CampaignSendTestFunc == nil {
+		err = NotMockedError{"CampaignSendTestContext"}
+		return
+	}
+	return m.CampaignSendTestFunc(ctx, parameters)
+}
+
+func (m *Mock) CampaignSendTestWithParams(ctx context.Context, params *mailchimp.CampaignSendTestParams) (r0 bool, err error) {
+	m.record("CampaignSendTestWithParams", params)
+	if m.CampaignSendTestWithParamsFunc != nil {
+		return m.CampaignSendTestWithParamsFunc(ctx, params)
+	}
+	parameters, err := mailchimp.ToParameters(params)
+	if err != nil {
+		return
+	}
+	if m.CampaignSendTestFunc == nil {
+		err = NotMockedError{"CampaignSendTestWithParams"}
+		return
+	}
+	return m.CampaignSendTestFunc(ctx, parameters)
+}
+
+func (m *Mock) CampaignShareReport(parameters map[string]interface{}) (r0 *mailchimp.CampaignShareReportResult, err error) {
+	m.record("CampaignShareReport", parameters)
+	if m.CampaignShareReportFunc == nil {
+		err = NotMockedError{"CampaignShareReport"}
+		return
+	}
+	return m.CampaignShareReportFunc(context.Background(), parameters)
+}
+
+func (m *Mock) CampaignShareReportContext(ctx context.Context, parameters map[string]interface{}) (r0 *mailchimp.CampaignShareReportResult, err error) {
+	m.record("CampaignShareReportContext", parameters)
+	if m.CampaignShareReportFunc == nil {
+		err = NotMockedError{"CampaignShareReportContext"}
+		return
+	}
+	return m.CampaignShareReportFunc(ctx, parameters)
+}
+
+func (m *Mock) CampaignShareReportWithParams(ctx context.Context, params *mailchimp.CampaignShareReportParams) (r0 *mailchimp.CampaignShareReportResult, err error) {
+	m.record("CampaignShareReportWithParams", params)
+	if m.CampaignShareReportWithParamsFunc != nil {
+		return m.CampaignShareReportWithParamsFunc(ctx, params)
+	}
+	parameters, err := mailchimp.ToParameters(params)
+	if err != nil {
+		return
+	}
+	if m.CampaignShareReportFunc == nil {
+		err = NotMockedError{"CampaignShareReportWithParams"}
+		return
+	}
+	return m.CampaignShareReportFunc(ctx, parameters)
+}
+
+func (m *Mock) CampaignStats(parameters map[string]interface{}) (r0 *mailchimp.CampaignStatsResult, err error) {
+	m.record("CampaignStats", parameters)
+	if m.CampaignStatsFunc == nil {
+		err = NotMockedError{"CampaignStats"}
+		return
+	}
+	return m.CampaignStatsFunc(context.Background(), parameters)
+}
+
+func (m *Mock) CampaignStatsContext(ctx context.Context, parameters map[string]interface{}) (r0 *mailchimp.CampaignStatsResult, err error) {
+	m.record("CampaignStatsContext", parameters)
+	if m.CampaignStatsFunc == nil {
+		err = NotMockedError{"CampaignStatsContext"}
+		return
+	}
+	return m.CampaignStatsFunc(ctx, parameters)
+}
+
+func (m *Mock) CampaignStatsWithParams(ctx context.Context, params *mailchimp.CampaignStatsParams) (r0 *mailchimp.CampaignStatsResult, err error) {
+	m.record("CampaignStatsWithParams", params)
+	if m.CampaignStatsWithParamsFunc != nil {
+		return m.CampaignStatsWithParamsFunc(ctx, params)
+	}
+	parameters, err := mailchimp.ToParameters(params)
+	if err != nil {
+		return
+	}
+	if m.CampaignStatsFunc == nil {
+		err = NotMockedError{"CampaignStatsWithParams"}
+		return
+	}
+	return m.CampaignStatsFunc(ctx, parameters)
+}
+
+func (m *Mock) CampaignTemplateContent(parameters map[string]interface{}) (r0 map[string]interface{}, err error) {
+	m.record("CampaignTemplateContent", parameters)
+	if m.CampaignTemplateContentFunc == nil {
+		err = NotMockedError{"CampaignTemplateContent"}
+		return
+	}
+	return m.CampaignTemplateContentFunc(context.Background(), parameters)
+}
+
+func (m *Mock) CampaignTemplateContentContext(ctx context.Context, parameters map[string]interface{}) (r0 map[string]interface{}, err error) {
+	m.record("CampaignTemplateContentContext", parameters)
+	if m.CampaignTemplateContentFunc == nil {
+		err = NotMockedError{"CampaignTemplateContentContext"}
+		return
+	}
+	return m.CampaignTemplateContentFunc(ctx, parameters)
+}
+
+func (m *Mock) CampaignTemplateContentWithParams(ctx context.Context, params *mailchimp.CampaignTemplateContentParams) (r0 map[string]interface{}, err error) {
+	m.record("CampaignTemplateContentWithParams", params)
+	if m.CampaignTemplateContentWithParamsFunc != nil {
+		return m.CampaignTemplateContentWithParamsFunc(ctx, params)
+	}
+	parameters, err := mailchimp.ToParameters(params)
+	if err != nil {
+		return
+	}
+	if m.CampaignTemplateContentFunc == nil {
+		err = NotMockedError{"CampaignTemplateContentWithParams"}
+		return
+	}
+	return m.CampaignTemplateContentFunc(ctx, parameters)
+}
+
+func (m *Mock) CampaignUnschedule(parameters map[string]interface{}) (r0 bool, err error) {
+	m.record("CampaignUnschedule", parameters)
+	if m.CampaignUnscheduleFunc == nil {
+		err = NotMockedError{"CampaignUnschedule"}
+		return
+	}
+	return m.CampaignUnscheduleFunc(context.Background(), parameters)
+}
+
+func (m *Mock) CampaignUnscheduleContext(ctx context.Context, parameters map[string]interface{}) (r0 bool, err error) {
+	m.record("CampaignUnscheduleContext", parameters)
+	if m.CampaignUnscheduleFunc == nil {
+		err = NotMockedError{"CampaignUnscheduleContext"}
+		return
+	}
+	return m.CampaignUnscheduleFunc(ctx, parameters)
+}
+
+func (m *Mock) CampaignUnscheduleWithParams(ctx context.Context, params *mailchimp.CampaignUnscheduleParams) (r0 bool, err error) {
+	m.record("CampaignUnscheduleWithParams", params)
+	if m.CampaignUnscheduleWithParamsFunc != nil {
+		return m.CampaignUnscheduleWithParamsFunc(ctx, params)
+	}
+	parameters, err := mailchimp.ToParameters(params)
+	if err != nil {
+		return
+	}
+	if m.CampaignUnscheduleFunc == nil {
+		err = NotMockedError{"CampaignUnscheduleWithParams"}
+		return
+	}
+	return m.CampaignUnscheduleFunc(ctx, parameters)
+}
+
+func (m *Mock) CampaignUnsubscribes(parameters map[string]interface{}) (r0 *mailchimp.CampaignUnsubscribesResult, err error) {
+	m.record("CampaignUnsubscribes", parameters)
+	if m.CampaignUnsubscribesFunc == nil {
+		err = NotMockedError{"CampaignUnsubscribes"}
+		return
+	}
+	return m.CampaignUnsubscribesFunc(context.Background(), parameters)
+}
+
+func (m *Mock) CampaignUnsubscribesContext(ctx context.Context, parameters map[string]interface{}) (r0 *mailchimp.CampaignUnsubscribesResult, err error) {
+	m.record("CampaignUnsubscribesContext", parameters)
+	if m.CampaignUnsubscribesFunc == nil {
+		err = NotMockedError{"CampaignUnsubscribesContext"}
+		return
+	}
+	return m.CampaignUnsubscribesFunc(ctx, parameters)
+}
+
+func (m *Mock) CampaignUnsubscribesPager(ctx context.Context, parameters map[string]interface{}) (r0 *mailchimp.Pager[mailchimp.CampaignUnsubscribesResultDataItem]) {
+	m.record("CampaignUnsubscribesPager", parameters)
+	if m.CampaignUnsubscribesPagerFunc == nil {
+		return mailchimp.PagerOf[mailchimp.CampaignUnsubscribesResultDataItem](nil, NotMockedError{"CampaignUnsubscribesPager"})
+	}
+	return m.CampaignUnsubscribesPagerFunc(ctx, parameters)
+}
+
+func (m *Mock) CampaignUnsubscribesWithParams(ctx context.Context, params *mailchimp.CampaignUnsubscribesParams) (r0 *mailchimp.CampaignUnsubscribesResult, err error) {
+	m.record("CampaignUnsubscribesWithParams", params)
+	if m.CampaignUnsubscribesWithParamsFunc != nil {
+		return m.CampaignUnsubscribesWithParamsFunc(ctx, params)
+	}
+	parameters, err := mailchimp.ToParameters(params)
+	if err != nil {
+		return
+	}
+	if m.CampaignUnsubscribesFunc == nil {
+		err = NotMockedError{"CampaignUnsubscribesWithParams"}
+		return
+	}
+	return m.CampaignUnsubscribesFunc(ctx, parameters)
+}
+
+func (m *Mock) CampaignUpdate(parameters map[string]interface{}) (r0 bool, err error) {
+	m.record("CampaignUpdate", parameters)
+	if m.CampaignUpdateFunc == nil {
+		err = NotMockedError{"CampaignUpdate"}
+		return
+	}
+	return m.CampaignUpdateFunc(context.Background(), parameters)
+}
+
+func (m *Mock) CampaignUpdateContext(ctx context.Context, parameters map[string]interface{}) (r0 bool, err error) {
+	m.record("CampaignUpdateContext", parameters)
+	if m.CampaignUpdateFunc == nil {
+		err = NotMockedError{"CampaignUpdateContext"}
+		return
+	}
+	return m.CampaignUpdateFunc(ctx, parameters)
+}
+
+func (m *Mock) CampaignUpdateWithParams(ctx context.Context, params *mailchimp.CampaignUpdateParams) (r0 bool, err error) {
+	m.record("CampaignUpdateWithParams", params)
+	if m.CampaignUpdateWithParamsFunc != nil {
+		return m.CampaignUpdateWithParamsFunc(ctx, params)
+	}
+	parameters, err := mailchimp.ToParameters(params)
+	if err != nil {
+		return
+	}
+	if m.CampaignUpdateFunc == nil {
+		err = NotMockedError{"CampaignUpdateWithParams"}
+		return
+	}
+	return m.CampaignUpdateFunc(ctx, parameters)
+}
+
+func (m *Mock) Campaigns(parameters map[string]interface{}) (r0 *mailchimp.CampaignsResult, err error) {
+	m.record("Campaigns", parameters)
+	if m.CampaignsFunc == nil {
+		err = NotMockedError{"Campaigns"}
+		return
+	}
+	return m.CampaignsFunc(context.Background(), parameters)
+}
+
+func (m *Mock) CampaignsContext(ctx context.Context, parameters map[string]interface{}) (r0 *mailchimp.CampaignsResult, err error) {
+	m.record("CampaignsContext", parameters)
+	if m.CampaignsFunc == nil {
+		err = NotMockedError{"CampaignsContext"}
+		return
+	}
+	return m.CampaignsFunc(ctx, parameters)
+}
+
+func (m *Mock) CampaignsForEmail(parameters map[string]interface{}) (r0 []string, err error) {
+	m.record("CampaignsForEmail", parameters)
+	if m.CampaignsForEmailFunc == nil {
+		err = NotMockedError{"CampaignsForEmail"}
+		return
+	}
+	return m.CampaignsForEmailFunc(context.Background(), parameters)
+}
+
+func (m *Mock) CampaignsForEmailContext(ctx context.Context, parameters map[string]interface{}) (r0 []string, err error) {
+	m.record("CampaignsForEmailContext", parameters)
+	if m.CampaignsForEmailFunc == nil {
+		err = NotMockedError{"CampaignsForEmailContext"}
+		return
+	}
+	return m.CampaignsForEmailFunc(ctx, parameters)
+}
+
+func (m *Mock) CampaignsForEmailWithParams(ctx context.Context, params *mailchimp.CampaignsForEmailParams) (r0 []string, err error) {
+	m.record("CampaignsForEmailWithParams", params)
+	if m.CampaignsForEmailWithParamsFunc != nil {
+		return m.CampaignsForEmailWithParamsFunc(ctx, params)
+	}
+	parameters, err := mailchimp.ToParameters(params)
+	if err != nil {
+		return
+	}
+	if m.CampaignsForEmailFunc == nil {
+		err = NotMockedError{"CampaignsForEmailWithParams"}
+		return
+	}
+	return m.CampaignsForEmailFunc(ctx, parameters)
+}
+
+func (m *Mock) CampaignsPager(ctx context.Context, parameters map[string]interface{}) (r0 *mailchimp.Pager[mailchimp.CampaignsResultData]) {
+	m.record("CampaignsPager", parameters)
+	if m.CampaignsPagerFunc == nil {
+		return mailchimp.PagerOf[mailchimp.CampaignsResultData](nil, NotMockedError{"CampaignsPager"})
+	}
+	return m.CampaignsPagerFunc(ctx, parameters)
+}
+
+func (m *Mock) CampaignsWithParams(ctx context.Context, params *mailchimp.CampaignsParams) (r0 *mailchimp.CampaignsResult, err error) {
+	m.record("CampaignsWithParams", params)
+	if m.CampaignsWithParamsFunc != nil {
+		return m.CampaignsWithParamsFunc(ctx, params)
+	}
+	parameters, err := mailchimp.ToParameters(params)
+	if err != nil {
+		return
+	}
+	if m.CampaignsFunc == nil {
+		err = NotMockedError{"CampaignsWithParams"}
+		return
+	}
+	return m.CampaignsFunc(ctx, parameters)
+}
+
+func (m *Mock) ChimpChatter(parameters map[string]interface{}) (r0 []mailchimp.ChimpChatterResultItem, err error) {
+	m.record("ChimpChatter", parameters)
+	if m.ChimpChatterFunc == nil {
+		err = NotMockedError{"ChimpChatter"}
+		return
+	}
+	return m.ChimpChatterFunc(context.Background(), parameters)
+}
+
+func (m *Mock) ChimpChatterContext(ctx context.Context, parameters map[string]interface{}) (r0 []mailchimp.ChimpChatterResultItem, err error) {
+	m.record("ChimpChatterContext", parameters)
+	if m.ChimpChatterFunc == nil {
+		err = NotMockedError{"ChimpChatterContext"}
+		return
+	}
+	return m.ChimpChatterFunc(ctx, parameters)
+}
+
+func (m *Mock) EcommOrderAdd(parameters map[string]interface{}) (r0 bool, err error) {
+	m.record("EcommOrderAdd", parameters)
+	if m.EcommOrderAddFunc == nil {
+		err = NotMockedError{"EcommOrderAdd"}
+		return
+	}
+	return m.EcommOrderAddFunc(context.Background(), parameters)
+}
+
+func (m *Mock) EcommOrderAddContext(ctx context.Context, parameters map[string]interface{}) (r0 bool, err error) {
+	m.record("EcommOrderAddContext", parameters)
+	if m.EcommOrderAddFunc == nil {
+		err = NotMockedError{"EcommOrderAddContext"}
+		return
+	}
+	return m.EcommOrderAddFunc(ctx, parameters)
+}
+
+func (m *Mock) EcommOrderAddWithParams(ctx context.Context, params *mailchimp.EcommOrderAddParams) (r0 bool, err error) {
+	m.record("EcommOrderAddWithParams", params)
+	if m.EcommOrderAddWithParamsFunc != nil {
+		return m.EcommOrderAddWithParamsFunc(ctx, params)
+	}
+	parameters, err := mailchimp.ToParameters(params)
+	if err != nil {
+		return
+	}
+	if m.EcommOrderAddFunc == nil {
+		err = NotMockedError{"EcommOrderAddWithParams"}
+		return
+	}
+	return m.EcommOrderAddFunc(ctx, parameters)
+}
+
+func (m *Mock) EcommOrderDel(parameters map[string]interface{}) (r0 bool, err error) {
+	m.record("EcommOrderDel", parameters)
+	if m.EcommOrderDelFunc == nil {
+		err = NotMockedError{"EcommOrderDel"}
+		return
+	}
+	return m.EcommOrderDelFunc(context.Background(), parameters)
+}
+
+func (m *Mock) EcommOrderDelContext(ctx context.Context, parameters map[string]interface{}) (r0 bool, err error) {
+	m.record("EcommOrderDelContext", parameters)
+	if m.EcommOrderDelFunc == nil {
+		err = NotMockedError{"EcommOrderDelContext"}
+		return
+	}
+	return m.EcommOrderDelFunc(ctx, parameters)
+}
+
+func (m *Mock) EcommOrderDelWithParams(ctx context.Context, params *mailchimp.EcommOrderDelParams) (r0 bool, err error) {
+	m.record("EcommOrderDelWithParams", params)
+	if m.EcommOrderDelWithParamsFunc != nil {
+		return m.EcommOrderDelWithParamsFunc(ctx, params)
+	}
+	parameters, err := mailchimp.ToParameters(params)
+	if err != nil {
+		return
+	}
+	if m.EcommOrderDelFunc == nil {
+		err = NotMockedError{"EcommOrderDelWithParams"}
+		return
+	}
+	return m.EcommOrderDelFunc(ctx, parameters)
+}
+
+func (m *Mock) EcommOrders(parameters map[string]interface{}) (r0 *mailchimp.EcommOrdersResult, err error) {
+	m.record("EcommOrders", parameters)
+	if m.EcommOrdersFunc == nil {
+		err = NotMockedError{"EcommOrders"}
+		return
+	}
+	return m.EcommOrdersFunc(context.Background(), parameters)
+}
+
+func (m *Mock) EcommOrdersContext(ctx context.Context, parameters map[string]interface{}) (r0 *mailchimp.EcommOrdersResult, err error) {
+	m.record("EcommOrdersContext", parameters)
+	if m.EcommOrdersFunc == nil {
+		err = NotMockedError{"EcommOrdersContext"}
+		return
+	}
+	return m.EcommOrdersFunc(ctx, parameters)
+}
+
+func (m *Mock) EcommOrdersPager(ctx context.Context, parameters map[string]interface{}) (r0 *mailchimp.Pager[mailchimp.EcommOrdersResultDataItem]) {
+	m.record("EcommOrdersPager", parameters)
+	if m.EcommOrdersPagerFunc == nil {
+		return mailchimp.PagerOf[mailchimp.EcommOrdersResultDataItem](nil, NotMockedError{"EcommOrdersPager"})
+	}
+	return m.EcommOrdersPagerFunc(ctx, parameters)
+}
+
+func (m *Mock) EcommOrdersWithParams(ctx context.Context, params *mailchimp.EcommOrdersParams) (r0 *mailchimp.EcommOrdersResult, err error) {
+	m.record("EcommOrdersWithParams", params)
+	if m.EcommOrdersWithParamsFunc != nil {
+		return m.EcommOrdersWithParamsFunc(ctx, params)
+	}
+	parameters, err := mailchimp.ToParameters(params)
+	if err != nil {
+		return
+	}
+	if m.EcommOrdersFunc == nil {
+		err = NotMockedError{"EcommOrdersWithParams"}
+		return
+	}
+	return m.EcommOrdersFunc(ctx, parameters)
+}
+
+func (m *Mock) EnsureWebhook(ctx context.Context, id string, hook mailchimp.Webhook) (r0 bool, err error) {
+	m.record("EnsureWebhook", id, hook)
+	if m.EnsureWebhookFunc == nil {
+		err = NotMockedError{"EnsureWebhook"}
+		return
+	}
+	return m.EnsureWebhookFunc(ctx, id, hook)
+}
+
+func (m *Mock) FolderAdd(parameters map[string]interface{}) (r0 int, err error) {
+	m.record("FolderAdd", parameters)
+	if m.FolderAddFunc == nil {
+		err = NotMockedError{"FolderAdd"}
+		return
+	}
+	return m.FolderAddFunc(context.Background(), parameters)
+}
+
+func (m *Mock) FolderAddContext(ctx context.Context, parameters map[string]interface{}) (r0 int, err error) {
+	m.record("FolderAddContext", parameters)
+	if m.FolderAddFunc == nil {
+		err = NotMockedError{"FolderAddContext"}
+		return
+	}
+	return m.FolderAddFunc(ctx, parameters)
+}
+
+func (m *Mock) FolderAddWithParams(ctx context.Context, params *mailchimp.FolderAddParams) (r0 int, err error) {
+	m.record("FolderAddWithParams", params)
+	if m.FolderAddWithParamsFunc != nil {
+		return m.FolderAddWithParamsFunc(ctx, params)
+	}
+	parameters, err := mailchimp.ToParameters(params)
+	if err != nil {
+		return
+	}
+	if m.FolderAddFunc == nil {
+		err = NotMockedError{"FolderAddWithParams"}
+		return
+	}
+	return m.FolderAddFunc(ctx, parameters)
+}
+
+func (m *Mock) FolderDel(parameters map[string]interface{}) (r0 bool, err error) {
+	m.record("FolderDel", parameters)
+	if m.FolderDelFunc == nil {
+		err = NotMockedError{"FolderDel"}
+		return
+	}
+	return m.FolderDelFunc(context.Background(), parameters)
+}
+
+func (m *Mock) FolderDelContext(ctx context.Context, parameters map[string]interface{}) (r0 bool, err error) {
+	m.record("FolderDelContext", parameters)
+	if m.FolderDelFunc == nil {
+		err = NotMockedError{"FolderDelContext"}
+		return
+	}
+	return m.FolderDelFunc(ctx, parameters)
+}
+
+func (m *Mock) FolderDelWithParams(ctx context.Context, params *mailchimp.FolderDelParams) (r0 bool, err error) {
+	m.record("FolderDelWithParams", params)
+	if m.FolderDelWithParamsFunc != nil {
+		return m.FolderDelWithParamsFunc(ctx, params)
+	}
+	parameters, err := mailchimp.ToParameters(params)
+	if err != nil {
+		return
+	}
+	if m.FolderDelFunc == nil {
+		err = NotMockedError{"FolderDelWithParams"}
+		return
+	}
+	return m.FolderDelFunc(ctx, parameters)
+}
+
+func (m *Mock) FolderUpdate(parameters map[string]interface{}) (r0 bool, err error) {
+	m.record("FolderUpdate", parameters)
+	if m.FolderUpdateFunc == nil {
+		err = NotMockedError{"FolderUpdate"}
+		return
+	}
+	return m.FolderUpdateFunc(context.Background(), parameters)
+}
+
+func (m *Mock) FolderUpdateContext(ctx context.Context, parameters map[string]interface{}) (r0 bool, err error) {
+	m.record("FolderUpdateContext", parameters)
+	if m.FolderUpdateFunc == nil {
+		err = NotMockedError{"FolderUpdateContext"}
+		return
+	}
+	return m.FolderUpdateFunc(ctx, parameters)
+}
+
+func (m *Mock) FolderUpdateWithParams(ctx context.Context, params *mailchimp.FolderUpdateParams) (r0 bool, err error) {
+	m.record("FolderUpdateWithParams", params)
+	if m.FolderUpdateWithParamsFunc != nil {
+		return m.FolderUpdateWithParamsFunc(ctx, params)
+	}
+	parameters, err := mailchimp.ToParameters(params)
+	if err != nil {
+		return
+	}
+	if m.FolderUpdateFunc == nil {
+		err = NotMockedError{"FolderUpdateWithParams"}
+		return
+	}
+	return m.FolderUpdateFunc(ctx, parameters)
+}
+
+func (m *Mock) Folders(parameters map[string]interface{}) (r0 []mailchimp.FoldersResultItem, err error) {
+	m.record("Folders", parameters)
+	if m.FoldersFunc == nil {
+		err = NotMockedError{"Folders"}
+		return
+	}
+	return m.FoldersFunc(context.Background(), parameters)
+}
+
+func (m *Mock) FoldersContext(ctx context.Context, parameters map[string]interface{}) (r0 []mailchimp.FoldersResultItem, err error) {
+	m.record("FoldersContext", parameters)
+	if m.FoldersFunc == nil {
+		err = NotMockedError{"FoldersContext"}
+		return
+	}
+	return m.FoldersFunc(ctx, parameters)
+}
+
+func (m *Mock) FoldersWithParams(ctx context.Context, params *mailchimp.FoldersParams) (r0 []mailchimp.FoldersResultItem, err error) {
+	m.record("FoldersWithParams", params)
+	if m.FoldersWithParamsFunc != nil {
+		return m.FoldersWithParamsFunc(ctx, params)
+	}
+	parameters, err := mailchimp.ToParameters(params)
+	if err != nil {
+		return
+	}
+	if m.FoldersFunc == nil {
+		err = NotMockedError{"FoldersWithParams"}
+		return
+	}
+	return m.FoldersFunc(ctx, parameters)
+}
+
+func (m *Mock) GenerateText(parameters map[string]interface{}) (r0 string, err error) {
+	m.record("GenerateText", parameters)
+	if m.GenerateTextFunc == nil {
+		err = NotMockedError{"GenerateText"}
+		return
+	}
+	return m.GenerateTextFunc(context.Background(), parameters)
+}
+
+func (m *Mock) GenerateTextContext(ctx context.Context, parameters map[string]interface{}) (r0 string, err error) {
+	m.record("GenerateTextContext", parameters)
+	if m.GenerateTextFunc == nil {
+		err = NotMockedError{"GenerateTextContext"}
+		return
+	}
+	return m.GenerateTextFunc(ctx, parameters)
+}
+
+func (m *Mock) GenerateTextWithParams(ctx context.Context, params *mailchimp.GenerateTextParams) (r0 string, err error) {
+	m.record("GenerateTextWithParams", params)
+	if m.GenerateTextWithParamsFunc != nil {
+		return m.GenerateTextWithParamsFunc(ctx, params)
+	}
+	parameters, err := mailchimp.ToParameters(params)
+	if err != nil {
+		return
+	}
+	if m.GenerateTextFunc == nil {
+		err = NotMockedError{"GenerateTextWithParams"}
+		return
+	}
+	return m.GenerateTextFunc(ctx, parameters)
+}
+
+func (m *Mock) GetAccountDetails(parameters map[string]interface{}) (r0 *mailchimp.GetAccountDetailsResult, err error) {
+	m.record("GetAccountDetails", parameters)
+	if m.GetAccountDetailsFunc == nil {
+		err = NotMockedError{"GetAccountDetails"}
+		return
+	}
+	return m.GetAccountDetailsFunc(context.Background(), parameters)
+}
+
+func (m *Mock) GetAccountDetailsContext(ctx context.Context, parameters map[string]interface{}) (r0 *mailchimp.GetAccountDetailsResult, err error) {
+	m.record("GetAccountDetailsContext", parameters)
+	if m.GetAccountDetailsFunc == nil {
+		err = NotMockedError{"GetAccountDetailsContext"}
+		return
+	}
+	return m.GetAccountDetailsFunc(ctx, parameters)
+}
+
+func (m *Mock) GetAccountDetailsWithParams(ctx context.Context, params *mailchimp.GetAccountDetailsParams) (r0 *mailchimp.GetAccountDetailsResult, err error) {
+	m.record("GetAccountDetailsWithParams", params)
+	if m.GetAccountDetailsWithParamsFunc != nil {
+		return m.GetAccountDetailsWithParamsFunc(ctx, params)
+	}
+	parameters, err := mailchimp.ToParameters(params)
+	if err != nil {
+		return
+	}
+	if m.GetAccountDetailsFunc == nil {
+		err = NotMockedError{"GetAccountDetailsWithParams"}
+		return
+	}
+	return m.GetAccountDetailsFunc(ctx, parameters)
+}
+
+func (m *Mock) GetVerifiedDomains(parameters map[string]interface{}) (r0 []mailchimp.GetVerifiedDomainsResultItem, err error) {
+	m.record("GetVerifiedDomains", parameters)
+	if m.GetVerifiedDomainsFunc == nil {
+		err = NotMockedError{"GetVerifiedDomains"}
+		return
+	}
+	return m.GetVerifiedDomainsFunc(context.Background(), parameters)
+}
+
+func (m *Mock) GetVerifiedDomainsContext(ctx context.Context, parameters map[string]interface{}) (r0 []mailchimp.GetVerifiedDomainsResultItem, err error) {
+	m.record("GetVerifiedDomainsContext", parameters)
+	if m.GetVerifiedDomainsFunc == nil {
+		err = NotMockedError{"GetVerifiedDomainsContext"}
+		return
+	}
+	return m.GetVerifiedDomainsFunc(ctx, parameters)
+}
+
+func (m *Mock) GmonkeyActivity(parameters map[string]interface{}) (r0 []mailchimp.GmonkeyActivityResultItem, err error) {
+	m.record("GmonkeyActivity", parameters)
+	if m.GmonkeyActivityFunc == nil {
+		err = NotMockedError{"GmonkeyActivity"}
+		return
+	}
+	return m.GmonkeyActivityFunc(context.Background(), parameters)
+}
+
+func (m *Mock) GmonkeyActivityContext(ctx context.Context, parameters map[string]interface{}) (r0 []mailchimp.GmonkeyActivityResultItem, err error) {
+	m.record("GmonkeyActivityContext", parameters)
+	if m.GmonkeyActivityFunc == nil {
+		err = NotMockedError{"GmonkeyActivityContext"}
+		return
+	}
+	return m.GmonkeyActivityFunc(ctx, parameters)
+}
+
+func (m *Mock) GmonkeyAdd(parameters map[string]interface{}) (r0 *mailchimp.GmonkeyAddResult, err error) {
+	m.record("GmonkeyAdd", parameters)
+	if m.GmonkeyAddFunc == nil {
+		err = NotMockedError{"GmonkeyAdd"}
+		return
+	}
+	return m.GmonkeyAddFunc(context.Background(), parameters)
+}
+
+func (m *Mock) GmonkeyAddContext(ctx context.Context, parameters map[string]interface{}) (r0 *mailchimp.GmonkeyAddResult, err error) {
+	m.record("GmonkeyAddContext", parameters)
+	if m.GmonkeyAddFunc == nil {
+		err = NotMockedError{"GmonkeyAddContext"}
+		return
+	}
+	return m.GmonkeyAddFunc(ctx, parameters)
+}
+
+func (m *Mock) GmonkeyAddWithParams(ctx context.Context, params *mailchimp.GmonkeyAddParams) (r0 *mailchimp.GmonkeyAddResult, err error) {
+	m.record("GmonkeyAddWithParams", params)
+	if m.GmonkeyAddWithParamsFunc != nil {
+		return m.GmonkeyAddWithParamsFunc(ctx, params)
+	}
+	parameters, err := mailchimp.ToParameters(params)
+	if err != nil {
+		return
+	}
+	if m.GmonkeyAddFunc == nil {
+		err = NotMockedError{"GmonkeyAddWithParams"}
+		return
+	}
+	return m.GmonkeyAddFunc(ctx, parameters)
+}
+
+func (m *Mock) GmonkeyDel(parameters map[string]interface{}) (r0 *mailchimp.GmonkeyDelResult, err error) {
+	m.record("GmonkeyDel", parameters)
+	if m.GmonkeyDelFunc == nil {
+		err = NotMockedError{"GmonkeyDel"}
+		return
+	}
+	return m.GmonkeyDelFunc(context.Background(), parameters)
+}
+
+func (m *Mock) GmonkeyDelContext(ctx context.Context, parameters map[string]interface{}) (r0 *mailchimp.GmonkeyDelResult, err error) {
+	m.record("GmonkeyDelContext", parameters)
+	if m.GmonkeyDelFunc == nil {
+		err = NotMockedError{"GmonkeyDelContext"}
+		return
+	}
+	return m.GmonkeyDelFunc(ctx, parameters)
+}
+
+func (m *Mock) GmonkeyDelWithParams(ctx context.Context, params *mailchimp.GmonkeyDelParams) (r0 *mailchimp.GmonkeyDelResult, err error) {
+	m.record("GmonkeyDelWithParams", params)
+	if m.GmonkeyDelWithParamsFunc != nil {
+		return m.GmonkeyDelWithParamsFunc(ctx, params)
+	}
+	parameters, err := mailchimp.ToParameters(params)
+	if err != nil {
+		return
+	}
+	if m.GmonkeyDelFunc == nil {
+		err = NotMockedError{"GmonkeyDelWithParams"}
+		return
+	}
+	return m.GmonkeyDelFunc(ctx, parameters)
+}
+
+func (m *Mock) GmonkeyMembers(parameters map[string]interface{}) (r0 []mailchimp.GmonkeyMembersItem, err error) {
+	m.record("GmonkeyMembers", parameters)
+	if m.GmonkeyMembersFunc == nil {
+		err = NotMockedError{"GmonkeyMembers"}
+		return
+	}
+	return m.GmonkeyMembersFunc(context.Background(), parameters)
+}
+
+func (m *Mock) GmonkeyMembersContext(ctx context.Context, parameters map[string]interface{}) (r0 []mailchimp.GmonkeyMembersItem, err error) {
+	m.record("GmonkeyMembersContext", parameters)
+	if m.GmonkeyMembersFunc == nil {
+		err = NotMockedError{"GmonkeyMembersContext"}
+		return
+	}
+	return m.GmonkeyMembersFunc(ctx, parameters)
+}
+
+func (m *Mock) InlineCss(parameters map[string]interface{}) (r0 string, err error) {
+	m.record("InlineCss", parameters)
+	if m.InlineCssFunc == nil {
+		err = NotMockedError{"InlineCss"}
+		return
+	}
+	return m.InlineCssFunc(context.Background(), parameters)
+}
+
+func (m *Mock) InlineCssContext(ctx context.Context, parameters map[string]interface{}) (r0 string, err error) {
+	m.record("InlineCssContext", parameters)
+	if m.InlineCssFunc == nil {
+		err = NotMockedError{"InlineCssContext"}
+		return
+	}
+	return m.InlineCssFunc(ctx, parameters)
+}
+
+func (m *Mock) InlineCssWithParams(ctx context.Context, params *mailchimp.InlineCssParams) (r0 string, err error) {
+	m.record("InlineCssWithParams", params)
+	if m.InlineCssWithParamsFunc != nil {
+		return m.InlineCssWithParamsFunc(ctx, params)
+	}
+	parameters, err := mailchimp.ToParameters(params)
+	if err != nil {
+		return
+	}
+	if m.InlineCssFunc == nil {
+		err = NotMockedError{"InlineCssWithParams"}
+		return
+	}
+	return m.InlineCssFunc(ctx, parameters)
+}
+
+func (m *Mock) ListAbuseReports(parameters map[string]interface{}) (r0 *mailchimp.ListAbuseReportsResponse, err error) {
+	m.record("ListAbuseReports", parameters)
+	if m.ListAbuseReportsFunc == nil {
+		err = NotMockedError{"ListAbuseReports"}
+		return
+	}
+	return m.ListAbuseReportsFunc(context.Background(), parameters)
+}
+
+func (m *Mock) ListAbuseReportsContext(ctx context.Context, parameters map[string]interface{}) (r0 *mailchimp.ListAbuseReportsResponse, err error) {
+	m.record("ListAbuseReportsContext", parameters)
+	if m.ListAbuseReportsFunc == nil {
+		err = NotMockedError{"ListAbuseReportsContext"}
+		return
+	}
+	return m.ListAbuseReportsFunc(ctx, parameters)
+}
+
+func (m *Mock) ListAbuseReportsPager(ctx context.Context, parameters map[string]interface{}) (r0 *mailchimp.Pager[mailchimp.ListAbuseReportsResponseDataItem]) {
+	m.record("ListAbuseReportsPager", parameters)
+	if m.ListAbuseReportsPagerFunc == nil {
+		return mailchimp.PagerOf[mailchimp.ListAbuseReportsResponseDataItem](nil, NotMockedError{"ListAbuseReportsPager"})
+	}
+	return m.ListAbuseReportsPagerFunc(ctx, parameters)
+}
+
+func (m *Mock) ListAbuseReportsWithParams(ctx context.Context, params *mailchimp.ListAbuseReportsParams) (r0 *mailchimp.ListAbuseReportsResponse, err error) {
+	m.record("ListAbuseReportsWithParams", params)
+	if m.ListAbuseReportsWithParamsFunc != nil {
+		return m.ListAbuseReportsWithParamsFunc(ctx, params)
+	}
+	parameters, err := mailchimp.ToParameters(params)
+	if err != nil {
+		return
+	}
+	if m.ListAbuseReportsFunc == nil {
+		err = NotMockedError{"ListAbuseReportsWithParams"}
+		return
+	}
+	return m.ListAbuseReportsFunc(ctx, parameters)
+}
+
+func (m *Mock) ListActivity(parameters map[string]interface{}) (r0 []mailchimp.ListActivityElement, err error) {
+	m.record("ListActivity", parameters)
+	if m.ListActivityFunc == nil {
+		err = NotMockedError{"ListActivity"}
+		return
+	}
+	return m.ListActivityFunc(context.Background(), parameters)
+}
+
+func (m *Mock) ListActivityContext(ctx context.Context, parameters map[string]interface{}) (r0 []mailchimp.ListActivityElement, err error) {
+	m.record("ListActivityContext", parameters)
+	if m.ListActivityFunc == nil {
+		err = NotMockedError{"ListActivityContext"}
+		return
+	}
+	return m.ListActivityFunc(ctx, parameters)
+}
+
+func (m *Mock) ListActivityWithParams(ctx context.Context, params *mailchimp.ListActivityParams) (r0 []mailchimp.ListActivityElement, err error) {
+	m.record("ListActivityWithParams", params)
+	if m.ListActivityWithParamsFunc != nil {
+		return m.ListActivityWithParamsFunc(ctx, params)
+	}
+	parameters, err := mailchimp.ToParameters(params)
+	if err != nil {
+		return
+	}
+	if m.ListActivityFunc == nil {
+		err = NotMockedError{"ListActivityWithParams"}
+		return
+	}
+	return m.ListActivityFunc(ctx, parameters)
+}
+
+func (m *Mock) ListBatchSubscribe(parameters map[string]interface{}) (r0 *mailchimp.ListBatchSubscribeResponse, err error) {
+	m.record("ListBatchSubscribe", parameters)
+	if m.ListBatchSubscribeFunc == nil {
+		err = NotMockedError{"ListBatchSubscribe"}
+		return
+	}
+	return m.ListBatchSubscribeFunc(context.Background(), parameters)
+}
+
+func (m *Mock) ListBatchSubscribeContext(ctx context.Context, parameters map[string]interface{}) (r0 *mailchimp.ListBatchSubscribeResponse, err error) {
+	m.record("ListBatchSubscribeContext", parameters)
+	if m.ListBatchSubscribeFunc == nil {
+		err = NotMockedError{"ListBatchSubscribeContext"}
+		return
+	}
+	return m.ListBatchSubscribeFunc(ctx, parameters)
+}
+
+func (m *Mock) ListBatchSubscribeWithParams(ctx context.Context, params *mailchimp.ListBatchSubscribeParams) (r0 *mailchimp.ListBatchSubscribeResponse, err error) {
+	m.record("ListBatchSubscribeWithParams", params)
+	if m.ListBatchSubscribeWithParamsFunc != nil {
+		return m.ListBatchSubscribeWithParamsFunc(ctx, params)
+	}
+	parameters, err := mailchimp.ToParameters(params)
+	if err != nil {
+		return
+	}
+	if m.ListBatchSubscribeFunc == nil {
+		err = NotMockedError{"ListBatchSubscribeWithParams"}
+		return
+	}
+	return m.ListBatchSubscribeFunc(ctx, parameters)
+}
+
+func (m *Mock) ListBatchUnsubscribe(parameters map[string]interface{}) (r0 *mailchimp.ListBatchUnsubscribeResponse, err error) {
+	m.record("ListBatchUnsubscribe", parameters)
+	if m.ListBatchUnsubscribeFunc == nil {
+		err = NotMockedError{"ListBatchUnsubscribe"}
+		return
+	}
+	return m.ListBatchUnsubscribeFunc(context.Background(), parameters)
+}
+
+func (m *Mock) ListBatchUnsubscribeContext(ctx context.Context, parameters map[string]interface{}) (r0 *mailchimp.ListBatchUnsubscribeResponse, err error) {
+	m.record("ListBatchUnsubscribeContext", parameters)
+	if m.ListBatchUnsubscribeFunc == nil {
+		err = NotMockedError{"ListBatchUnsubscribeContext"}
+		return
+	}
+	return m.ListBatchUnsubscribeFunc(ctx, parameters)
+}
+
+func (m *Mock) ListBatchUnsubscribeWithParams(ctx context.Context, params *mailchimp.ListBatchUnsubscribeParams) (r0 *mailchimp.ListBatchUnsubscribeResponse, err error) {
+	m.record("ListBatchUnsubscribeWithParams", params)
+	if m.ListBatchUnsubscribeWithParamsFunc != nil {
+		return m.ListBatchUnsubscribeWithParamsFunc(ctx, params)
+	}
+	parameters, err := mailchimp.ToParameters(params)
+	if err != nil {
+		return
+	}
+	if m.ListBatchUnsubscribeFunc == nil {
+		err = NotMockedError{"ListBatchUnsubscribeWithParams"}
+		return
+	}
+	return m.ListBatchUnsubscribeFunc(ctx, parameters)
+}
+
+func (m *Mock) ListClients(parameters map[string]interface{}) (r0 *mailchimp.ListClientsResponse, err error) {
+	m.record("ListClients", parameters)
+	if m.ListClientsFunc == nil {
+		err = NotMockedError{"ListClients"}
+		return
+	}
+	return m.ListClientsFunc(context.Background(), parameters)
+}
+
+func (m *Mock) ListClientsContext(ctx context.Context, parameters map[string]interface{}) (r0 *mailchimp.ListClientsResponse, err error) {
+	m.record("ListClientsContext", parameters)
+	if m.ListClientsFunc == nil {
+		err = NotMockedError{"ListClientsContext"}
+		return
+	}
+	return m.ListClientsFunc(ctx, parameters)
+}
+
+func (m *Mock) ListClientsWithParams(ctx context.Context, params *mailchimp.ListClientsParams) (r0 *mailchimp.ListClientsResponse, err error) {
+	m.record("ListClientsWithParams", params)
+	if m.ListClientsWithParamsFunc != nil {
+		return m.ListClientsWithParamsFunc(ctx, params)
+	}
+	parameters, err := mailchimp.ToParameters(params)
+	if err != nil {
+		return
+	}
+	if m.ListClientsFunc == nil {
+		err = NotMockedError{"ListClientsWithParams"}
+		return
+	}
+	return m.ListClientsFunc(ctx, parameters)
+}
+
+func (m *Mock) ListGrowthHistory(parameters map[string]interface{}) (r0 *mailchimp.ListGrowthHistoryResponse, err error) {
+	m.record("ListGrowthHistory", parameters)
+	if m.ListGrowthHistoryFunc == nil {
+		err = NotMockedError{"ListGrowthHistory"}
+		return
+	}
+	return m.ListGrowthHistoryFunc(context.Background(), parameters)
+}
+
+func (m *Mock) ListGrowthHistoryContext(ctx context.Context, parameters map[string]interface{}) (r0 *mailchimp.ListGrowthHistoryResponse, err error) {
+	m.record("ListGrowthHistoryContext", parameters)
+	if m.ListGrowthHistoryFunc == nil {
+		err = NotMockedError{"ListGrowthHistoryContext"}
+		return
+	}
+	return m.ListGrowthHistoryFunc(ctx, parameters)
+}
+
+func (m *Mock) ListGrowthHistoryWithParams(ctx context.Context, params *mailchimp.ListGrowthHistoryParams) (r0 *mailchimp.ListGrowthHistoryResponse, err error) {
+	m.record("ListGrowthHistoryWithParams", params)
+	if m.ListGrowthHistoryWithParamsFunc != nil {
+		return m.ListGrowthHistoryWithParamsFunc(ctx, params)
+	}
+	parameters, err := mailchimp.ToParameters(params)
+	if err != nil {
+		return
+	}
+	if m.ListGrowthHistoryFunc == nil {
+		err = NotMockedError{"ListGrowthHistoryWithParams"}
+		return
+	}
+	return m.ListGrowthHistoryFunc(ctx, parameters)
+}
+
+func (m *Mock) ListInterestGroupAdd(parameters map[string]interface{}) (r0 bool, err error) {
+	m.record("ListInterestGroupAdd", parameters)
+	if m.ListInterestGroupAddFunc == nil {
+		err = NotMockedError{"ListInterestGroupAdd"}
+		return
+	}
+	return m.ListInterestGroupAddFunc(context.Background(), parameters)
+}
+
+func (m *Mock) ListInterestGroupAddContext(ctx context.Context, parameters map[string]interface{}) (r0 bool, err error) {
+	m.record("ListInterestGroupAddContext", parameters)
+	if m.ListInterestGroupAddFunc == nil {
+		err = NotMockedError{"ListInterestGroupAddContext"}
+		return
+	}
+	return m.ListInterestGroupAddFunc(ctx, parameters)
+}
+
+func (m *Mock) ListInterestGroupAddWithParams(ctx context.Context, params *mailchimp.ListInterestGroupAddParams) (r0 bool, err error) {
+	m.record("ListInterestGroupAddWithParams", params)
+	if m.ListInterestGroupAddWithParamsFunc != nil {
+		return m.ListInterestGroupAddWithParamsFunc(ctx, params)
+	}
+	parameters, err := mailchimp.ToParameters(params)
+	if err != nil {
+		return
+	}
+	if m.ListInterestGroupAddFunc == nil {
+		err = NotMockedError{"ListInterestGroupAddWithParams"}
+		return
+	}
+	return m.ListInterestGroupAddFunc(ctx, parameters)
+}
+
+func (m *Mock) ListInterestGroupDel(parameters map[string]interface{}) (r0 bool, err error) {
+	m.record("ListInterestGroupDel", parameters)
+	if m.ListInterestGroupDelFunc == nil {
+		err = NotMockedError{"ListInterestGroupDel"}
+		return
+	}
+	return m.ListInterestGroupDelFunc(context.Background(), parameters)
+}
+
+func (m *Mock) ListInterestGroupDelContext(ctx context.Context, parameters map[string]interface{}) (r0 bool, err error) {
+	m.record("ListInterestGroupDelContext", parameters)
+	if m.ListInterestGroupDelFunc == nil {
+		err = NotMockedError{"ListInterestGroupDelContext"}
+		return
+	}
+	return m.ListInterestGroupDelFunc(ctx, parameters)
+}
+
+func (m *Mock) ListInterestGroupDelWithParams(ctx context.Context, params *mailchimp.ListInterestGroupDelParams) (r0 bool, err error) {
+	m.record("ListInterestGroupDelWithParams", params)
+	if m.ListInterestGroupDelWithParamsFunc != nil {
+		return m.ListInterestGroupDelWithParamsFunc(ctx, params)
+	}
+	parameters, err := mailchimp.ToParameters(params)
+	if err != nil {
+		return
+	}
+	if m.ListInterestGroupDelFunc == nil {
+		err = NotMockedError{"ListInterestGroupDelWithParams"}
+		return
+	}
+	return m.ListInterestGroupDelFunc(ctx, parameters)
+}
+
+func (m *Mock) ListInterestGroupUpdate(parameters map[string]interface{}) (r0 bool, err error) {
+	m.record("ListInterestGroupUpdate", parameters)
+	if m.ListInterestGroupUpdateFunc == nil {
+		err = NotMockedError{"ListInterestGroupUpdate"}
+		return
+	}
+	return m.ListInterestGroupUpdateFunc(context.Background(), parameters)
+}
+
+func (m *Mock) ListInterestGroupUpdateContext(ctx context.Context, parameters map[string]interface{}) (r0 bool, err error) {
+	m.record("ListInterestGroupUpdateContext", parameters)
+	if m.ListInterestGroupUpdateFunc == nil {
+		err = NotMockedError{"ListInterestGroupUpdateContext"}
+		return
+	}
+	return m.ListInterestGroupUpdateFunc(ctx, parameters)
+}
+
+func (m *Mock) ListInterestGroupUpdateWithParams(ctx context.Context, params *mailchimp.ListInterestGroupUpdateParams) (r0 bool, err error) {
+	m.record("ListInterestGroupUpdateWithParams", params)
+	if m.ListInterestGroupUpdateWithParamsFunc != nil {
+		return m.ListInterestGroupUpdateWithParamsFunc(ctx, params)
+	}
+	parameters, err := mailchimp.ToParameters(params)
+	if err != nil {
+		return
+	}
+	if m.ListInterestGroupUpdateFunc == nil {
+		err = NotMockedError{"ListInterestGroupUpdateWithParams"}
+		return
+	}
+	return m.ListInterestGroupUpdateFunc(ctx, parameters)
+}
+
+func (m *Mock) ListInterestGroupingAdd(parameters map[string]interface{}) (r0 int, err error) {
+	m.record("ListInterestGroupingAdd", parameters)
+	if m.ListInterestGroupingAddFunc == nil {
+		err = NotMockedError{"ListInterestGroupingAdd"}
+		return
+	}
+	return m.ListInterestGroupingAddFunc(context.Background(), parameters)
+}
+
+func (m *Mock) ListInterestGroupingAddContext(ctx context.Context, parameters map[string]interface{}) (r0 int, err error) {
+	m.record("ListInterestGroupingAddContext", parameters)
+	if m.ListInterestGroupingAddFunc == nil {
+		err = NotMockedError{"ListInterestGroupingAddContext"}
+		return
+	}
+	return m.ListInterestGroupingAddFunc(ctx, parameters)
+}
+
+func (m *Mock) ListInterestGroupingAddWithParams(ctx context.Context, params *mailchimp.ListInterestGroupingAddParams) (r0 int, err error) {
+	m.record("ListInterestGroupingAddWithParams", params)
+	if m.ListInterestGroupingAddWithParamsFunc != nil {
+		return m.ListInterestGroupingAddWithParamsFunc(ctx, params)
+	}
+	parameters, err := mailchimp.ToParameters(params)
+	if err != nil {
+		return
+	}
+	if m.ListInterestGroupingAddFunc == nil {
+		err = NotMockedError{"ListInterestGroupingAddWithParams"}
+		return
+	}
+	return m.ListInterestGroupingAddFunc(ctx, parameters)
+}
+
+func (m *Mock) ListInterestGroupingDel(parameters map[string]interface{}) (r0 bool, err error) {
+	m.record("ListInterestGroupingDel", parameters)
+	if m.ListInterestGroupingDelFunc == nil {
+		err = NotMockedError{"ListInterestGroupingDel"}
+		return
+	}
+	return m.ListInterestGroupingDelFunc(context.Background(), parameters)
+}
+
+func (m *Mock) ListInterestGroupingDelContext(ctx context.Context, parameters map[string]interface{}) (r0 bool, err error) {
+	m.record("ListInterestGroupingDelContext", parameters)
+	if m.ListInterestGroupingDelFunc == nil {
+		err = NotMockedError{"ListInterestGroupingDelContext"}
+		return
+	}
+	return m.ListInterestGroupingDelFunc(ctx, parameters)
+}
+
+func (m *Mock) ListInterestGroupingDelWithParams(ctx context.Context, params *mailchimp.ListInterestGroupingDelParams) (r0 bool, err error) {
+	m.record("ListInterestGroupingDelWithParams", params)
+	if m.ListInterestGroupingDelWithParamsFunc != nil {
+		return m.ListInterestGroupingDelWithParamsFunc(ctx, params)
+	}
+	parameters, err := mailchimp.ToParameters(params)
+	if err != nil {
+		return
+	}
+	if m.ListInterestGroupingDelFunc == nil {
+		err = NotMockedError{"ListInterestGroupingDelWithParams"}
+		return
+	}
+	return m.ListInterestGroupingDelFunc(ctx, parameters)
+}
+
+func (m *Mock) ListInterestGroupingUpdate(parameters map[string]interface{}) (r0 bool, err error) {
+	m.record("ListInterestGroupingUpdate", parameters)
+	if m.ListInterestGroupingUpdateFunc == nil {
+		err = NotMockedError{"ListInterestGroupingUpdate"}
+		return
+	}
+	return m.ListInterestGroupingUpdateFunc(context.Background(), parameters)
+}
+
+func (m *Mock) ListInterestGroupingUpdateContext(ctx context.Context, parameters map[string]interface{}) (r0 bool, err error) {
+	m.record("ListInterestGroupingUpdateContext", parameters)
+	if m.ListInterestGroupingUpdateFunc == nil {
+		err = NotMockedError{"ListInterestGroupingUpdateContext"}
+		return
+	}
+	return m.ListInterestGroupingUpdateFunc(ctx, parameters)
+}
+
+func (m *Mock) ListInterestGroupingUpdateWithParams(ctx context.Context, params *mailchimp.ListInterestGroupingUpdateParams) (r0 bool, err error) {
+	m.record("ListInterestGroupingUpdateWithParams", params)
+	if m.ListInterestGroupingUpdateWithParamsFunc != nil {
+		return m.ListInterestGroupingUpdateWithParamsFunc(ctx, params)
+	}
+	parameters, err := mailchimp.ToParameters(params)
+	if err != nil {
+		return
+	}
+	if m.ListInterestGroupingUpdateFunc == nil {
+		err = NotMockedError{"ListInterestGroupingUpdateWithParams"}
+		return
+	}
+	return m.ListInterestGroupingUpdateFunc(ctx, parameters)
+}
+
+func (m *Mock) ListInterestGroupings(parameters map[string]interface{}) (r0 []mailchimp.ListInterestGroupingsElement, err error) {
+	m.record("ListInterestGroupings", parameters)
+	if m.ListInterestGroupingsFunc == nil {
+		err = NotMockedError{"ListInterestGroupings"}
+		return
+	}
+	return m.ListInterestGroupingsFunc(context.Background(), parameters)
+}
+
+func (m *Mock) ListInterestGroupingsContext(ctx context.Context, parameters map[string]interface{}) (r0 []mailchimp.ListInterestGroupingsElement, err error) {
+	m.record("ListInterestGroupingsContext", parameters)
+	if m.ListInterestGroupingsFunc == nil {
+		err = NotMockedError{"ListInterestGroupingsContext"}
+		return
+	}
+	return m.ListInterestGroupingsFunc(ctx, parameters)
+}
+
+func (m *Mock) ListInterestGroupingsWithParams(ctx context.Context, params *mailchimp.ListInterestGroupingsParams) (r0 []mailchimp.ListInterestGroupingsElement, err error) {
+	m.record("ListInterestGroupingsWithParams", params)
+	if m.ListInterestGroupingsWithParamsFunc != nil {
+		return m.ListInterestGroupingsWithParamsFunc(ctx, params)
+	}
+	parameters, err := mailchimp.ToParameters(params)
+	if err != nil {
+		return
+	}
+	if m.ListInterestGroupingsFunc == nil {
+		err = NotMockedError{"ListInterestGroupingsWithParams"}
+		return
+	}
+	return m.ListInterestGroupingsFunc(ctx, parameters)
+}
+
+func (m *Mock) ListMemberActivity(parameters map[string]interface{}) (r0 *mailchimp.ListMemberActivityResponse, err error) {
+	m.record("ListMemberActivity", parameters)
+	if m.ListMemberActivityFunc == nil {
+		err = NotMockedError{"ListMemberActivity"}
+		return
+	}
+	return m.ListMemberActivityFunc(context.Background(), parameters)
+}
+
+func (m *Mock) ListMemberActivityContext(ctx context.Context, parameters map[string]interface{}) (r0 *mailchimp.ListMemberActivityResponse, err error) {
+	m.record("ListMemberActivityContext", parameters)
+	if m.ListMemberActivityFunc == nil {
+		err = NotMockedError{"ListMemberActivityContext"}
+		return
+	}
+	return m.ListMemberActivityFunc(ctx, parameters)
+}
+
+func (m *Mock) ListMemberActivityWithParams(ctx context.Context, params *mailchimp.ListMemberActivityParams) (r0 *mailchimp.ListMemberActivityResponse, err error) {
+	m.record("ListMemberActivityWithParams", params)
+	if m.ListMemberActivityWithParamsFunc != nil {
+		return m.ListMemberActivityWithParamsFunc(ctx, params)
+	}
+	parameters, err := mailchimp.ToParameters(params)
+	if err != nil {
+		return
+	}
+	if m.ListMemberActivityFunc == nil {
+		err = NotMockedError{"ListMemberActivityWithParams"}
+		return
+	}
+	return m.ListMemberActivityFunc(ctx, parameters)
+}
+
+func (m *Mock) ListMemberInfo(parameters map[string]interface{}) (r0 *mailchimp.ListMemberInfoResponse, err error) {
+	m.record("ListMemberInfo", parameters)
+	if m.ListMemberInfoFunc == nil {
+		err = NotMockedError{"ListMemberInfo"}
+		return
+	}
+	return m.ListMemberInfoFunc(context.Background(), parameters)
+}
+
+func (m *Mock) ListMemberInfoContext(ctx context.Context, parameters map[string]interface{}) (r0 *mailchimp.ListMemberInfoResponse, err error) {
+	m.record("ListMemberInfoContext", parameters)
+	if m.ListMemberInfoFunc == nil {
+		err = NotMockedError{"ListMemberInfoContext"}
+		return
+	}
+	return m.ListMemberInfoFunc(ctx, parameters)
+}
+
+func (m *Mock) ListMemberInfoWithParams(ctx context.Context, params *mailchimp.ListMemberInfoParams) (r0 *mailchimp.ListMemberInfoResponse, err error) {
+	m.record("ListMemberInfoWithParams", params)
+	if m.ListMemberInfoWithParamsFunc != nil {
+		return m.ListMemberInfoWithParamsFunc(ctx, params)
+	}
+	parameters, err := mailchimp.ToParameters(params)
+	if err != nil {
+		return
+	}
+	if m.ListMemberInfoFunc == nil {
+		err = NotMockedError{"ListMemberInfoWithParams"}
+		return
+	}
+	return m.ListMemberInfoFunc(ctx, parameters)
+}
+
+func (m *Mock) ListMembers(parameters map[string]interface{}) (r0 *mailchimp.ListMembersResponse, err error) {
+	m.record("ListMembers", parameters)
+	if m.ListMembersFunc == nil {
+		err = NotMockedError{"ListMembers"}
+		return
+	}
+	return m.ListMembersFunc(context.Background(), parameters)
+}
+
+func (m *Mock) ListMembersChangedSince(ctx context.Context, id string, status string, since time.Time) (r0 *mailchimp.Pager[mailchimp.ListMember]) {
+	m.record("ListMembersChangedSince", id, status, since)
+	if m.ListMembersChangedSinceFunc == nil {
+		return mailchimp.PagerOf[mailchimp.ListMember](nil, NotMockedError{"ListMembersChangedSince"})
+	}
+	return m.ListMembersChangedSinceFunc(ctx, id, status, since)
+}
+
+func (m *Mock) ListMembersContext(ctx context.Context, parameters map[string]interface{}) (r0 *mailchimp.ListMembersResponse, err error) {
+	m.record("ListMembersContext", parameters)
+	if m.ListMembersFunc == nil {
+		err = NotMockedError{"ListMembersContext"}
+		return
+	}
+	return m.ListMembersFunc(ctx, parameters)
+}
+
+func (m *Mock) ListMembersPager(ctx context.Context, parameters map[string]interface{}) (r0 *mailchimp.Pager[mailchimp.ListMember]) {
+	m.record("ListMembersPager", parameters)
+	if m.ListMembersPagerFunc == nil {
+		return mailchimp.PagerOf[mailchimp.ListMember](nil, NotMockedError{"ListMembersPager"})
+	}
+	return m.ListMembersPagerFunc(ctx, parameters)
+}
+
+func (m *Mock) ListMembersWithParams(ctx context.Context, params *mailchimp.ListMembersParams) (r0 *mailchimp.ListMembersResponse, err error) {
+	m.record("ListMembersWithParams", params)
+	if m.ListMembersWithParamsFunc != nil {
+		return m.ListMembersWithParamsFunc(ctx, params)
+	}
+	parameters, err := mailchimp.ToParameters(params)
+	if err != nil {
+		return
+	}
+	if m.ListMembersFunc == nil {
+		err = NotMockedError{"ListMembersWithParams"}
+		return
+	}
+	return m.ListMembersFunc(ctx, parameters)
+}
+
+func (m *Mock) ListMergeVarAdd(parameters map[string]interface{}) (r0 bool, err error) {
+	m.record("ListMergeVarAdd", parameters)
+	if m.ListMergeVarAddFunc == nil {
+		err = NotMockedError{"ListMergeVarAdd"}
+		return
+	}
+	return m.ListMergeVarAddFunc(context.Background(), parameters)
+}
+
+func (m *Mock) ListMergeVarAddContext(ctx context.Context, parameters map[string]interface{}) (r0 bool, err error) {
+	m.record("ListMergeVarAddContext", parameters)
+	if m.ListMergeVarAddFunc == nil {
+		err = NotMockedError{"ListMergeVarAddContext"}
+		return
+	}
+	return m.ListMergeVarAddFunc(ctx, parameters)
+}
+
+func (m *Mock) ListMergeVarAddWithParams(ctx context.Context, params *mailchimp.ListMergeVarAddParams) (r0 bool, err error) {
+	m.record("ListMergeVarAddWithParams", params)
+	if m.ListMergeVarAddWithParamsFunc != nil {
+		return m.ListMergeVarAddWithParamsFunc(ctx, params)
+	}
+	parameters, err := mailchimp.ToParameters(params)
+	if err != nil {
+		return
+	}
+	if m.ListMergeVarAddFunc == nil {
+		err = NotMockedError{"ListMergeVarAddWithParams"}
+		return
+	}
+	return m.ListMergeVarAddFunc(ctx, parameters)
+}
+
+func (m *Mock) ListMergeVarDel(parameters map[string]interface{}) (r0 bool, err error) {
+	m.record("ListMergeVarDel", parameters)
+	if m.ListMergeVarDelFunc == nil {
+		err = NotMockedError{"ListMergeVarDel"}
+		return
+	}
+	return m.ListMergeVarDelFunc(context.Background(), parameters)
+}
+
+func (m *Mock) ListMergeVarDelContext(ctx context.Context, parameters map[string]interface{}) (r0 bool, err error) {
+	m.record("ListMergeVarDelContext", parameters)
+	if m.ListMergeVarDelFunc == nil {
+		err = NotMockedError{"ListMergeVarDelContext"}
+		return
+	}
+	return m.ListMergeVarDelFunc(ctx, parameters)
+}
+
+func (m *Mock) ListMergeVarDelWithParams(ctx context.Context, params *mailchimp.ListMergeVarDelParams) (r0 bool, err error) {
+	m.record("ListMergeVarDelWithParams", params)
+	if m.ListMergeVarDelWithParamsFunc != nil {
+		return m.ListMergeVarDelWithParamsFunc(ctx, params)
+	}
+	parameters, err := mailchimp.ToParameters(params)
+	if err != nil {
+		return
+	}
+	if m.ListMergeVarDelFunc == nil {
+		err = NotMockedError{"ListMergeVarDelWithParams"}
+		return
+	}
+	return m.ListMergeVarDelFunc(ctx, parameters)
+}
+
+func (m *Mock) ListMergeVarReset(parameters map[string]interface{}) (r0 bool, err error) {
+	m.record("ListMergeVarReset", parameters)
+	if m.ListMergeVarResetFunc == nil {
+		err = NotMockedError{"ListMergeVarReset"}
+		return
+	}
+	return m.ListMergeVarResetFunc(context.Background(), parameters)
+}
+
+func (m *Mock) ListMergeVarResetContext(ctx context.Context, parameters map[string]interface{}) (r0 bool, err error) {
+	m.record("ListMergeVarResetContext", parameters)
+	if m.ListMergeVarResetFunc == nil {
+		err = NotMockedError{"ListMergeVarResetContext"}
+		return
+	}
+	return m.ListMergeVarResetFunc(ctx, parameters)
+}
+
+func (m *Mock) ListMergeVarResetWithParams(ctx context.Context, params *mailchimp.ListMergeVarResetParams) (r0 bool, err error) {
+	m.record("ListMergeVarResetWithParams", params)
+	if m.ListMergeVarResetWithParamsFunc != nil {
+		return m.ListMergeVarResetWithParamsFunc(ctx, params)
+	}
+	parameters, err := mailchimp.ToParameters(params)
+	if err != nil {
+		return
+	}
+	if m.ListMergeVarResetFunc == nil {
+		err = NotMockedError{"ListMergeVarResetWithParams"}
+		return
+	}
+	return m.ListMergeVarResetFunc(ctx, parameters)
+}
+
+func (m *Mock) ListMergeVarSetValue(parameters map[string]interface{}) (r0 bool, err error) {
+	m.record("ListMergeVarSetValue", parameters)
+	if m.ListMergeVarSetValueFunc == nil {
+		err = NotMockedError{"ListMergeVarSetValue"}
+		return
+	}
+	return m.ListMergeVarSetValueFunc(context.Background(), parameters)
+}
+
+func (m *Mock) ListMergeVarSetValueContext(ctx context.Context, parameters map[string]interface{}) (r0 bool, err error) {
+	m.record("ListMergeVarSetValueContext", parameters)
+	if m.ListMergeVarSetValueFunc == nil {
+		err = NotMockedError{"ListMergeVarSetValueContext"}
+		return
+	}
+	return m.ListMergeVarSetValueFunc(ctx, parameters)
+}
+
+func (m *Mock) ListMergeVarSetValueWithParams(ctx context.Context, params *mailchimp.ListMergeVarSetValueParams) (r0 bool, err error) {
+	m.record("ListMergeVarSetValueWithParams", params)
+	if m.ListMergeVarSetValueWithParamsFunc != nil {
+		return m.ListMergeVarSetValueWithParamsFunc(ctx, params)
+	}
+	parameters, err := mailchimp.ToParameters(params)
+	if err != nil {
+		return
+	}
+	if m.ListMergeVarSetValueFunc == nil {
+		err = NotMockedError{"ListMergeVarSetValueWithParams"}
+		return
+	}
+	return m.ListMergeVarSetValueFunc(ctx, parameters)
+}
+
+func (m *Mock) ListMergeVarUpdate(parameters map[string]interface{}) (r0 bool, err error) {
+	m.record("ListMergeVarUpdate", parameters)
+	if m.ListMergeVarUpdateFunc == nil {
+		err = NotMockedError{"ListMergeVarUpdate"}
+		return
+	}
+	return m.ListMergeVarUpdateFunc(context.Background(), parameters)
+}
+
+func (m *Mock) ListMergeVarUpdateContext(ctx context.Context, parameters map[string]interface{}) (r0 bool, err error) {
+	m.record("ListMergeVarUpdateContext", parameters)
+	if m.ListMergeVarUpdateFunc == nil {
+		err = NotMockedError{"ListMergeVarUpdateContext"}
+		return
+	}
+	return m.ListMergeVarUpdateFunc(ctx, parameters)
+}
+
+func (m *Mock) ListMergeVarUpdateWithParams(ctx context.Context, params *mailchimp.ListMergeVarUpdateParams) (r0 bool, err error) {
+	m.record("ListMergeVarUpdateWithParams", params)
+	if m.ListMergeVarUpdateWithParamsFunc != nil {
+		return m.ListMergeVarUpdateWithParamsFunc(ctx, params)
+	}
+	parameters, err := mailchimp.ToParameters(params)
+	if err != nil {
+		return
+	}
+	if m.ListMergeVarUpdateFunc == nil {
+		err = NotMockedError{"ListMergeVarUpdateWithParams"}
+		return
+	}
+	return m.ListMergeVarUpdateFunc(ctx, parameters)
+}
+
+func (m *Mock) ListMergeVars(parameters map[string]interface{}) (r0 []mailchimp.MergeVar, err error) {
+	m.record("ListMergeVars", parameters)
+	if m.ListMergeVarsFunc == nil {
+		err = NotMockedError{"ListMergeVars"}
+		return
+	}
+	return m.ListMergeVarsFunc(context.Background(), parameters)
+}
+
+func (m *Mock) ListMergeVarsContext(ctx context.Context, parameters map[string]interface{}) (r0 []mailchimp.MergeVar, err error) {
+	m.record("ListMergeVarsContext", parameters)
+	if m.ListMergeVarsFunc == nil {
+		err = NotMockedError{"ListMergeVarsContext"}
+		return
+	}
+	return m.ListMergeVarsFunc(ctx, parameters)
+}
+
+func (m *Mock) ListMergeVarsWithParams(ctx context.Context, params *mailchimp.ListMergeVarsParams) (r0 []mailchimp.MergeVar, err error) {
+	m.record("ListMergeVarsWithParams", params)
+	if m.ListMergeVarsWithParamsFunc != nil {
+		return m.ListMergeVarsWithParamsFunc(ctx, params)
+	}
+	parameters, err := mailchimp.ToParameters(params)
+	if err != nil {
+		return
+	}
+	if m.ListMergeVarsFunc == nil {
+		err = NotMockedError{"ListMergeVarsWithParams"}
+		return
+	}
+	return m.ListMergeVarsFunc(ctx, parameters)
+}
+
+func (m *Mock) ListStaticSegmentAdd(parameters map[string]interface{}) (r0 int, err error) {
+	m.record("ListStaticSegmentAdd", parameters)
+	if m.ListStaticSegmentAddFunc == nil {
+		err = NotMockedError{"ListStaticSegmentAdd"}
+		return
+	}
+	return m.ListStaticSegmentAddFunc(context.Background(), parameters)
+}
+
+func (m *Mock) ListStaticSegmentAddContext(ctx context.Context, parameters map[string]interface{}) (r0 int, err error) {
+	m.record("ListStaticSegmentAddContext", parameters)
+	if m.ListStaticSegmentAddFunc == nil {
+		err = NotMockedError{"ListStaticSegmentAddContext"}
+		return
+	}
+	return m.ListStaticSegmentAddFunc(ctx, parameters)
+}
+
+func (m *Mock) ListStaticSegmentAddWithParams(ctx context.Context, params *mailchimp.ListStaticSegmentAddParams) (r0 int, err error) {
+	m.record("ListStaticSegmentAddWithParams", params)
+	if m.ListStaticSegmentAddWithParamsFunc != nil {
+		return m.ListStaticSegmentAddWithParamsFunc(ctx, params)
+	}
+	parameters, err := mailchimp.ToParameters(params)
+	if err != nil {
+		return
+	}
+	if m.ListStaticSegmentAddFunc == nil {
+		err = NotMockedError{"ListStaticSegmentAddWithParams"}
+		return
+	}
+	return m.ListStaticSegmentAddFunc(ctx, parameters)
+}
+
+func (m *Mock) ListStaticSegmentDel(parameters map[string]interface{}) (r0 bool, err error) {
+	m.record("ListStaticSegmentDel", parameters)
+	if m.ListStaticSegmentDelFunc == nil {
+		err = NotMockedError{"ListStaticSegmentDel"}
+		return
+	}
+	return m.ListStaticSegmentDelFunc(context.Background(), parameters)
+}
+
+func (m *Mock) ListStaticSegmentDelContext(ctx context.Context, parameters map[string]interface{}) (r0 bool, err error) {
+	m.record("ListStaticSegmentDelContext", parameters)
+	if m.ListStaticSegmentDelFunc == nil {
+		err = NotMockedError{"ListStaticSegmentDelContext"}
+		return
+	}
+	return m.ListStaticSegmentDelFunc(ctx, parameters)
+}
+
+func (m *Mock) ListStaticSegmentDelWithParams(ctx context.Context, params *mailchimp.ListStaticSegmentDelParams) (r0 bool, err error) {
+	m.record("ListStaticSegmentDelWithParams", params)
+	if m.ListStaticSegmentDelWithParamsFunc != nil {
+		return m.ListStaticSegmentDelWithParamsFunc(ctx, params)
+	}
+	parameters, err := mailchimp.ToParameters(params)
+	if err != nil {
+		return
+	}
+	if m.ListStaticSegmentDelFunc == nil {
+		err = NotMockedError{"ListStaticSegmentDelWithParams"}
+		return
+	}
+	return m.ListStaticSegmentDelFunc(ctx, parameters)
+}
+
+func (m *Mock) ListStaticSegmentMembersAdd(parameters map[string]interface{}) (r0 *mailchimp.StaticSegmentMembersResponse, err error) {
+	m.record("ListStaticSegmentMembersAdd", parameters)
+	if m.ListStaticSegmentMembersAddFunc == nil {
+		err = NotMockedError{"ListStaticSegmentMembersAdd"}
+		return
+	}
+	return m.ListStaticSegmentMembersAddFunc(context.Background(), parameters)
+}
+
+func (m *Mock) ListStaticSegmentMembersAddContext(ctx context.Context, parameters map[string]interface{}) (r0 *mailchimp.StaticSegmentMembersResponse, err error) {
+	m.record("ListStaticSegmentMembersAddContext", parameters)
+	if m.ListStaticSegmentMembersAddFunc == nil {
+		err = NotMockedError{"ListStaticSegmentMembersAddContext"}
+		return
+	}
+	return m.ListStaticSegmentMembersAddFunc(ctx, parameters)
+}
+
+func (m *Mock) ListStaticSegmentMembersAddWithParams(ctx context.Context, params *mailchimp.ListStaticSegmentMembersAddParams) (r0 *mailchimp.StaticSegmentMembersResponse, err error) {
+	m.record("ListStaticSegmentMembersAddWithParams", params)
+	if m.ListStaticSegmentMembersAddWithParamsFunc != nil {
+		return m.ListStaticSegmentMembersAddWithParamsFunc(ctx, params)
+	}
+	parameters, err := mailchimp.ToParameters(params)
+	if err != nil {
+		return
+	}
+	if m.ListStaticSegmentMembersAddFunc == nil {
+		err = NotMockedError{"ListStaticSegmentMembersAddWithParams"}
+		return
+	}
+	return m.ListStaticSegmentMembersAddFunc(ctx, parameters)
+}
+
+func (m *Mock) ListStaticSegmentMembersDel(parameters map[string]interface{}) (r0 *mailchimp.StaticSegmentMembersResponse, err error) {
+	m.record("ListStaticSegmentMembersDel", parameters)
+	if m.ListStaticSegmentMembersDelFunc == nil {
+		err = NotMockedError{"ListStaticSegmentMembersDel"}
+		return
+	}
+	return m.ListStaticSegmentMembersDelFunc(context.Background(), parameters)
+}
+
+func (m *Mock) ListStaticSegmentMembersDelContext(ctx context.Context, parameters map[string]interface{}) (r0 *mailchimp.StaticSegmentMembersResponse, err error) {
+	m.record("ListStaticSegmentMembersDelContext", parameters)
+	if m.ListStaticSegmentMembersDelFunc == nil {
+		err = NotMockedError{"ListStaticSegmentMembersDelContext"}
+		return
+	}
+	return m.ListStaticSegmentMembersDelFunc(ctx, parameters)
+}
+
+func (m *Mock) ListStaticSegmentMembersDelWithParams(ctx context.Context, params *mailchimp.ListStaticSegmentMembersDelParams) (r0 *mailchimp.StaticSegmentMembersResponse, err error) {
+	m.record("ListStaticSegmentMembersDelWithParams", params)
+	if m.ListStaticSegmentMembersDelWithParamsFunc != nil {
+		return m.ListStaticSegmentMembersDelWithParamsFunc(ctx, params)
+	}
+	parameters, err := mailchimp.ToParameters(params)
+	if err != nil {
+		return
+	}
+	if m.ListStaticSegmentMembersDelFunc == nil {
+		err = NotMockedError{"ListStaticSegmentMembersDelWithParams"}
+		return
+	}
+	return m.ListStaticSegmentMembersDelFunc(ctx, parameters)
+}
+
+func (m *Mock) ListStaticSegmentReset(parameters map[string]interface{}) (r0 bool, err error) {
+	m.record("ListStaticSegmentReset", parameters)
+	if m.ListStaticSegmentResetFunc == nil {
+		err = NotMockedError{"ListStaticSegmentReset"}
+		return
+	}
+	return m.ListStaticSegmentResetFunc(context.Background(), parameters)
+}
+
+func (m *Mock) ListStaticSegmentResetContext(ctx context.Context, parameters map[string]interface{}) (r0 bool, err error) {
+	m.record("ListStaticSegmentResetContext", parameters)
+	if m.ListStaticSegmentResetFunc == nil {
+		err = NotMockedError{"ListStaticSegmentResetContext"}
+		return
+	}
+	return m.ListStaticSegmentResetFunc(ctx, parameters)
+}
+
+func (m *Mock) ListStaticSegmentResetWithParams(ctx context.Context, params *mailchimp.ListStaticSegmentResetParams) (r0 bool, err error) {
+	m.record("ListStaticSegmentResetWithParams", params)
+	if m.ListStaticSegmentResetWithParamsFunc != nil {
+		return m.ListStaticSegmentResetWithParamsFunc(ctx, params)
+	}
+	parameters, err := mailchimp.ToParameters(params)
+	if err != nil {
+		return
+	}
+	if m.ListStaticSegmentResetFunc == nil {
+		err = NotMockedError{"ListStaticSegmentResetWithParams"}
+		return
+	}
+	return m.ListStaticSegmentResetFunc(ctx, parameters)
+}
+
+func (m *Mock) ListStaticSegments(parameters map[string]interface{}) (r0 []mailchimp.StaticSegment, err error) {
+	m.record("ListStaticSegments", parameters)
+	if m.ListStaticSegmentsFunc == nil {
+		err = NotMockedError{"ListStaticSegments"}
+		return
+	}
+	return m.ListStaticSegmentsFunc(context.Background(), parameters)
+}
+
+func (m *Mock) ListStaticSegmentsContext(ctx context.Context, parameters map[string]interface{}) (r0 []mailchimp.StaticSegment, err error) {
+	m.record("ListStaticSegmentsContext", parameters)
+	if m.ListStaticSegmentsFunc == nil {
+		err = NotMockedError{"ListStaticSegmentsContext"}
+		return
+	}
+	return m.ListStaticSegmentsFunc(ctx, parameters)
+}
+
+func (m *Mock) ListStaticSegmentsWithParams(ctx context.Context, params *mailchimp.ListStaticSegmentsParams) (r0 []mailchimp.StaticSegment, err error) {
+	m.record("ListStaticSegmentsWithParams", params)
+	if m.ListStaticSegmentsWithParamsFunc != nil {
+		return m.ListStaticSegmentsWithParamsFunc(ctx, params)
+	}
+	parameters, err := mailchimp.ToParameters(params)
+	if err != nil {
+		return
+	}
+	if m.ListStaticSegmentsFunc == nil {
+		err = NotMockedError{"ListStaticSegmentsWithParams"}
+		return
+	}
+	return m.ListStaticSegmentsFunc(ctx, parameters)
+}
+
+func (m *Mock) ListSubscribe(parameters map[string]interface{}) (r0 bool, err error) {
+	m.record("ListSubscribe", parameters)
+	if m.ListSubscribeFunc == nil {
+		err = NotMockedError{"ListSubscribe"}
+		return
+	}
+	return m.ListSubscribeFunc(context.Background(), parameters)
+}
+
+func (m *Mock) ListSubscribeContext(ctx context.Context, parameters map[string]interface{}) (r0 bool, err error) {
+	m.record("ListSubscribeContext", parameters)
+	if m.ListSubscribeFunc == nil {
+		err = NotMockedError{"ListSubscribeContext"}
+		return
+	}
+	return m.ListSubscribeFunc(ctx, parameters)
+}
+
+func (m *Mock) ListSubscribeWithParams(ctx context.Context, params *mailchimp.ListSubscribeParams) (r0 bool, err error) {
+	m.record("ListSubscribeWithParams", params)
+	if m.ListSubscribeWithParamsFunc != nil {
+		return m.ListSubscribeWithParamsFunc(ctx, params)
+	}
+	parameters, err := mailchimp.ToParameters(params)
+	if err != nil {
+		return
+	}
+	if m.ListSubscribeFunc == nil {
+		err = NotMockedError{"ListSubscribeWithParams"}
+		return
+	}
+	return m.ListSubscribeFunc(ctx, parameters)
+}
+
+func (m *Mock) ListUnsubscribe(parameters map[string]interface{}) (r0 bool, err error) {
+	m.record("ListUnsubscribe", parameters)
+	if m.ListUnsubscribeFunc == nil {
+		err = NotMockedError{"ListUnsubscribe"}
+		return
+	}
+	return m.ListUnsubscribeFunc(context.Background(), parameters)
+}
+
+func (m *Mock) ListUnsubscribeContext(ctx context.Context, parameters map[string]interface{}) (r0 bool, err error) {
+	m.record("ListUnsubscribeContext", parameters)
+	if m.ListUnsubscribeFunc == nil {
+		err = NotMockedError{"ListUnsubscribeContext"}
+		return
+	}
+	return m.ListUnsubscribeFunc(ctx, parameters)
+}
+
+func (m *Mock) ListUnsubscribeWithParams(ctx context.Context, params *mailchimp.ListUnsubscribeParams) (r0 bool, err error) {
+	m.record("ListUnsubscribeWithParams", params)
+	if m.ListUnsubscribeWithParamsFunc != nil {
+		return m.ListUnsubscribeWithParamsFunc(ctx, params)
+	}
+	parameters, err := mailchimp.ToParameters(params)
+	if err != nil {
+		return
+	}
+	if m.ListUnsubscribeFunc == nil {
+		err = NotMockedError{"ListUnsubscribeWithParams"}
+		return
+	}
+	return m.ListUnsubscribeFunc(ctx, parameters)
+}
+
+func (m *Mock) ListUpdateMember(parameters map[string]interface{}) (r0 bool, err error) {
+	m.record("ListUpdateMember", parameters)
+	if m.ListUpdateMemberFunc == nil {
+		err = NotMockedError{"ListUpdateMember"}
+		return
+	}
+	return m.ListUpdateMemberFunc(context.Background(), parameters)
+}
+
+func (m *Mock) ListUpdateMemberContext(ctx context.Context, parameters map[string]interface{}) (r0 bool, err error) {
+	m.record("ListUpdateMemberContext", parameters)
+	if m.ListUpdateMemberFunc == nil {
+		err = NotMockedError{"ListUpdateMemberContext"}
+		return
+	}
+	return m.ListUpdateMemberFunc(ctx, parameters)
+}
+
+func (m *Mock) ListUpdateMemberWithParams(ctx context.Context, params *mailchimp.ListUpdateMemberParams) (r0 bool, err error) {
+	m.record("ListUpdateMemberWithParams", params)
+	if m.ListUpdateMemberWithParamsFunc != nil {
+		return m.ListUpdateMemberWithParamsFunc(ctx, params)
+	}
+	parameters, err := mailchimp.ToParameters(params)
+	if err != nil {
+		return
+	}
+	if m.ListUpdateMemberFunc == nil {
+		err = NotMockedError{"ListUpdateMemberWithParams"}
+		return
+	}
+	return m.ListUpdateMemberFunc(ctx, parameters)
+}
+
+func (m *Mock) ListWebhookAdd(parameters map[string]interface{}) (r0 bool, err error) {
+	m.record("ListWebhookAdd", parameters)
+	if m.ListWebhookAddFunc == nil {
+		err = NotMockedError{"ListWebhookAdd"}
+		return
+	}
+	return m.ListWebhookAddFunc(context.Background(), parameters)
+}
+
+func (m *Mock) ListWebhookAddContext(ctx context.Context, parameters map[string]interface{}) (r0 bool, err error) {
+	m.record("ListWebhookAddContext", parameters)
+	if m.ListWebhookAddFunc == nil {
+		err = NotMockedError{"ListWebhookAddContext"}
+		return
+	}
+	return m.ListWebhookAddFunc(ctx, parameters)
+}
+
+func (m *Mock) ListWebhookAddWithParams(ctx context.Context, params *mailchimp.ListWebhookAddParams) (r0 bool, err error) {
+	m.record("ListWebhookAddWithParams", params)
+	if m.ListWebhookAddWithParamsFunc != nil {
+		return m.ListWebhookAddWithParamsFunc(ctx, params)
+	}
+	parameters, err := mailchimp.ToParameters(params)
+	if err != nil {
+		return
+	}
+	if m.ListWebhookAddFunc == nil {
+		err = NotMockedError{"ListWebhookAddWithParams"}
+		return
+	}
+	return m.ListWebhookAddFunc(ctx, parameters)
+}
+
+func (m *Mock) ListWebhookDel(parameters map[string]interface{}) (r0 bool, err error) {
+	m.record("ListWebhookDel", parameters)
+	if m.ListWebhookDelFunc == nil {
+		err = NotMockedError{"ListWebhookDel"}
+		return
+	}
+	return m.ListWebhookDelFunc(context.Background(), parameters)
+}
+
+func (m *Mock) ListWebhookDelContext(ctx context.Context, parameters map[string]interface{}) (r0 bool, err error) {
+	m.record("ListWebhookDelContext", parameters)
+	if m.ListWebhookDelFunc == nil {
+		err = NotMockedError{"ListWebhookDelContext"}
+		return
+	}
+	return m.ListWebhookDelFunc(ctx, parameters)
+}
+
+func (m *Mock) ListWebhookDelWithParams(ctx context.Context, params *mailchimp.ListWebhookDelParams) (r0 bool, err error) {
+	m.record("ListWebhookDelWithParams", params)
+	if m.ListWebhookDelWithParamsFunc != nil {
+		return m.ListWebhookDelWithParamsFunc(ctx, params)
+	}
+	parameters, err := mailchimp.ToParameters(params)
+	if err != nil {
+		return
+	}
+	if m.ListWebhookDelFunc == nil {
+		err = NotMockedError{"ListWebhookDelWithParams"}
+		return
+	}
+	return m.ListWebhookDelFunc(ctx, parameters)
+}
+
+func (m *Mock) ListWebhooks(parameters map[string]interface{}) (r0 []mailchimp.Webhook, err error) {
+	m.record("ListWebhooks", parameters)
+	if m.ListWebhooksFunc == nil {
+		err = NotMockedError{"ListWebhooks"}
+		return
+	}
+	return m.ListWebhooksFunc(context.Background(), parameters)
+}
+
+func (m *Mock) ListWebhooksContext(ctx context.Context, parameters map[string]interface{}) (r0 []mailchimp.Webhook, err error) {
+	m.record("ListWebhooksContext", parameters)
+	if m.ListWebhooksFunc == nil {
+		err = NotMockedError{"ListWebhooksContext"}
+		return
+	}
+	return m.ListWebhooksFunc(ctx, parameters)
+}
+
+func (m *Mock) ListWebhooksWithParams(ctx context.Context, params *mailchimp.ListWebhooksParams) (r0 []mailchimp.Webhook, err error) {
+	m.record("ListWebhooksWithParams", params)
+	if m.ListWebhooksWithParamsFunc != nil {
+		return m.ListWebhooksWithParamsFunc(ctx, params)
+	}
+	parameters, err := mailchimp.ToParameters(params)
+	if err != nil {
+		return
+	}
+	if m.ListWebhooksFunc == nil {
+		err = NotMockedError{"ListWebhooksWithParams"}
+		return
+	}
+	return m.ListWebhooksFunc(ctx, parameters)
+}
+
+func (m *Mock) ListsForEmail(parameters map[string]interface{}) (r0 []string, err error) {
+	m.record("ListsForEmail", parameters)
+	if m.ListsForEmailFunc == nil {
+		err = NotMockedError{"ListsForEmail"}
+		return
+	}
+	return m.ListsForEmailFunc(context.Background(), parameters)
+}
+
+func (m *Mock) ListsForEmailContext(ctx context.Context, parameters map[string]interface{}) (r0 []string, err error) {
+	m.record("ListsForEmailContext", parameters)
+	if m.ListsForEmailFunc == nil {
+		err = NotMockedError{"ListsForEmailContext"}
+		return
+	}
+	return m.ListsForEmailFunc(ctx, parameters)
+}
+
+func (m *Mock) ListsForEmailWithParams(ctx context.Context, params *mailchimp.ListsForEmailParams) (r0 []string, err error) {
+	m.record("ListsForEmailWithParams", params)
+	if m.ListsForEmailWithParamsFunc != nil {
+		return m.ListsForEmailWithParamsFunc(ctx, params)
+	}
+	parameters, err := mailchimp.ToParameters(params)
+	if err != nil {
+		return
+	}
+	if m.ListsForEmailFunc == nil {
+		err = NotMockedError{"ListsForEmailWithParams"}
+		return
+	}
+	return m.ListsForEmailFunc(ctx, parameters)
+}
+
+func (m *Mock) Ping() (r0 string, err error) {
+	m.record("Ping")
+	if m.PingFunc == nil {
+		err = NotMockedError{"Ping"}
+		return
+	}
+	return m.PingFunc(context.Background())
+}
+
+func (m *Mock) PingContext(ctx context.Context) (r0 string, err error) {
+	m.record("PingContext")
+	if m.PingFunc == nil {
+		err = NotMockedError{"PingContext"}
+		return
+	}
+	return m.PingFunc(ctx)
+}
+
+func (m *Mock) SearchMembers(parameters map[string]interface{}) (r0 *mailchimp.SearchMembersResponse, err error) {
+	m.record("SearchMembers", parameters)
+	if m.SearchMembersFunc == nil {
+		err = NotMockedError{"SearchMembers"}
+		return
+	}
+	return m.SearchMembersFunc(context.Background(), parameters)
+}
+
+func (m *Mock) SearchMembersContext(ctx context.Context, parameters map[string]interface{}) (r0 *mailchimp.SearchMembersResponse, err error) {
+	m.record("SearchMembersContext", parameters)
+	if m.SearchMembersFunc == nil {
+		err = NotMockedError{"SearchMembersContext"}
+		return
+	}
+	return m.SearchMembersFunc(ctx, parameters)
+}
+
+func (m *Mock) SearchMembersPager(ctx context.Context, parameters map[string]interface{}) (r0 *mailchimp.Pager[mailchimp.MemberInfo]) {
+	m.record("SearchMembersPager", parameters)
+	if m.SearchMembersPagerFunc == nil {
+		return mailchimp.PagerOf[mailchimp.MemberInfo](nil, NotMockedError{"SearchMembersPager"})
+	}
+	return m.SearchMembersPagerFunc(ctx, parameters)
+}
+
+func (m *Mock) SearchMembersWithParams(ctx context.Context, params *mailchimp.SearchMembersParams) (r0 *mailchimp.SearchMembersResponse, err error) {
+	m.record("SearchMembersWithParams", params)
+	if m.SearchMembersWithParamsFunc != nil {
+		return m.SearchMembersWithParamsFunc(ctx, params)
+	}
+	parameters, err := mailchimp.ToParameters(params)
+	if err != nil {
+		return
+	}
+	if m.SearchMembersFunc == nil {
+		err = NotMockedError{"SearchMembersWithParams"}
+		return
+	}
+	return m.SearchMembersFunc(ctx, parameters)
+}
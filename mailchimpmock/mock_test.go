@@ -0,0 +1,86 @@
+package mailchimpmock
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/areed/mailchimp"
+)
+
+//archive stands in for code under test that depends on a service interface
+func archive(campaigns mailchimp.CampaignService, cid string) (string, error) {
+	content, err := campaigns.CampaignContentWithParams(context.Background(), &mailchimp.CampaignContentParams{CID: cid, ForArchive: true})
+	if err != nil {
+		return "", err
+	}
+	return content.HTML, nil
+}
+
+func TestMock(t *testing.T) {
+	mock := new(Mock)
+	mock.CampaignContentFunc = func(ctx context.Context, parameters map[string]interface{}) (*mailchimp.CampaignContentResult, error) {
+		return &mailchimp.CampaignContentResult{HTML: "<p>" + parameters["cid"].(string) + "</p>"}, nil
+	}
+	html, err := archive(mock, "a1b2c3")
+	if err != nil || html != "<p>a1b2c3</p>" {
+		t.Error("CampaignContentWithParams: expected the result of CampaignContentFunc but got", html, err)
+	}
+	if _, err := mock.CampaignContent(map[string]interface{}{"cid": "d4e5f6"}); err != nil {
+		t.Error("CampaignContent:", err)
+	}
+
+	calls := mock.Calls()
+	if len(calls) != 2 || calls[0].Method != "CampaignContentWithParams" || calls[1].Method != "CampaignContent" {
+		t.Fatal("Calls: expected the two calls in order but got", calls)
+	}
+	if params := calls[0].Args[0].(*mailchimp.CampaignContentParams); params.CID != "a1b2c3" {
+		t.Error("Calls: expected the params to be recorded but got", params)
+	}
+	if len(mock.CallsTo("CampaignContent")) != 1 {
+		t.Error("CallsTo: expected one call to CampaignContent but got", mock.CallsTo("CampaignContent"))
+	}
+	mock.Reset()
+	if len(mock.Calls()) != 0 {
+		t.Error("Reset: expected no calls but got", mock.Calls())
+	}
+}
+
+func TestMockValidatesParams(t *testing.T) {
+	mock := new(Mock)
+	mock.CampaignContentFunc = func(ctx context.Context, parameters map[string]interface{}) (*mailchimp.CampaignContentResult, error) {
+		t.Error("CampaignContentWithParams: expected invalid params not to reach CampaignContentFunc")
+		return nil, nil
+	}
+	var paramErr mailchimp.ParamError
+	if _, err := mock.CampaignContentWithParams(context.Background(), nil); !errors.As(err, &paramErr) {
+		t.Error("CampaignContentWithParams: expected a ParamError but got", err)
+	}
+}
+
+func TestMockNotMocked(t *testing.T) {
+	var client mailchimp.Client = new(Mock)
+	var notMocked NotMockedError
+	if _, err := client.Ping(); !errors.As(err, &notMocked) || notMocked.Method != "Ping" {
+		t.Error("Ping: expected a NotMockedError but got", err)
+	}
+	pager := client.CampaignsPager(context.Background(), nil)
+	if pager.Next() || !errors.As(pager.Err(), &notMocked) {
+		t.Error("CampaignsPager: expected a Pager failing with a NotMockedError but got", pager.Err())
+	}
+}
+
+func TestMockPager(t *testing.T) {
+	mock := new(Mock)
+	mock.CampaignMembersPagerFunc = func(ctx context.Context, parameters map[string]interface{}) *mailchimp.Pager[mailchimp.CampaignMembersResultDataItem] {
+		return mailchimp.PagerOf([]mailchimp.CampaignMembersResultDataItem{{Email: "a@example.com"}, {Email: "b@example.com"}}, nil)
+	}
+	pager := mock.CampaignMembersPager(context.Background(), map[string]interface{}{"cid": "a1b2c3"})
+	n := 0
+	for pager.Next() {
+		n++
+	}
+	if n != 2 || pager.Err() != nil {
+		t.Error("CampaignMembersPager: expected the canned members but got", n, pager.Err())
+	}
+}
@@ -0,0 +1,431 @@
+package mailchimptest
+
+import (
+	"sort"
+	"strings"
+	"time"
+)
+
+//Campaign is a campaign held by the server
+type Campaign struct {
+	ID          string                 `json:"id"`
+	WebID       int                    `json:"web_id"`
+	ListID      string                 `json:"list_id"`
+	FolderID    int                    `json:"folder_id"`
+	TemplateID  int                    `json:"template_id"`
+	ContentType string                 `json:"content_type"`
+	Title       string                 `json:"title"`
+	Type        string                 `json:"type"`
+	CreateTime  string                 `json:"create_time"`
+	SendTime    string                 `json:"send_time"`
+	EmailsSent  int                    `json:"emails_sent"`
+	Status      string                 `json:"status"`
+	FromName    string                 `json:"from_name"`
+	FromEmail   string                 `json:"from_email"`
+	Subject     string                 `json:"subject"`
+	ToName      string                 `json:"to_name"`
+	ArchiveURL  string                 `json:"archive_url"`
+	SegmentOpts map[string]interface{} `json:"segment_opts,omitempty"`
+	TypeOpts    map[string]interface{} `json:"type_opts,omitempty"`
+	HTML        string                 `json:"-"`
+	Text        string                 `json:"-"`
+	//Recipients are the email addresses the campaign was sent to
+	Recipients []string `json:"-"`
+}
+
+//Folder is a campaign or autoresponder folder held by the server
+type Folder struct {
+	FolderID    int    `json:"folder_id"`
+	Name        string `json:"name"`
+	DateCreated string `json:"date_created"`
+	Type        string `json:"type"`
+}
+
+//Campaign returns a copy of the campaign with the given id
+func (s *Server) Campaign(cid string) (Campaign, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	c, ok := s.campaigns[cid]
+	if !ok {
+		return Campaign{}, false
+	}
+	return *c, true
+}
+
+var campaignTypes = map[string]bool{"regular": true, "plaintext": true, "absplit": true, "rss": true, "auto": true}
+
+//campaign returns the campaign selected by the cid parameter
+func (s *Server) campaign(p Params) (*Campaign, error) {
+	if err := p.require("cid"); err != nil {
+		return nil, err
+	}
+	c := s.campaigns[p.String("cid")]
+	if c == nil {
+		return nil, Errorf(300, "Invalid Campaign ID: %v", p.String("cid"))
+	}
+	return c, nil
+}
+
+func (s *Server) campaignCreate(p Params) (interface{}, error) {
+	if err := p.require("type", "options"); err != nil {
+		return nil, err
+	}
+	if !campaignTypes[p.String("type")] {
+		return nil, Errorf(-32602, "Invalid campaign type: %v", p.String("type"))
+	}
+	options := p.Map("options")
+	if err := options.require("list_id", "subject", "from_email", "from_name"); err != nil {
+		return nil, err
+	}
+	if s.lists[options.String("list_id")] == nil {
+		return nil, Errorf(200, "Invalid MailChimp List ID: %v", options.String("list_id"))
+	}
+	folderID := options.Int("folder_id", 0)
+	if folderID != 0 && s.folders[folderID] == nil {
+		return nil, Errorf(507, "Invalid Folder ID: %v", folderID)
+	}
+	content := p.Map("content")
+	c := &Campaign{
+		ID:          s.id(),
+		WebID:       s.nextID,
+		ListID:      options.String("list_id"),
+		FolderID:    folderID,
+		TemplateID:  options.Int("template_id", 0),
+		ContentType: "html",
+		Title:       options.String("title"),
+		Type:        p.String("type"),
+		CreateTime:  s.now(),
+		Status:      "save",
+		FromName:    options.String("from_name"),
+		FromEmail:   options.String("from_email"),
+		Subject:     options.String("subject"),
+		ToName:      options.String("to_name"),
+		HTML:        content.String("html"),
+		Text:        content.String("text"),
+	}
+	if c.Title == "" {
+		c.Title = c.Subject
+	}
+	if c.Type == "plaintext" {
+		c.ContentType = "text"
+	}
+	if _, ok := p["segment_opts"]; ok {
+		c.SegmentOpts = p.Map("segment_opts")
+	}
+	if _, ok := p["type_opts"]; ok {
+		c.TypeOpts = p.Map("type_opts")
+	}
+	s.campaigns[c.ID] = c
+	return c.ID, nil
+}
+
+func (s *Server) campaignContent(p Params) (interface{}, error) {
+	c, err := s.campaign(p)
+	if err != nil {
+		return nil, err
+	}
+	return map[string]string{"html": c.HTML, "text": c.Text}, nil
+}
+
+func (s *Server) campaignUpdate(p Params) (interface{}, error) {
+	c, err := s.campaign(p)
+	if err != nil {
+		return nil, err
+	}
+	if err := p.require("name"); err != nil {
+		return nil, err
+	}
+	if c.Status == "sent" || c.Status == "sending" {
+		return nil, Errorf(313, "Campaign %v has been sent and can not be updated", c.ID)
+	}
+	strings := map[string]*string{
+		"title":      &c.Title,
+		"subject":    &c.Subject,
+		"from_email": &c.FromEmail,
+		"from_name":  &c.FromName,
+		"to_name":    &c.ToName,
+	}
+	name := p.String("name")
+	switch {
+	case strings[name] != nil:
+		*strings[name] = p.String("value")
+	case name == "list_id":
+		if s.lists[p.String("value")] == nil {
+			return nil, Errorf(200, "Invalid MailChimp List ID: %v", p.String("value"))
+		}
+		c.ListID = p.String("value")
+	case name == "folder_id":
+		folderID := p.Int("value", 0)
+		if folderID != 0 && s.folders[folderID] == nil {
+			return nil, Errorf(507, "Invalid Folder ID: %v", folderID)
+		}
+		c.FolderID = folderID
+	case name == "template_id":
+		c.TemplateID = p.Int("value", 0)
+	case name == "content":
+		content := p.Map("value")
+		c.HTML, c.Text = content.String("html"), content.String("text")
+	case name == "segment_opts":
+		c.SegmentOpts = p.Map("value")
+	case name == "type_opts":
+		c.TypeOpts = p.Map("value")
+	default:
+		return nil, Errorf(-32602, "Invalid campaign option: %v", name)
+	}
+	return true, nil
+}
+
+func (s *Server) campaignDelete(p Params) (interface{}, error) {
+	c, err := s.campaign(p)
+	if err != nil {
+		return nil, err
+	}
+	delete(s.campaigns, c.ID)
+	return true, nil
+}
+
+func (s *Server) campaignReplicate(p Params) (interface{}, error) {
+	c, err := s.campaign(p)
+	if err != nil {
+		return nil, err
+	}
+	replica := *c
+	replica.ID = s.id()
+	replica.WebID = s.nextID
+	replica.Title += " (copy)"
+	replica.CreateTime = s.now()
+	replica.SendTime = ""
+	replica.EmailsSent = 0
+	replica.Status = "save"
+	replica.Recipients = nil
+	s.campaigns[replica.ID] = &replica
+	return replica.ID, nil
+}
+
+func (s *Server) campaignSchedule(p Params) (interface{}, error) {
+	c, err := s.campaign(p)
+	if err != nil {
+		return nil, err
+	}
+	if err := p.require("schedule_time"); err != nil {
+		return nil, err
+	}
+	at, err := time.Parse(timeFormat, p.String("schedule_time"))
+	if err != nil || !at.After(s.Now()) {
+		return nil, Errorf(501, "Invalid schedule time, which must be in the future: %v", p.String("schedule_time"))
+	}
+	if c.Status != "save" {
+		return nil, Errorf(313, "Campaign %v can not be scheduled while its status is %v", c.ID, c.Status)
+	}
+	c.Status = "schedule"
+	c.SendTime = p.String("schedule_time")
+	return true, nil
+}
+
+func (s *Server) campaignUnschedule(p Params) (interface{}, error) {
+	c, err := s.campaign(p)
+	if err != nil {
+		return nil, err
+	}
+	if c.Status != "schedule" {
+		return nil, Errorf(313, "Campaign %v is not scheduled", c.ID)
+	}
+	c.Status = "save"
+	c.SendTime = ""
+	return true, nil
+}
+
+func (s *Server) campaignSendNow(p Params) (interface{}, error) {
+	c, err := s.campaign(p)
+	if err != nil {
+		return nil, err
+	}
+	if c.Status != "save" && c.Status != "schedule" {
+		return nil, Errorf(313, "Campaign %v can not be sent while its status is %v", c.ID, c.Status)
+	}
+	c.Status = "sent"
+	if c.Type == "rss" || c.Type == "auto" {
+		c.Status = "sending"
+	}
+	c.SendTime = s.now()
+	c.Recipients = []string{}
+	if l := s.lists[c.ListID]; l != nil {
+		for _, email := range l.order {
+			if l.members[email].Status == "subscribed" {
+				c.Recipients = append(c.Recipients, email)
+			}
+		}
+	}
+	c.EmailsSent = len(c.Recipients)
+	return true, nil
+}
+
+func (s *Server) campaignSendTest(p Params) (interface{}, error) {
+	if _, err := s.campaign(p); err != nil {
+		return nil, err
+	}
+	if len(p.Strings("test_emails")) == 0 {
+		return nil, Errorf(-32602, "Invalid parameters: test_emails is required")
+	}
+	return true, nil
+}
+
+func (s *Server) campaignPause(p Params) (interface{}, error) {
+	c, err := s.campaign(p)
+	if err != nil {
+		return nil, err
+	}
+	if c.Status != "sending" {
+		return nil, Errorf(313, "Only RSS and autoresponder campaigns that are sending can be paused")
+	}
+	c.Status = "paused"
+	return true, nil
+}
+
+func (s *Server) campaignResume(p Params) (interface{}, error) {
+	c, err := s.campaign(p)
+	if err != nil {
+		return nil, err
+	}
+	if c.Status != "paused" {
+		return nil, Errorf(313, "Campaign %v is not paused", c.ID)
+	}
+	c.Status = "sending"
+	return true, nil
+}
+
+func (s *Server) campaignsRoutine(p Params) (interface{}, error) {
+	filters := p.Map("filters")
+	matches := []*Campaign{}
+	for _, c := range s.campaigns {
+		if matchCampaign(c, filters) {
+			matches = append(matches, c)
+		}
+	}
+	//newest first, as Mailchimp lists them
+	sort.Slice(matches, func(i, j int) bool {
+		return matches[i].WebID > matches[j].WebID
+	})
+	return pageResult{len(matches), page(p, matches, 25, 1000)}, nil
+}
+
+//matchCampaign reports whether c passes the filters of the campaigns routine
+func matchCampaign(c *Campaign, filters Params) bool {
+	exact := filters.Bool("exact", true)
+	for name, value := range map[string]string{
+		"campaign_id": c.ID,
+		"list_id":     c.ListID,
+		"status":      c.Status,
+		"type":        c.Type,
+		"from_name":   c.FromName,
+		"from_email":  c.FromEmail,
+		"title":       c.Title,
+		"subject":     c.Subject,
+	} {
+		filter := filters.String(name)
+		if filter == "" {
+			continue
+		}
+		if exact && filter != value || !exact && !strings.Contains(value, filter) {
+			return false
+		}
+	}
+	if folderID := filters.Int("folder_id", 0); folderID != 0 && folderID != c.FolderID {
+		return false
+	}
+	return true
+}
+
+func (s *Server) campaignMembers(p Params) (interface{}, error) {
+	c, err := s.campaign(p)
+	if err != nil {
+		return nil, err
+	}
+	if c.Recipients == nil {
+		return nil, Errorf(301, "Campaign stats are not available until the campaign has been sent")
+	}
+	type member struct {
+		Email        string `json:"email"`
+		Status       string `json:"status"`
+		AbsplitGroup string `json:"absplit_group"`
+		TzGroup      string `json:"tz_group"`
+	}
+	members := []member{}
+	if status := p.String("status"); status == "" || status == "sent" {
+		for _, email := range c.Recipients {
+			members = append(members, member{Email: email, Status: "sent"})
+		}
+	}
+	return pageResult{len(members), page(p, members, 1000, 15000)}, nil
+}
+
+//folder returns the folder selected by the fid and type parameters
+func (s *Server) folder(p Params) (*Folder, error) {
+	if err := p.require("fid"); err != nil {
+		return nil, err
+	}
+	f := s.folders[p.Int("fid", 0)]
+	if f == nil || f.Type != folderType(p) {
+		return nil, Errorf(507, "Invalid Folder ID: %v", p.Int("fid", 0))
+	}
+	return f, nil
+}
+
+func folderType(p Params) string {
+	if t := p.String("type"); t != "" {
+		return t
+	}
+	return "campaign"
+}
+
+func (s *Server) folderAdd(p Params) (interface{}, error) {
+	if err := p.require("name"); err != nil {
+		return nil, err
+	}
+	if t := folderType(p); t != "campaign" && t != "autoresponder" {
+		return nil, Errorf(-32602, "Invalid folder type: %v", t)
+	}
+	s.nextID++
+	f := &Folder{s.nextID, p.String("name"), s.now(), folderType(p)}
+	s.folders[f.FolderID] = f
+	return f.FolderID, nil
+}
+
+func (s *Server) folderUpdate(p Params) (interface{}, error) {
+	f, err := s.folder(p)
+	if err != nil {
+		return nil, err
+	}
+	if err := p.require("name"); err != nil {
+		return nil, err
+	}
+	f.Name = p.String("name")
+	return true, nil
+}
+
+func (s *Server) folderDel(p Params) (interface{}, error) {
+	f, err := s.folder(p)
+	if err != nil {
+		return nil, err
+	}
+	delete(s.folders, f.FolderID)
+	for _, c := range s.campaigns {
+		if c.FolderID == f.FolderID {
+			c.FolderID = 0
+		}
+	}
+	return true, nil
+}
+
+func (s *Server) foldersRoutine(p Params) (interface{}, error) {
+	folders := []*Folder{}
+	for _, f := range s.folders {
+		if f.Type == folderType(p) {
+			folders = append(folders, f)
+		}
+	}
+	sort.Slice(folders, func(i, j int) bool {
+		return folders[i].FolderID < folders[j].FolderID
+	})
+	return folders, nil
+}
@@ -0,0 +1,156 @@
+package mailchimptest
+
+//Order is an ecommerce order held by the server
+type Order struct {
+	StoreID    string      `json:"store_id"`
+	StoreName  string      `json:"store_name"`
+	OrderID    string      `json:"order_id"`
+	CampaignID string      `json:"-"`
+	Email      string      `json:"email"`
+	OrderTotal float64     `json:"order_total"`
+	TaxTotal   float64     `json:"tax_total"`
+	ShipTotal  float64     `json:"ship_total"`
+	OrderDate  string      `json:"order_date"`
+	Lines      []OrderLine `json:"lines"`
+}
+
+//OrderLine is an item of an Order
+type OrderLine struct {
+	LineNum             int     `json:"line_num"`
+	ProductID           int     `json:"product_id"`
+	ProductName         string  `json:"product_name"`
+	ProductSKU          string  `json:"product_sku"`
+	ProductCategoryID   int     `json:"product_category_id"`
+	ProductCategoryName string  `json:"product_category_name"`
+	Qty                 float64 `json:"qty"`
+	Cost                float64 `json:"cost"`
+}
+
+//Orders returns copies of the orders held by the server in the order they
+//were added
+func (s *Server) Orders() []Order {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	orders := make([]Order, len(s.orders))
+	for i, o := range s.orders {
+		orders[i] = *o
+	}
+	return orders
+}
+
+//addOrder records the order parameter, which is attributed to a campaign if
+//campaign is set
+func (s *Server) addOrder(p Params, campaign bool) (interface{}, error) {
+	if err := p.require("order"); err != nil {
+		return nil, err
+	}
+	order := p.Map("order")
+	if err := order.require("id", "total", "store_id"); err != nil {
+		return nil, err
+	}
+	if order.String("email") == "" && order.String("email_id") == "" {
+		return nil, Errorf(-32602, "Invalid parameters: email or email_id is required")
+	}
+	if len(order.Maps("items")) == 0 {
+		return nil, Errorf(330, "Orders must have at least one item")
+	}
+	if campaign {
+		if err := order.require("campaign_id"); err != nil {
+			return nil, err
+		}
+		if s.campaigns[order.String("campaign_id")] == nil {
+			return nil, Errorf(300, "Invalid Campaign ID: %v", order.String("campaign_id"))
+		}
+	}
+	if s.order(order.String("store_id"), order.String("id")) >= 0 {
+		return nil, Errorf(330, "Order %v has already been recorded for store %v", order.String("id"), order.String("store_id"))
+	}
+	o := &Order{
+		StoreID:    order.String("store_id"),
+		StoreName:  order.String("store_name"),
+		OrderID:    order.String("id"),
+		CampaignID: order.String("campaign_id"),
+		Email:      order.String("email"),
+		OrderTotal: order.Float("total"),
+		TaxTotal:   order.Float("tax"),
+		ShipTotal:  order.Float("shipping"),
+		OrderDate:  order.String("order_date"),
+		Lines:      []OrderLine{},
+	}
+	if o.OrderDate == "" {
+		o.OrderDate = s.now()
+	}
+	for i, item := range order.Maps("items") {
+		if err := item.require("product_id", "product_name"); err != nil {
+			return nil, err
+		}
+		o.Lines = append(o.Lines, OrderLine{
+			LineNum:             item.Int("line_num", i+1),
+			ProductID:           item.Int("product_id", 0),
+			ProductName:         item.String("product_name"),
+			ProductSKU:          item.String("sku"),
+			ProductCategoryID:   item.Int("category_id", 0),
+			ProductCategoryName: item.String("category_name"),
+			Qty:                 item.Float("qty"),
+			Cost:                item.Float("cost"),
+		})
+	}
+	s.orders = append(s.orders, o)
+	return true, nil
+}
+
+//order returns the index of an order in s.orders, or -1
+func (s *Server) order(storeID, orderID string) int {
+	for i, o := range s.orders {
+		if o.StoreID == storeID && o.OrderID == orderID {
+			return i
+		}
+	}
+	return -1
+}
+
+func (s *Server) ecommOrderAdd(p Params) (interface{}, error) {
+	return s.addOrder(p, false)
+}
+
+func (s *Server) campaignEcommOrderAdd(p Params) (interface{}, error) {
+	return s.addOrder(p, true)
+}
+
+func (s *Server) ecommOrderDel(p Params) (interface{}, error) {
+	if err := p.require("store_id", "order_id"); err != nil {
+		return nil, err
+	}
+	i := s.order(p.String("store_id"), p.String("order_id"))
+	if i < 0 {
+		return nil, Errorf(330, "Order %v was not found in store %v", p.String("order_id"), p.String("store_id"))
+	}
+	s.orders = append(s.orders[:i], s.orders[i+1:]...)
+	return true, nil
+}
+
+//orders returns the orders made since the since parameter, if set, that
+//belong to the campaign cid, if not empty
+func (s *Server) ordersSince(p Params, cid string) []*Order {
+	orders := []*Order{}
+	for _, o := range s.orders {
+		if (cid == "" || o.CampaignID == cid) && o.OrderDate >= p.String("since") {
+			orders = append(orders, o)
+		}
+	}
+	return orders
+}
+
+func (s *Server) ecommOrders(p Params) (interface{}, error) {
+	orders := s.ordersSince(p, "")
+	return pageResult{len(orders), page(p, orders, 100, 500)}, nil
+}
+
+func (s *Server) campaignEcommOrders(p Params) (interface{}, error) {
+	c, err := s.campaign(p)
+	if err != nil {
+		return nil, err
+	}
+	orders := s.ordersSince(p, c.ID)
+	return pageResult{len(orders), page(p, orders, 100, 500)}, nil
+}
@@ -0,0 +1,427 @@
+package mailchimptest
+
+import (
+	"regexp"
+	"sort"
+	"strconv"
+)
+
+//Member is a member of a list held by the server
+type Member struct {
+	Email string
+	//EmailType is html or text
+	EmailType string
+	//Status is subscribed, unsubscribed, pending or cleaned
+	Status string
+	//Merges are the member's merge fields, keyed by tag, e.g. FNAME
+	Merges map[string]interface{}
+	//Groups are the names of the interest groups the member is in, keyed by
+	//grouping id
+	Groups    map[int][]string
+	Timestamp string
+}
+
+//list is a list held by the server
+type list struct {
+	id      string
+	name    string
+	members map[string]*Member
+	//order holds the email addresses of members in the order they were added
+	order     []string
+	groupings []*grouping
+	mergeVars []*mergeVar
+	segments  []*segment
+	webhooks  []*webhook
+}
+
+type grouping struct {
+	ID        int     `json:"id"`
+	Name      string  `json:"name"`
+	FormField string  `json:"form_field"`
+	Groups    []group `json:"groups"`
+}
+
+type group struct {
+	Bit          string `json:"bit"`
+	Name         string `json:"name"`
+	DisplayOrder string `json:"display_order"`
+	Subscribers  int    `json:"subscribers"`
+}
+
+//AddList adds an empty list with the EMAIL, FNAME and LNAME merge fields
+//Mailchimp gives new lists. Lists cannot be created through the API.
+func (s *Server) AddList(id, name string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.lists[id] = &list{id: id, name: name, members: make(map[string]*Member), mergeVars: defaultMergeVars()}
+}
+
+//AddMember adds m to the list with the given id, replacing any member with
+//the same email address. Missing fields of m are given defaults, e.g. a
+//Status of subscribed. It reports whether the list exists.
+func (s *Server) AddMember(listID string, m Member) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	l := s.lists[listID]
+	if l == nil {
+		return false
+	}
+	if m.EmailType == "" {
+		m.EmailType = "html"
+	}
+	if m.Status == "" {
+		m.Status = "subscribed"
+	}
+	if m.Merges == nil {
+		m.Merges = make(map[string]interface{})
+	}
+	if m.Groups == nil {
+		m.Groups = make(map[int][]string)
+	}
+	if m.Timestamp == "" {
+		m.Timestamp = s.now()
+	}
+	l.add(&m)
+	return true
+}
+
+//Member returns a copy of the member of a list with the given email address
+func (s *Server) Member(listID, email string) (Member, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if l := s.lists[listID]; l != nil && l.members[email] != nil {
+		return *l.members[email], true
+	}
+	return Member{}, false
+}
+
+//Members returns copies of the members of a list in the order they were added
+func (s *Server) Members(listID string) []Member {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	l := s.lists[listID]
+	if l == nil {
+		return nil
+	}
+	members := make([]Member, 0, len(l.order))
+	for _, email := range l.order {
+		members = append(members, *l.members[email])
+	}
+	return members
+}
+
+func (l *list) add(m *Member) {
+	if l.members[m.Email] == nil {
+		l.order = append(l.order, m.Email)
+	}
+	l.members[m.Email] = m
+}
+
+func (l *list) remove(email string) {
+	delete(l.members, email)
+	for i, e := range l.order {
+		if e == email {
+			l.order = append(l.order[:i], l.order[i+1:]...)
+			return
+		}
+	}
+}
+
+var emailAddress = regexp.MustCompile(`^[^@\s]+@[^@\s]+\.[^@\s]+$`)
+
+//list returns the list selected by the id parameter
+func (s *Server) list(p Params) (*list, error) {
+	if err := p.require("id"); err != nil {
+		return nil, err
+	}
+	l := s.lists[p.String("id")]
+	if l == nil {
+		return nil, Errorf(200, "Invalid MailChimp List ID: %v", p.String("id"))
+	}
+	return l, nil
+}
+
+//batchError is an error for one email address of a batch routine
+type batchError struct {
+	Email   string `json:"email"`
+	Code    int    `json:"code"`
+	Message string `json:"message"`
+}
+
+func (s *Server) listBatchSubscribe(p Params) (interface{}, error) {
+	l, err := s.list(p)
+	if err != nil {
+		return nil, err
+	}
+	result := struct {
+		AddCount    int          `json:"add_count"`
+		UpdateCount int          `json:"update_count"`
+		ErrorCount  int          `json:"error_count"`
+		Errors      []batchError `json:"errors"`
+	}{Errors: []batchError{}}
+	doubleOptin := p.Bool("double_optin", true)
+	updateExisting := p.Bool("update_existing", false)
+	for _, entry := range p.Maps("batch") {
+		email := entry.String("EMAIL")
+		existing := l.members[email]
+		switch {
+		case !emailAddress.MatchString(email):
+			result.Errors = append(result.Errors, batchError{email, 502, "Invalid Email Address: " + email})
+			continue
+		case existing != nil && existing.Status == "subscribed" && !updateExisting:
+			result.Errors = append(result.Errors, batchError{email, 214, email + " is already subscribed to list " + l.name})
+			continue
+		}
+		m := s.newMember(email)
+		if existing != nil {
+			m = existing
+			result.UpdateCount++
+		} else {
+			result.AddCount++
+			if doubleOptin {
+				m.Status = "pending"
+			}
+		}
+		for k, v := range entry {
+			switch k {
+			case "EMAIL":
+			case "EMAIL_TYPE":
+				m.EmailType, _ = v.(string)
+			default:
+				m.Merges[k] = v
+			}
+		}
+		if existing != nil && existing.Status != "subscribed" {
+			m.Status = "subscribed"
+		}
+		l.add(m)
+	}
+	result.ErrorCount = len(result.Errors)
+	return result, nil
+}
+
+func (s *Server) listBatchUnsubscribe(p Params) (interface{}, error) {
+	l, err := s.list(p)
+	if err != nil {
+		return nil, err
+	}
+	result := struct {
+		SuccessCount int          `json:"success_count"`
+		ErrorCount   int          `json:"error_count"`
+		Errors       []batchError `json:"errors"`
+	}{Errors: []batchError{}}
+	for _, email := range p.Strings("emails") {
+		m := l.members[email]
+		switch {
+		case m == nil:
+			result.Errors = append(result.Errors, batchError{email, 232, "There is no record of " + email + " in the database"})
+		case m.Status != "subscribed":
+			result.Errors = append(result.Errors, batchError{email, 215, email + " is not subscribed to list " + l.name})
+		case p.Bool("delete_member", false):
+			l.remove(email)
+			result.SuccessCount++
+		default:
+			m.Status = "unsubscribed"
+			result.SuccessCount++
+		}
+	}
+	result.ErrorCount = len(result.Errors)
+	return result, nil
+}
+
+func (s *Server) listsForEmail(p Params) (interface{}, error) {
+	if err := p.require("email_address"); err != nil {
+		return nil, err
+	}
+	ids := []string{}
+	for id, l := range s.lists {
+		if m := l.members[p.String("email_address")]; m != nil && m.Status == "subscribed" {
+			ids = append(ids, id)
+		}
+	}
+	if len(ids) == 0 {
+		return nil, Errorf(232, "There is no record of %v in the database", p.String("email_address"))
+	}
+	sort.Strings(ids)
+	return ids, nil
+}
+
+//grouping returns the grouping of l with the given id, or the first grouping
+//if id is zero
+func (l *list) grouping(id int) (*grouping, error) {
+	for _, g := range l.groupings {
+		if id == 0 || g.ID == id {
+			return g, nil
+		}
+	}
+	if id == 0 {
+		return nil, Errorf(211, "This list does not have interest groups enabled")
+	}
+	return nil, Errorf(270, "Invalid Interest Grouping ID: %v", id)
+}
+
+//groupingByID finds the grouping with the given id in any list
+func (s *Server) groupingByID(p Params) (*list, *grouping, error) {
+	if err := p.require("grouping_id"); err != nil {
+		return nil, nil, err
+	}
+	id := p.Int("grouping_id", 0)
+	for _, l := range s.lists {
+		for _, g := range l.groupings {
+			if g.ID == id {
+				return l, g, nil
+			}
+		}
+	}
+	return nil, nil, Errorf(270, "Invalid Interest Grouping ID: %v", id)
+}
+
+func (g *grouping) find(name string) int {
+	for i := range g.Groups {
+		if g.Groups[i].Name == name {
+			return i
+		}
+	}
+	return -1
+}
+
+func (g *grouping) add(name string) error {
+	if g.find(name) >= 0 {
+		return Errorf(270, "Interest group %v already exists", name)
+	}
+	n := len(g.Groups) + 1
+	g.Groups = append(g.Groups, group{Bit: strconv.Itoa(1 << uint(n-1)), Name: name, DisplayOrder: strconv.Itoa(n)})
+	return nil
+}
+
+var groupingTypes = map[string]bool{"checkboxes": true, "hidden": true, "dropdown": true, "radio": true}
+
+func (s *Server) listInterestGroupings(p Params) (interface{}, error) {
+	l, err := s.list(p)
+	if err != nil {
+		return nil, err
+	}
+	if len(l.groupings) == 0 {
+		return nil, Errorf(211, "This list does not have interest groups enabled")
+	}
+	return l.groupings, nil
+}
+
+func (s *Server) listInterestGroupingAdd(p Params) (interface{}, error) {
+	l, err := s.list(p)
+	if err != nil {
+		return nil, err
+	}
+	if err := p.require("name", "type"); err != nil {
+		return nil, err
+	}
+	if !groupingTypes[p.String("type")] {
+		return nil, Errorf(-32602, "Invalid interest grouping type: %v", p.String("type"))
+	}
+	s.nextID++
+	g := &grouping{ID: s.nextID, Name: p.String("name"), FormField: p.String("type"), Groups: []group{}}
+	for _, name := range p.Strings("groups") {
+		if err := g.add(name); err != nil {
+			return nil, err
+		}
+	}
+	l.groupings = append(l.groupings, g)
+	return g.ID, nil
+}
+
+func (s *Server) listInterestGroupingUpdate(p Params) (interface{}, error) {
+	_, g, err := s.groupingByID(p)
+	if err != nil {
+		return nil, err
+	}
+	if err := p.require("name", "value"); err != nil {
+		return nil, err
+	}
+	switch p.String("name") {
+	case "name":
+		g.Name = p.String("value")
+	case "type":
+		if !groupingTypes[p.String("value")] {
+			return nil, Errorf(-32602, "Invalid interest grouping type: %v", p.String("value"))
+		}
+		g.FormField = p.String("value")
+	default:
+		return nil, Errorf(-32602, "Invalid interest grouping field: %v", p.String("name"))
+	}
+	return true, nil
+}
+
+func (s *Server) listInterestGroupingDel(p Params) (interface{}, error) {
+	l, g, err := s.groupingByID(p)
+	if err != nil {
+		return nil, err
+	}
+	for i := range l.groupings {
+		if l.groupings[i] == g {
+			l.groupings = append(l.groupings[:i], l.groupings[i+1:]...)
+			break
+		}
+	}
+	return true, nil
+}
+
+func (s *Server) listInterestGroupAdd(p Params) (interface{}, error) {
+	l, err := s.list(p)
+	if err != nil {
+		return nil, err
+	}
+	if err := p.require("group_name"); err != nil {
+		return nil, err
+	}
+	g, err := l.grouping(p.Int("grouping_id", 0))
+	if err != nil {
+		return nil, err
+	}
+	if err := g.add(p.String("group_name")); err != nil {
+		return nil, err
+	}
+	return true, nil
+}
+
+func (s *Server) listInterestGroupUpdate(p Params) (interface{}, error) {
+	l, err := s.list(p)
+	if err != nil {
+		return nil, err
+	}
+	if err := p.require("old_name", "new_name"); err != nil {
+		return nil, err
+	}
+	g, err := l.grouping(p.Int("grouping_id", 0))
+	if err != nil {
+		return nil, err
+	}
+	i := g.find(p.String("old_name"))
+	if i < 0 {
+		return nil, Errorf(270, "Invalid interest group: %v", p.String("old_name"))
+	}
+	if g.find(p.String("new_name")) >= 0 {
+		return nil, Errorf(270, "Interest group %v already exists", p.String("new_name"))
+	}
+	g.Groups[i].Name = p.String("new_name")
+	return true, nil
+}
+
+func (s *Server) listInterestGroupDel(p Params) (interface{}, error) {
+	l, err := s.list(p)
+	if err != nil {
+		return nil, err
+	}
+	if err := p.require("group_name"); err != nil {
+		return nil, err
+	}
+	g, err := l.grouping(p.Int("grouping_id", 0))
+	if err != nil {
+		return nil, err
+	}
+	i := g.find(p.String("group_name"))
+	if i < 0 {
+		return nil, Errorf(270, "Invalid interest group: %v", p.String("group_name"))
+	}
+	g.Groups = append(g.Groups[:i], g.Groups[i+1:]...)
+	return true, nil
+}
@@ -0,0 +1,399 @@
+package mailchimptest
+
+import (
+	"crypto/md5"
+	"encoding/hex"
+	"sort"
+	"strings"
+)
+
+//newMember returns a subscribed html member with no merge fields
+func (s *Server) newMember(email string) *Member {
+	return &Member{
+		Email:     email,
+		EmailType: "html",
+		Status:    "subscribed",
+		Merges:    make(map[string]interface{}),
+		Groups:    make(map[int][]string),
+		Timestamp: s.now(),
+	}
+}
+
+//memberID returns a stable unique id for a member, which Mailchimp accepts
+//in place of their email address
+func memberID(email string) string {
+	sum := md5.Sum([]byte(strings.ToLower(email)))
+	return hex.EncodeToString(sum[:])[:10]
+}
+
+//member returns the member of l with the given email address or unique id
+func (l *list) member(email string) *Member {
+	if m := l.members[email]; m != nil {
+		return m
+	}
+	for _, m := range l.members {
+		if memberID(m.Email) == email {
+			return m
+		}
+	}
+	return nil
+}
+
+//merge applies the merge_vars parameter to m, adding to its interest groups
+//unless replace is set
+func (l *list) merge(m *Member, merges Params, replace bool) error {
+	for tag, v := range merges {
+		switch tag {
+		case "EMAIL", "NEW-EMAIL", "OPTIN_IP", "OPTIN_TIME", "MC_LANGUAGE":
+		case "GROUPINGS":
+			for _, selection := range merges.Maps("GROUPINGS") {
+				if err := l.mergeGroups(m, selection, replace); err != nil {
+					return err
+				}
+			}
+		default:
+			m.Merges[tag] = v
+		}
+	}
+	return nil
+}
+
+func (l *list) mergeGroups(m *Member, selection Params, replace bool) error {
+	var g *grouping
+	for _, candidate := range l.groupings {
+		if id := selection.Int("id", 0); id == candidate.ID || id == 0 && selection.String("name") == candidate.Name {
+			g = candidate
+		}
+	}
+	if g == nil {
+		return Errorf(270, "Invalid Interest Grouping: %v", selection)
+	}
+	groups := m.Groups[g.ID]
+	if replace {
+		groups = nil
+	}
+	for _, name := range splitGroups(selection.String("groups")) {
+		if g.find(name) < 0 {
+			return Errorf(270, "%v is not a valid Interest Group for the list", name)
+		}
+		if !contains(groups, name) {
+			groups = append(groups, name)
+		}
+	}
+	m.Groups[g.ID] = groups
+	return nil
+}
+
+//splitGroups splits the comma separated names of groups, in which commas are
+//escaped with a backslash
+func splitGroups(s string) []string {
+	var groups []string
+	var group strings.Builder
+	for i := 0; i < len(s); i++ {
+		switch {
+		case s[i] == '\\' && i+1 < len(s) && s[i+1] == ',':
+			group.WriteByte(',')
+			i++
+		case s[i] == ',':
+			groups = append(groups, strings.TrimSpace(group.String()))
+			group.Reset()
+		default:
+			group.WriteByte(s[i])
+		}
+	}
+	if last := strings.TrimSpace(group.String()); last != "" {
+		groups = append(groups, last)
+	}
+	return groups
+}
+
+func contains(values []string, s string) bool {
+	for _, v := range values {
+		if v == s {
+			return true
+		}
+	}
+	return false
+}
+
+func (s *Server) listSubscribe(p Params) (interface{}, error) {
+	l, err := s.list(p)
+	if err != nil {
+		return nil, err
+	}
+	if err := p.require("email_address"); err != nil {
+		return nil, err
+	}
+	email := p.String("email_address")
+	existing := l.members[email]
+	switch {
+	case !emailAddress.MatchString(email):
+		return nil, Errorf(502, "Invalid Email Address: %v", email)
+	case existing != nil && existing.Status == "subscribed" && !p.Bool("update_existing", false):
+		return nil, Errorf(214, "%v is already subscribed to list %v", email, l.name)
+	}
+	m := s.newMember(email)
+	if existing != nil {
+		m = existing
+		m.Status = "subscribed"
+	} else if p.Bool("double_optin", true) {
+		m.Status = "pending"
+	}
+	if t := p.String("email_type"); t != "" {
+		m.EmailType = t
+	}
+	if err := l.merge(m, p.Map("merge_vars"), p.Bool("replace_interests", true)); err != nil {
+		return nil, err
+	}
+	l.add(m)
+	return true, nil
+}
+
+func (s *Server) listUnsubscribe(p Params) (interface{}, error) {
+	l, err := s.list(p)
+	if err != nil {
+		return nil, err
+	}
+	if err := p.require("email_address"); err != nil {
+		return nil, err
+	}
+	m := l.member(p.String("email_address"))
+	switch {
+	case m == nil:
+		return nil, Errorf(232, "There is no record of %v in the database", p.String("email_address"))
+	case m.Status != "subscribed":
+		return nil, Errorf(215, "%v is not subscribed to list %v", m.Email, l.name)
+	case p.Bool("delete_member", false):
+		l.remove(m.Email)
+	default:
+		m.Status = "unsubscribed"
+		m.Timestamp = s.now()
+	}
+	return true, nil
+}
+
+func (s *Server) listUpdateMember(p Params) (interface{}, error) {
+	l, err := s.list(p)
+	if err != nil {
+		return nil, err
+	}
+	if err := p.require("email_address", "merge_vars"); err != nil {
+		return nil, err
+	}
+	m := l.member(p.String("email_address"))
+	if m == nil {
+		return nil, Errorf(232, "There is no record of %v in the database", p.String("email_address"))
+	}
+	merges := p.Map("merge_vars")
+	if email := merges.String("NEW-EMAIL"); email != "" && email != m.Email {
+		if !emailAddress.MatchString(email) {
+			return nil, Errorf(502, "Invalid Email Address: %v", email)
+		}
+		if l.members[email] != nil {
+			return nil, Errorf(214, "%v is already subscribed to list %v", email, l.name)
+		}
+	}
+	//merge into a copy so that a bad interest group changes nothing
+	updated := *m
+	updated.Merges = make(map[string]interface{}, len(m.Merges))
+	for tag, v := range m.Merges {
+		updated.Merges[tag] = v
+	}
+	updated.Groups = make(map[int][]string, len(m.Groups))
+	for id, groups := range m.Groups {
+		updated.Groups[id] = groups
+	}
+	if err := l.merge(&updated, merges, p.Bool("replace_interests", true)); err != nil {
+		return nil, err
+	}
+	if t := p.String("email_type"); t != "" {
+		updated.EmailType = t
+	}
+	if email := merges.String("NEW-EMAIL"); email != "" && email != m.Email {
+		l.remove(m.Email)
+		updated.Email = email
+	}
+	updated.Timestamp = s.now()
+	*m = updated
+	l.add(m)
+	return true, nil
+}
+
+func (s *Server) listMemberInfo(p Params) (interface{}, error) {
+	l, err := s.list(p)
+	if err != nil {
+		return nil, err
+	}
+	if err := p.require("email_address"); err != nil {
+		return nil, err
+	}
+	result := struct {
+		Success int                      `json:"success"`
+		Errors  int                      `json:"errors"`
+		Data    []map[string]interface{} `json:"data"`
+	}{Data: []map[string]interface{}{}}
+	for _, email := range p.Strings("email_address") {
+		m := l.member(email)
+		if m == nil {
+			result.Errors++
+			result.Data = append(result.Data, map[string]interface{}{
+				"email_address": email,
+				"error":         "The email address \"" + email + "\" does not belong to this list",
+			})
+			continue
+		}
+		result.Success++
+		result.Data = append(result.Data, l.info(m))
+	}
+	return result, nil
+}
+
+//info returns the listMemberInfo data of m. Mailchimp sends the empty
+//objects of members it knows little about as empty arrays.
+func (l *list) info(m *Member) map[string]interface{} {
+	merges := map[string]interface{}{"EMAIL": m.Email}
+	for tag, v := range m.Merges {
+		merges[tag] = v
+	}
+	groupings := []map[string]interface{}{}
+	for _, g := range l.groupings {
+		groups := make([]string, len(m.Groups[g.ID]))
+		for i, name := range m.Groups[g.ID] {
+			groups[i] = strings.ReplaceAll(name, ",", `\,`)
+		}
+		groupings = append(groupings, map[string]interface{}{"id": g.ID, "name": g.Name, "groups": strings.Join(groups, ", ")})
+	}
+	if len(groupings) > 0 {
+		merges["GROUPINGS"] = groupings
+	}
+	return map[string]interface{}{
+		"id":               memberID(m.Email),
+		"email":            m.Email,
+		"email_type":       m.EmailType,
+		"merges":           merges,
+		"status":           m.Status,
+		"ip_signup":        "",
+		"timestamp_signup": "",
+		"ip_opt":           "",
+		"timestamp_opt":    m.Timestamp,
+		"member_rating":    2,
+		"campaign_id":      "",
+		"lists":            []interface{}{},
+		"timestamp":        m.Timestamp,
+		"info_changed":     m.Timestamp,
+		"web_id":           0,
+		"list_id":          l.id,
+		"list_name":        l.name,
+		"language":         "",
+		"is_gmonkey":       false,
+		"geo":              []interface{}{},
+		"clients":          []interface{}{},
+		"static_segments":  l.memberSegments(m),
+		"notes":            []interface{}{},
+	}
+}
+
+//listMemberActivity answers with no activity, since the server does not
+//send campaigns to members
+func (s *Server) listMemberActivity(p Params) (interface{}, error) {
+	l, err := s.list(p)
+	if err != nil {
+		return nil, err
+	}
+	if err := p.require("email_address"); err != nil {
+		return nil, err
+	}
+	result := struct {
+		Success int             `json:"success"`
+		Errors  int             `json:"errors"`
+		Data    [][]interface{} `json:"data"`
+	}{Data: [][]interface{}{}}
+	for _, email := range p.Strings("email_address") {
+		if l.member(email) == nil {
+			result.Errors++
+			continue
+		}
+		result.Success++
+		result.Data = append(result.Data, []interface{}{})
+	}
+	return result, nil
+}
+
+//listMembers pages through the members with the given status in the order
+//they were added
+func (s *Server) listMembers(p Params) (interface{}, error) {
+	l, err := s.list(p)
+	if err != nil {
+		return nil, err
+	}
+	status := p.String("status")
+	if status == "" {
+		status = "subscribed"
+	}
+	//times in Mailchimp's format sort in time order
+	since := p.String("since")
+	members := []map[string]interface{}{}
+	for _, email := range l.order {
+		m := l.members[email]
+		if m.Status != status || m.Timestamp < since {
+			continue
+		}
+		member := map[string]interface{}{"email": m.Email, "timestamp": m.Timestamp}
+		if status == "unsubscribed" || status == "cleaned" {
+			member["reason"] = "NORMAL"
+			member["reason_text"] = ""
+		}
+		members = append(members, member)
+	}
+	return pageResult{len(members), page(p, members, 100, 15000)}, nil
+}
+
+//searchMembers finds members whose email address or name contains the query,
+//of which those whose address is the query are exact matches
+func (s *Server) searchMembers(p Params) (interface{}, error) {
+	if err := p.require("query"); err != nil {
+		return nil, err
+	}
+	lists := make([]*list, 0, len(s.lists))
+	if p.String("id") != "" {
+		l, err := s.list(p)
+		if err != nil {
+			return nil, err
+		}
+		lists = append(lists, l)
+	} else {
+		for _, l := range s.lists {
+			lists = append(lists, l)
+		}
+		sort.Slice(lists, func(i, j int) bool { return lists[i].id < lists[j].id })
+	}
+	query := strings.ToLower(p.String("query"))
+	exact, full := []map[string]interface{}{}, []map[string]interface{}{}
+	for _, l := range lists {
+		for _, email := range l.order {
+			m := l.members[email]
+			name := strings.ToLower(mergeString(m, "FNAME") + " " + mergeString(m, "LNAME"))
+			if !strings.Contains(strings.ToLower(m.Email), query) && !strings.Contains(name, query) {
+				continue
+			}
+			if strings.ToLower(m.Email) == query {
+				exact = append(exact, l.info(m))
+			}
+			full = append(full, l.info(m))
+		}
+	}
+	type matches struct {
+		Total   int                      `json:"total"`
+		Members []map[string]interface{} `json:"members"`
+	}
+	offset := Params{"start": p["offset"]}
+	return map[string]matches{
+		"exact_matches": {len(exact), page(offset, exact, 100, 100)},
+		"full_search":   {len(full), page(offset, full, 100, 100)},
+	}, nil
+}
+
+func mergeString(m *Member, tag string) string {
+	s, _ := m.Merges[tag].(string)
+	return s
+}
@@ -0,0 +1,218 @@
+package mailchimptest
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+//mergeVar is the definition of a merge field, as listMergeVars returns it
+type mergeVar struct {
+	Name      string   `json:"name"`
+	Req       bool     `json:"req"`
+	FieldType string   `json:"field_type"`
+	Public    bool     `json:"public"`
+	Show      bool     `json:"show"`
+	Order     string   `json:"order"`
+	Default   string   `json:"default"`
+	HelpText  string   `json:"helptext"`
+	Size      string   `json:"size"`
+	Tag       string   `json:"tag"`
+	Choices   []string `json:"choices,omitempty"`
+	ID        int      `json:"id"`
+}
+
+//maxMergeVars is the most merge fields a list may have
+const maxMergeVars = 30
+
+func defaultMergeVars() []*mergeVar {
+	return []*mergeVar{
+		{Name: "Email Address", Req: true, FieldType: "email", Public: true, Show: true, Order: "1", Size: "25", Tag: "EMAIL", ID: 0},
+		{Name: "First Name", FieldType: "text", Public: true, Show: true, Order: "2", Size: "25", Tag: "FNAME", ID: 1},
+		{Name: "Last Name", FieldType: "text", Public: true, Show: true, Order: "3", Size: "25", Tag: "LNAME", ID: 2},
+	}
+}
+
+var (
+	mergeTag        = regexp.MustCompile(`^[A-Z][A-Z0-9_]{0,9}$`)
+	mergeFieldTypes = map[string]bool{
+		"text": true, "number": true, "radio": true, "dropdown": true, "date": true, "birthday": true,
+		"address": true, "zip": true, "phone": true, "url": true, "imageurl": true,
+	}
+)
+
+//mergeVar returns the merge field of l selected by the tag parameter and its
+//index
+func (l *list) mergeVar(p Params) (*mergeVar, int, error) {
+	if err := p.require("tag"); err != nil {
+		return nil, 0, err
+	}
+	tag := strings.ToUpper(p.String("tag"))
+	for i, v := range l.mergeVars {
+		if v.Tag == tag {
+			return v, i, nil
+		}
+	}
+	return nil, 0, Errorf(252, "There is no merge field with the tag \"%v\"", tag)
+}
+
+//setOptions applies the options parameter of listMergeVarAdd and
+//listMergeVarUpdate to v
+func (v *mergeVar) setOptions(options Params) error {
+	if t := options.String("field_type"); t != "" {
+		if !mergeFieldTypes[t] {
+			return Errorf(254, "Invalid merge field type: %v", t)
+		}
+		v.FieldType = t
+	}
+	if name := options.String("name"); name != "" {
+		v.Name = name
+	}
+	v.Req = options.Bool("req", v.Req)
+	v.Public = options.Bool("public", v.Public)
+	v.Show = options.Bool("show", v.Show)
+	if order := options.Int("order", 0); order > 0 {
+		v.Order = strconv.Itoa(order)
+	}
+	if _, ok := options["default_value"]; ok {
+		v.Default = options.String("default_value")
+	}
+	if _, ok := options["helptext"]; ok {
+		v.HelpText = options.String("helptext")
+	}
+	if _, ok := options["choices"]; ok {
+		v.Choices = options.Strings("choices")
+	}
+	if (v.FieldType == "radio" || v.FieldType == "dropdown") && len(v.Choices) == 0 {
+		return Errorf(254, "A %v merge field needs choices", v.FieldType)
+	}
+	return nil
+}
+
+func (s *Server) listMergeVars(p Params) (interface{}, error) {
+	l, err := s.list(p)
+	if err != nil {
+		return nil, err
+	}
+	return l.mergeVars, nil
+}
+
+func (s *Server) listMergeVarAdd(p Params) (interface{}, error) {
+	l, err := s.list(p)
+	if err != nil {
+		return nil, err
+	}
+	if err := p.require("tag", "name"); err != nil {
+		return nil, err
+	}
+	tag := strings.ToUpper(p.String("tag"))
+	if !mergeTag.MatchString(tag) {
+		return nil, Errorf(254, "Invalid merge tag: %v", tag)
+	}
+	if _, _, err := l.mergeVar(p); err == nil {
+		return nil, Errorf(254, "A merge field with the tag \"%v\" already exists", tag)
+	}
+	if len(l.mergeVars) >= maxMergeVars {
+		return nil, Errorf(253, "This list already has the most merge fields allowed")
+	}
+	last := l.mergeVars[len(l.mergeVars)-1]
+	v := &mergeVar{
+		Name:      p.String("name"),
+		FieldType: "text",
+		Public:    true,
+		Show:      true,
+		Order:     strconv.Itoa(len(l.mergeVars) + 1),
+		Size:      "25",
+		Tag:       tag,
+		ID:        last.ID + 1,
+	}
+	if err := v.setOptions(p.Map("options")); err != nil {
+		return nil, err
+	}
+	l.mergeVars = append(l.mergeVars, v)
+	return true, nil
+}
+
+func (s *Server) listMergeVarUpdate(p Params) (interface{}, error) {
+	l, err := s.list(p)
+	if err != nil {
+		return nil, err
+	}
+	v, _, err := l.mergeVar(p)
+	if err != nil {
+		return nil, err
+	}
+	if err := p.require("options"); err != nil {
+		return nil, err
+	}
+	if v.Tag == "EMAIL" && p.Map("options").String("field_type") != "" {
+		return nil, Errorf(251, "The type of the EMAIL merge field cannot be changed")
+	}
+	//update a copy so that bad options change nothing
+	updated := *v
+	if err := updated.setOptions(p.Map("options")); err != nil {
+		return nil, err
+	}
+	*v = updated
+	return true, nil
+}
+
+func (s *Server) listMergeVarDel(p Params) (interface{}, error) {
+	l, err := s.list(p)
+	if err != nil {
+		return nil, err
+	}
+	v, i, err := l.mergeVar(p)
+	if err != nil {
+		return nil, err
+	}
+	if v.Tag == "EMAIL" {
+		return nil, Errorf(251, "The EMAIL merge field cannot be deleted")
+	}
+	l.mergeVars = append(l.mergeVars[:i], l.mergeVars[i+1:]...)
+	for _, m := range l.members {
+		delete(m.Merges, v.Tag)
+	}
+	return true, nil
+}
+
+func (s *Server) listMergeVarReset(p Params) (interface{}, error) {
+	l, err := s.list(p)
+	if err != nil {
+		return nil, err
+	}
+	v, _, err := l.mergeVar(p)
+	if err != nil {
+		return nil, err
+	}
+	if v.Tag == "EMAIL" {
+		return nil, Errorf(251, "The EMAIL merge field cannot be reset")
+	}
+	for _, m := range l.members {
+		delete(m.Merges, v.Tag)
+	}
+	return true, nil
+}
+
+func (s *Server) listMergeVarSetValue(p Params) (interface{}, error) {
+	l, err := s.list(p)
+	if err != nil {
+		return nil, err
+	}
+	v, _, err := l.mergeVar(p)
+	if err != nil {
+		return nil, err
+	}
+	if v.Tag == "EMAIL" {
+		return nil, Errorf(251, "The EMAIL merge field cannot be set for every member")
+	}
+	value := p.String("value")
+	for _, m := range l.members {
+		if value == "" {
+			delete(m.Merges, v.Tag)
+		} else {
+			m.Merges[v.Tag] = value
+		}
+	}
+	return true, nil
+}
@@ -0,0 +1,134 @@
+package mailchimptest
+
+import (
+	"encoding/json"
+	"strconv"
+)
+
+//Params are the parameters of a request, decoded from JSON
+type Params map[string]interface{}
+
+//String returns the named parameter if it is a string
+func (p Params) String(name string) string {
+	s, _ := p[name].(string)
+	return s
+}
+
+//Int returns the named parameter as an int, accepting numbers and numeric
+//strings, or def if it is not set
+func (p Params) Int(name string, def int) int {
+	switch v := p[name].(type) {
+	case float64:
+		return int(v)
+	case string:
+		if i, err := strconv.Atoi(v); err == nil {
+			return i
+		}
+	}
+	return def
+}
+
+//Float returns the named parameter as a float64, accepting numbers and
+//numeric strings, or zero if it is not set
+func (p Params) Float(name string) float64 {
+	switch v := p[name].(type) {
+	case float64:
+		return v
+	case string:
+		f, _ := strconv.ParseFloat(v, 64)
+		return f
+	}
+	return 0
+}
+
+//Bool returns the named parameter as a bool, or def if it is not set
+func (p Params) Bool(name string, def bool) bool {
+	switch v := p[name].(type) {
+	case bool:
+		return v
+	case float64:
+		return v != 0
+	case string:
+		if b, err := strconv.ParseBool(v); err == nil {
+			return b
+		}
+	}
+	return def
+}
+
+//Map returns the named parameter if it is an object, or an empty Params
+func (p Params) Map(name string) Params {
+	m, _ := p[name].(map[string]interface{})
+	if m == nil {
+		return Params{}
+	}
+	return Params(m)
+}
+
+//Strings returns the named parameter if it is an array of strings
+func (p Params) Strings(name string) []string {
+	values, _ := p[name].([]interface{})
+	strings := make([]string, 0, len(values))
+	for _, v := range values {
+		if s, ok := v.(string); ok {
+			strings = append(strings, s)
+		}
+	}
+	return strings
+}
+
+//Maps returns the named parameter if it is an array of objects
+func (p Params) Maps(name string) []Params {
+	values, _ := p[name].([]interface{})
+	maps := make([]Params, 0, len(values))
+	for _, v := range values {
+		if m, ok := v.(map[string]interface{}); ok {
+			maps = append(maps, Params(m))
+		}
+	}
+	return maps
+}
+
+//require returns the Mailchimp error for a missing parameter unless every
+//one of names is set
+func (p Params) require(names ...string) error {
+	for _, name := range names {
+		if v, ok := p[name]; !ok || v == nil || v == "" {
+			return Errorf(-32602, "Invalid parameters: %v is required", name)
+		}
+	}
+	return nil
+}
+
+//page returns the items from the page of a paged routine selected by the
+//start and limit parameters
+func page[T any](p Params, items []T, defaultLimit, maxLimit int) []T {
+	start := p.Int("start", 0)
+	limit := p.Int("limit", defaultLimit)
+	if limit > maxLimit {
+		limit = maxLimit
+	}
+	if start < 0 || limit <= 0 || start*limit >= len(items) {
+		return []T{}
+	}
+	end := (start + 1) * limit
+	if end > len(items) {
+		end = len(items)
+	}
+	return items[start*limit : end]
+}
+
+//pageResult is the shape of the results of paged routines
+type pageResult struct {
+	Total int         `json:"total"`
+	Data  interface{} `json:"data"`
+}
+
+//decodeInto converts v, a parameter decoded from JSON, into out
+func decodeInto(v interface{}, out interface{}) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(b, out)
+}
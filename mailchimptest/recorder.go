@@ -0,0 +1,279 @@
+package mailchimptest
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
+)
+
+//Mode is whether a Recorder records or replays
+type Mode int
+
+const (
+	//Replay answers requests from the cassette without using the network
+	Replay Mode = iota
+	//Record sends requests to Mailchimp and saves them with their responses
+	//to the cassette when the Recorder is closed
+	Record
+)
+
+//Interaction is a request and its response as saved in a cassette. The API
+//key and email addresses are scrubbed from both.
+type Interaction struct {
+	Method string          `json:"method"`
+	Params json.RawMessage `json:"params"`
+	Status int             `json:"status"`
+	//Body is the response if it is JSON, otherwise it is in Text
+	Body json.RawMessage `json:"body,omitempty"`
+	Text string          `json:"text,omitempty"`
+}
+
+//Recorder is an http.RoundTripper that records requests to the Mailchimp 1.3
+//API and their responses in a cassette file, and replays them later, so that
+//tests written against a real account can run offline:
+//
+//	mode := mailchimptest.Replay
+//	if os.Getenv("MAILCHIMPRECORD") != "" {
+//		mode = mailchimptest.Record
+//	}
+//	recorder, err := mailchimptest.NewRecorder("json/cassettes/campaigns.json", mode)
+//	defer recorder.Close()
+//	chimp, err := mailchimp.NewClient(key, mailchimp.WithTransport(recorder))
+//
+//Requests are matched to interactions by routine and parameters, ignoring the
+//API key; a request made more than once is answered with its recorded
+//responses in order. Email addresses are replaced with addresses at
+//example.com derived from them, so a replayed test must send the same
+//addresses it recorded. Addresses at example.com, example.net and example.org
+//are kept. A Recorder is safe for concurrent use.
+type Recorder struct {
+	//Transport sends requests while recording; http.DefaultTransport if nil
+	Transport http.RoundTripper
+	//Replace maps values specific to the account recorded with, such as the
+	//ids of its lists, to the placeholders saved in their place. Requests are
+	//scrubbed the same way when replaying, so a test may send either, e.g.
+	//a list id from the environment if it is set and the placeholder if not.
+	//Longer values are replaced first, so a value containing another is
+	//replaced whole. It must be set before the Recorder is used.
+	Replace map[string]string
+
+	path string
+	mode Mode
+
+	mu           sync.Mutex
+	interactions []Interaction
+	//used counts the replayed interactions of each request
+	used map[string]int
+}
+
+//NewRecorder returns a Recorder for the cassette at path. In Replay mode the
+//cassette is read immediately and must exist.
+func NewRecorder(path string, mode Mode) (*Recorder, error) {
+	r := &Recorder{path: path, mode: mode, used: make(map[string]int)}
+	if mode == Record {
+		return r, nil
+	}
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(b, &r.interactions); err != nil {
+		return nil, fmt.Errorf("mailchimptest: reading cassette %v: %v", path, err)
+	}
+	//the cassette is indented for reading, but is matched and replayed compacted
+	for i := range r.interactions {
+		r.interactions[i].Params = compact(r.interactions[i].Params)
+		if r.interactions[i].Body != nil {
+			r.interactions[i].Body = compact(r.interactions[i].Body)
+		}
+	}
+	return r, nil
+}
+
+//Interactions returns the interactions recorded or loaded so far
+func (r *Recorder) Interactions() []Interaction {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return append([]Interaction(nil), r.interactions...)
+}
+
+//Close saves the cassette if recording, creating its directory if needed
+func (r *Recorder) Close() error {
+	if r.mode != Record {
+		return nil
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	interactions := r.interactions
+	if interactions == nil {
+		interactions = []Interaction{}
+	}
+	b, err := json.MarshalIndent(interactions, "", "\t")
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(r.path), 0755); err != nil {
+		return err
+	}
+	return os.WriteFile(r.path, append(b, '\n'), 0644)
+}
+
+//RoundTrip records or replays req
+func (r *Recorder) RoundTrip(req *http.Request) (*http.Response, error) {
+	var body []byte
+	if req.Body != nil {
+		var err error
+		body, err = io.ReadAll(req.Body)
+		req.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+	}
+	method := req.URL.Query().Get("method")
+	params, key, err := r.scrubParams(body)
+	if err != nil {
+		return nil, fmt.Errorf("mailchimptest: %v: %v", method, err)
+	}
+	if r.mode == Record {
+		return r.record(req, body, method, params, key)
+	}
+	return r.replay(req, method, params)
+}
+
+func (r *Recorder) record(req *http.Request, body []byte, method string, params json.RawMessage, key string) (*http.Response, error) {
+	transport := r.Transport
+	if transport == nil {
+		transport = http.DefaultTransport
+	}
+	req = req.Clone(req.Context())
+	req.Body = io.NopCloser(bytes.NewReader(body))
+	req.ContentLength = int64(len(body))
+	resp, err := transport.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+	b, err := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	interaction := Interaction{Method: method, Params: params, Status: resp.StatusCode}
+	if scrubbed := r.scrub(b, key); json.Valid(scrubbed) {
+		interaction.Body = compact(scrubbed)
+	} else {
+		interaction.Text = string(scrubbed)
+	}
+	r.mu.Lock()
+	r.interactions = append(r.interactions, interaction)
+	r.mu.Unlock()
+	resp.Body = io.NopCloser(bytes.NewReader(b))
+	resp.ContentLength = int64(len(b))
+	return resp, nil
+}
+
+func (r *Recorder) replay(req *http.Request, method string, params json.RawMessage) (*http.Response, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	match := method + " " + string(params)
+	skip := r.used[match]
+	for _, interaction := range r.interactions {
+		if interaction.Method != method || !bytes.Equal(interaction.Params, params) {
+			continue
+		}
+		if skip > 0 {
+			skip--
+			continue
+		}
+		r.used[match]++
+		body := []byte(interaction.Text)
+		if interaction.Body != nil {
+			body = interaction.Body
+		}
+		return &http.Response{
+			Status:        fmt.Sprintf("%d %s", interaction.Status, http.StatusText(interaction.Status)),
+			StatusCode:    interaction.Status,
+			Proto:         "HTTP/1.1",
+			ProtoMajor:    1,
+			ProtoMinor:    1,
+			Header:        http.Header{"Content-Type": {"application/json"}},
+			Body:          io.NopCloser(bytes.NewReader(body)),
+			ContentLength: int64(len(body)),
+			Request:       req,
+		}, nil
+	}
+	return nil, fmt.Errorf("mailchimptest: no recorded response for %v %s in %v", method, params, r.path)
+}
+
+//scrubParams returns the parameters in body without the API key, scrubbed
+//and with object keys sorted, along with the key
+func (r *Recorder) scrubParams(body []byte) (json.RawMessage, string, error) {
+	var params map[string]interface{}
+	dec := json.NewDecoder(bytes.NewReader(body))
+	dec.UseNumber()
+	if err := dec.Decode(&params); err != nil {
+		return nil, "", err
+	}
+	key, _ := params["apikey"].(string)
+	delete(params, "apikey")
+	b, err := json.Marshal(params)
+	if err != nil {
+		return nil, "", err
+	}
+	return r.scrub(b, key), key, nil
+}
+
+var (
+	anyEmail = regexp.MustCompile(`[^\s"'<>@,;:\\/]+@[^\s"'<>@,;:\\/]+\.[A-Za-z]+`)
+	anyKey   = regexp.MustCompile(`\b[0-9a-f]{32}-[a-z]+[0-9]+\b`)
+)
+
+//scrub replaces key, anything else shaped like an API key, the values of
+//Replace and email addresses outside the example domains in b
+func (r *Recorder) scrub(b []byte, key string) []byte {
+	if key != "" {
+		b = bytes.ReplaceAll(b, []byte(key), []byte(Key))
+	}
+	values := make([]string, 0, len(r.Replace))
+	for value := range r.Replace {
+		if value != "" {
+			values = append(values, value)
+		}
+	}
+	sort.Slice(values, func(i, j int) bool {
+		if len(values[i]) != len(values[j]) {
+			return len(values[i]) > len(values[j])
+		}
+		return values[i] < values[j]
+	})
+	for _, value := range values {
+		b = bytes.ReplaceAll(b, []byte(value), []byte(r.Replace[value]))
+	}
+	b = anyKey.ReplaceAll(b, []byte(Key))
+	return anyEmail.ReplaceAllFunc(b, func(email []byte) []byte {
+		lower := strings.ToLower(string(email))
+		for _, domain := range []string{"@example.com", "@example.net", "@example.org"} {
+			if strings.HasSuffix(lower, domain) {
+				return email
+			}
+		}
+		sum := sha256.Sum256([]byte(lower))
+		return []byte(fmt.Sprintf("user-%x@example.com", sum[:5]))
+	})
+}
+
+func compact(b []byte) json.RawMessage {
+	var buf bytes.Buffer
+	if err := json.Compact(&buf, b); err != nil {
+		return b
+	}
+	return buf.Bytes()
+}
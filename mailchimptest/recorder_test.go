@@ -0,0 +1,165 @@
+package mailchimptest
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+const liveKey = "fedcba9876543210fedcba9876543210-us7"
+
+//send posts a request through client and returns the response body
+func send(t *testing.T, client *http.Client, url, method string, params map[string]interface{}) string {
+	b, err := json.Marshal(params)
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp, err := client.Post(url+"?method="+method, "application/json", strings.NewReader(string(b)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer resp.Body.Close()
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return string(body)
+}
+
+func TestRecorder(t *testing.T) {
+	server := NewServer()
+	defer server.Close()
+	server.AddList("l1", "List")
+	server.Handle("listMemberInfo", func(p Params) (interface{}, error) {
+		return map[string]interface{}{"email": p.Strings("email_address")[0], "key": liveKey}, nil
+	})
+	path := filepath.Join(t.TempDir(), "cassettes", "test.json")
+
+	recorder, err := NewRecorder(path, Record)
+	if err != nil {
+		t.Fatal(err)
+	}
+	client := &http.Client{Transport: recorder}
+	requests := []struct {
+		method string
+		params map[string]interface{}
+	}{
+		{"ping", map[string]interface{}{"apikey": Key}},
+		{"ping", map[string]interface{}{"apikey": "abc-us1"}},
+		{"listMemberInfo", map[string]interface{}{"apikey": Key, "id": "l1", "email_address": []string{"Jane.Doe@gmail.com"}}},
+		{"listBatchSubscribe", map[string]interface{}{"apikey": Key, "id": "l1", "batch": []map[string]string{{"EMAIL": "shop@example.com"}}}},
+		{"listBatchSubscribe", map[string]interface{}{"apikey": Key, "id": "l1", "batch": []map[string]string{{"EMAIL": "shop@example.com"}}}},
+	}
+	var recorded []string
+	for _, r := range requests {
+		recorded = append(recorded, send(t, client, server.BaseURL(), r.method, r.params))
+	}
+	if err := recorder.Close(); err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(recorded[2], "Jane.Doe@gmail.com") || !strings.Contains(recorded[2], liveKey) {
+		t.Error("Record: expected the caller to get the unscrubbed response but got", recorded[2])
+	}
+	server.Close()
+
+	recorder, err = NewRecorder(path, Replay)
+	if err != nil {
+		t.Fatal(err)
+	}
+	interactions := recorder.Interactions()
+	if len(interactions) != len(requests) {
+		t.Fatalf("NewRecorder: expected %v interactions but got %v", len(requests), len(interactions))
+	}
+	for _, i := range interactions {
+		if strings.Contains(string(i.Params), "apikey") {
+			t.Error("Record: expected the apikey parameter to be removed but got", string(i.Params))
+		}
+	}
+	if got := string(interactions[2].Params); got != `{"email_address":["user-831f6494ad@example.com"],"id":"l1"}` {
+		t.Error("Record: expected the email address to be scrubbed but got", got)
+	}
+	if got := string(interactions[2].Body); strings.Contains(got, "gmail") || strings.Contains(got, liveKey) || !strings.Contains(got, Key) {
+		t.Error("Record: expected the response to be scrubbed but got", got)
+	}
+	if got := string(interactions[1].Body); strings.Contains(got, "abc-us1") {
+		t.Error("Record: expected the rejected key to be scrubbed but got", got)
+	}
+	if got := string(interactions[3].Params); !strings.Contains(got, "shop@example.com") {
+		t.Error("Record: expected example.com addresses to be kept but got", got)
+	}
+
+	client = &http.Client{Transport: recorder}
+	for i, r := range requests {
+		if got := send(t, client, "https://us7.api.mailchimp.com/1.3/", r.method, r.params); got != string(interactions[i].Body) {
+			t.Errorf("Replay %v: expected %s but got %v", r.method, interactions[i].Body, got)
+		}
+	}
+	if recorded[3] == recorded[4] {
+		t.Error("Record: expected the second subscription to update the member but got", recorded[4])
+	}
+
+	_, err = client.Post("https://us7.api.mailchimp.com/1.3/?method=ping", "application/json", strings.NewReader(`{"apikey":"`+Key+`"}`))
+	if err == nil || !strings.Contains(err.Error(), "no recorded response for ping {}") {
+		t.Error("Replay: expected an error once the recorded responses are used up but got", err)
+	}
+}
+
+func TestRecorderReplace(t *testing.T) {
+	server := NewServer()
+	defer server.Close()
+	server.AddList("4bc2e8a7d1", "Live list")
+	path := filepath.Join(t.TempDir(), "test.json")
+	replace := map[string]string{"4bc2e8a7d1": "f6b4ea2a1c"}
+
+	recorder, err := NewRecorder(path, Record)
+	if err != nil {
+		t.Fatal(err)
+	}
+	recorder.Replace = replace
+	recorded := send(t, &http.Client{Transport: recorder}, server.BaseURL(), "listMergeVars", map[string]interface{}{"apikey": Key, "id": "4bc2e8a7d1"})
+	if err := recorder.Close(); err != nil {
+		t.Fatal(err)
+	}
+	server.Close()
+	if got := string(recorder.Interactions()[0].Params); got != `{"id":"f6b4ea2a1c"}` {
+		t.Error("Record: expected the list id to be replaced but got", got)
+	}
+
+	//a replayed test sends the live id if it knows it and the placeholder if not
+	for _, id := range []string{"4bc2e8a7d1", "f6b4ea2a1c"} {
+		recorder, err := NewRecorder(path, Replay)
+		if err != nil {
+			t.Fatal(err)
+		}
+		recorder.Replace = replace
+		if id == "f6b4ea2a1c" {
+			recorder.Replace = nil
+		}
+		if got := send(t, &http.Client{Transport: recorder}, server.BaseURL(), "listMergeVars", map[string]interface{}{"apikey": Key, "id": id}); got != recorded {
+			t.Errorf("Replay %v: expected %v but got %v", id, recorded, got)
+		}
+	}
+}
+
+func TestRecorderReplaceOverlapping(t *testing.T) {
+	recorder := &Recorder{Replace: map[string]string{
+		"4bc2":       "short",
+		"4bc2e8a7d1": "f6b4ea2a1c",
+		"e8a7":       "middle",
+	}}
+	//map order is random, so a single scrub might pass by luck
+	for i := 0; i < 50; i++ {
+		if got := string(recorder.scrub([]byte(`{"id":"4bc2e8a7d1","other":"4bc2"}`), "")); got != `{"id":"f6b4ea2a1c","other":"short"}` {
+			t.Fatal("scrub: expected the longest value to be replaced first but got", got)
+		}
+	}
+}
+
+func TestNewRecorderMissingCassette(t *testing.T) {
+	if _, err := NewRecorder(filepath.Join(t.TempDir(), "missing.json"), Replay); err == nil {
+		t.Error("NewRecorder: expected an error for a missing cassette")
+	}
+}
@@ -0,0 +1,153 @@
+package mailchimptest
+
+//segment is a static segment of a list
+type segment struct {
+	ID          int    `json:"id"`
+	Name        string `json:"name"`
+	MemberCount int    `json:"member_count"`
+	CreatedDate string `json:"created_date"`
+	LastUpdate  string `json:"last_update"`
+	LastReset   string `json:"last_reset"`
+	//members holds the email addresses of the segment's members
+	members map[string]bool
+}
+
+//segment returns the static segment of l selected by the seg_id parameter
+//and its index
+func (l *list) segment(p Params) (*segment, int, error) {
+	if err := p.require("seg_id"); err != nil {
+		return nil, 0, err
+	}
+	id := p.Int("seg_id", 0)
+	for i, seg := range l.segments {
+		if seg.ID == id {
+			return seg, i, nil
+		}
+	}
+	return nil, 0, Errorf(-32602, "Invalid static segment id: %v", id)
+}
+
+//memberSegments returns the listMemberInfo static_segments of m
+func (l *list) memberSegments(m *Member) []map[string]interface{} {
+	segments := []map[string]interface{}{}
+	for _, seg := range l.segments {
+		if seg.members[m.Email] {
+			segments = append(segments, map[string]interface{}{"id": seg.ID, "name": seg.Name, "added": seg.LastUpdate})
+		}
+	}
+	return segments
+}
+
+func (s *Server) listStaticSegments(p Params) (interface{}, error) {
+	l, err := s.list(p)
+	if err != nil {
+		return nil, err
+	}
+	segments := make([]segment, len(l.segments))
+	for i, seg := range l.segments {
+		segments[i] = *seg
+		segments[i].MemberCount = len(seg.members)
+	}
+	return segments, nil
+}
+
+func (s *Server) listStaticSegmentAdd(p Params) (interface{}, error) {
+	l, err := s.list(p)
+	if err != nil {
+		return nil, err
+	}
+	if err := p.require("name"); err != nil {
+		return nil, err
+	}
+	for _, seg := range l.segments {
+		if seg.Name == p.String("name") {
+			return nil, Errorf(-32602, "A static segment named %v already exists", seg.Name)
+		}
+	}
+	s.nextID++
+	seg := &segment{ID: s.nextID, Name: p.String("name"), CreatedDate: s.now(), members: make(map[string]bool)}
+	l.segments = append(l.segments, seg)
+	return seg.ID, nil
+}
+
+func (s *Server) listStaticSegmentDel(p Params) (interface{}, error) {
+	l, err := s.list(p)
+	if err != nil {
+		return nil, err
+	}
+	_, i, err := l.segment(p)
+	if err != nil {
+		return nil, err
+	}
+	l.segments = append(l.segments[:i], l.segments[i+1:]...)
+	return true, nil
+}
+
+func (s *Server) listStaticSegmentReset(p Params) (interface{}, error) {
+	l, err := s.list(p)
+	if err != nil {
+		return nil, err
+	}
+	seg, _, err := l.segment(p)
+	if err != nil {
+		return nil, err
+	}
+	seg.members = make(map[string]bool)
+	seg.LastReset = s.now()
+	return true, nil
+}
+
+//segmentError is an error for one member of a static segment batch
+type segmentError struct {
+	Email   string `json:"email"`
+	Code    int    `json:"code"`
+	Message string `json:"msg"`
+}
+
+//segmentMembers adds or removes the members in the batch parameter
+func (s *Server) segmentMembers(p Params, add bool) (interface{}, error) {
+	l, err := s.list(p)
+	if err != nil {
+		return nil, err
+	}
+	seg, _, err := l.segment(p)
+	if err != nil {
+		return nil, err
+	}
+	if err := p.require("batch"); err != nil {
+		return nil, err
+	}
+	result := struct {
+		Success int            `json:"success"`
+		Errors  []segmentError `json:"errors"`
+	}{Errors: []segmentError{}}
+	for _, email := range p.Strings("batch") {
+		m := l.member(email)
+		switch {
+		case m == nil:
+			result.Errors = append(result.Errors, segmentError{email, 232, "There is no record of " + email + " in the database"})
+		case add && m.Status != "subscribed":
+			result.Errors = append(result.Errors, segmentError{email, 215, email + " is not subscribed to list " + l.name})
+		case !add && !seg.members[m.Email]:
+			result.Errors = append(result.Errors, segmentError{email, 215, email + " is not in the static segment " + seg.Name})
+		case add:
+			seg.members[m.Email] = true
+			result.Success++
+		default:
+			delete(seg.members, m.Email)
+			result.Success++
+		}
+	}
+	if result.Success > 0 {
+		seg.LastUpdate = s.now()
+	}
+	return result, nil
+}
+
+func (s *Server) listStaticSegmentMembersAdd(p Params) (interface{}, error) {
+	return s.segmentMembers(p, true)
+}
+
+func (s *Server) listStaticSegmentMembersDel(p Params) (interface{}, error) {
+	return s.segmentMembers(p, false)
+}
@@ -0,0 +1,234 @@
+//Package mailchimptest provides a fake Mailchimp 1.3 API server for tests
+//that should not need a Mailchimp account or network access.
+//
+//The server speaks the same protocol as Mailchimp: routines are requested
+//with POST <base>?method=<routine> and a JSON object of parameters including
+//the API key, and answer with JSON or a {"error": ..., "code": ...} object.
+//Campaigns, folders, lists with their members and interest groupings, and
+//ecommerce orders are kept in memory, so that e.g. a campaign created with
+//campaignCreate is listed by campaigns. Other routines can be stubbed with
+//Handle.
+//
+//	server := mailchimptest.NewServer()
+//	defer server.Close()
+//	server.AddList("f6b4ea2a1c", "Customers")
+//	chimp, err := mailchimp.NewClient(mailchimptest.Key, mailchimp.WithBaseURL(server.BaseURL()))
+package mailchimptest
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"time"
+)
+
+//Key is the only API key the server accepts
+const Key = "0123456789abcdef0123456789abcdef-us1"
+
+//Error is a Mailchimp error, which the server sends as a JSON object with
+//error and code fields. Handlers return Errors to fail a request.
+type Error struct {
+	Code    int    `json:"code"`
+	Message string `json:"error"`
+}
+
+func (e *Error) Error() string {
+	return fmt.Sprintf("%v: %v", e.Code, e.Message)
+}
+
+//Errorf returns an Error with the given code and formatted message
+func Errorf(code int, format string, args ...interface{}) *Error {
+	return &Error{code, fmt.Sprintf(format, args...)}
+}
+
+//HandlerFunc answers a routine. Its result is sent encoded as JSON, and an
+//error that is not an *Error is sent as a server error with code -99.
+type HandlerFunc func(params Params) (interface{}, error)
+
+//Call is a request the server received
+type Call struct {
+	Method string
+	Params Params
+}
+
+//Server is a fake Mailchimp 1.3 API. Its methods are safe for concurrent
+//use, including while it serves requests.
+type Server struct {
+	*httptest.Server
+	//Now is the clock used for the times the server records
+	Now func() time.Time
+
+	mu       sync.Mutex
+	handlers map[string]HandlerFunc
+	calls    []Call
+	nextID   int
+
+	campaigns map[string]*Campaign
+	folders   map[int]*Folder
+	lists     map[string]*list
+	orders    []*Order
+}
+
+//NewServer starts a Server, which should be closed when it is no longer needed
+func NewServer() *Server {
+	s := &Server{
+		Now:       time.Now,
+		handlers:  make(map[string]HandlerFunc),
+		campaigns: make(map[string]*Campaign),
+		folders:   make(map[int]*Folder),
+		lists:     make(map[string]*list),
+	}
+	s.Server = httptest.NewServer(http.HandlerFunc(s.serve))
+	return s
+}
+
+//BaseURL returns the URL to pass to mailchimp.WithBaseURL
+func (s *Server) BaseURL() string {
+	return s.URL + "/1.3/"
+}
+
+//Handle answers method with h instead of the server's own handler, if it has
+//one. h is called without holding the server's lock.
+func (s *Server) Handle(method string, h HandlerFunc) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.handlers[method] = h
+}
+
+//Calls returns the requests the server has received, in order
+func (s *Server) Calls() []Call {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]Call(nil), s.calls...)
+}
+
+//routines are the routines the server implements itself. They are called
+//with the server's lock held.
+var routines = map[string]func(s *Server, p Params) (interface{}, error){
+	"campaignContent":             (*Server).campaignContent,
+	"campaignCreate":              (*Server).campaignCreate,
+	"campaignDelete":              (*Server).campaignDelete,
+	"campaignEcommOrderAdd":       (*Server).campaignEcommOrderAdd,
+	"campaignEcommOrders":         (*Server).campaignEcommOrders,
+	"campaignMembers":             (*Server).campaignMembers,
+	"campaignPause":               (*Server).campaignPause,
+	"campaignReplicate":           (*Server).campaignReplicate,
+	"campaignResume":              (*Server).campaignResume,
+	"campaignSchedule":            (*Server).campaignSchedule,
+	"campaignSendNow":             (*Server).campaignSendNow,
+	"campaignSendTest":            (*Server).campaignSendTest,
+	"campaignUnschedule":          (*Server).campaignUnschedule,
+	"campaignUpdate":              (*Server).campaignUpdate,
+	"campaigns":                   (*Server).campaignsRoutine,
+	"ecommOrderAdd":               (*Server).ecommOrderAdd,
+	"ecommOrderDelete":            (*Server).ecommOrderDel,
+	"ecommOrders":                 (*Server).ecommOrders,
+	"folderAdd":                   (*Server).folderAdd,
+	"folderDel":                   (*Server).folderDel,
+	"folderUpdate":                (*Server).folderUpdate,
+	"folders":                     (*Server).foldersRoutine,
+	"listBatchSubscribe":          (*Server).listBatchSubscribe,
+	"listBatchUnsubscribe":        (*Server).listBatchUnsubscribe,
+	"listInterestGroupAdd":        (*Server).listInterestGroupAdd,
+	"listInterestGroupDel":        (*Server).listInterestGroupDel,
+	"listInterestGroupUpdate":     (*Server).listInterestGroupUpdate,
+	"listInterestGroupingAdd":     (*Server).listInterestGroupingAdd,
+	"listInterestGroupingDel":     (*Server).listInterestGroupingDel,
+	"listInterestGroupingUpdate":  (*Server).listInterestGroupingUpdate,
+	"listInterestGroupings":       (*Server).listInterestGroupings,
+	"listMemberActivity":          (*Server).listMemberActivity,
+	"listMemberInfo":              (*Server).listMemberInfo,
+	"listMembers":                 (*Server).listMembers,
+	"listMergeVarAdd":             (*Server).listMergeVarAdd,
+	"listMergeVarDel":             (*Server).listMergeVarDel,
+	"listMergeVarReset":           (*Server).listMergeVarReset,
+	"listMergeVarSetValue":        (*Server).listMergeVarSetValue,
+	"listMergeVarUpdate":          (*Server).listMergeVarUpdate,
+	"listMergeVars":               (*Server).listMergeVars,
+	"listStaticSegmentAdd":        (*Server).listStaticSegmentAdd,
+	"listStaticSegmentDel":        (*Server).listStaticSegmentDel,
+	"listStaticSegmentMembersAdd": (*Server).listStaticSegmentMembersAdd,
+	"listStaticSegmentMembersDel": (*Server).listStaticSegmentMembersDel,
+	"listStaticSegmentReset":      (*Server).listStaticSegmentReset,
+	"listStaticSegments":          (*Server).listStaticSegments,
+	"listSubscribe":               (*Server).listSubscribe,
+	"listUnsubscribe":             (*Server).listUnsubscribe,
+	"listUpdateMember":            (*Server).listUpdateMember,
+	"listWebhookAdd":              (*Server).listWebhookAdd,
+	"listWebhookDel":              (*Server).listWebhookDel,
+	"listWebhooks":                (*Server).listWebhooks,
+	"listsForEmail":               (*Server).listsForEmail,
+	"searchMembers":               (*Server).searchMembers,
+	"ping": func(s *Server, p Params) (interface{}, error) {
+		return "Everything's Chimpy!", nil
+	},
+}
+
+func (s *Server) serve(w http.ResponseWriter, r *http.Request) {
+	if r.Method != "POST" {
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+	method := r.URL.Query().Get("method")
+	var params Params
+	if err := json.NewDecoder(r.Body).Decode(&params); err != nil {
+		reply(w, nil, Errorf(-32602, "Invalid JSON: %v", err))
+		return
+	}
+	result, err := s.call(method, params)
+	reply(w, result, err)
+}
+
+func (s *Server) call(method string, params Params) (interface{}, error) {
+	s.mu.Lock()
+	s.calls = append(s.calls, Call{method, params})
+	handler := s.handlers[method]
+	routine, ok := routines[method]
+	if handler != nil {
+		s.mu.Unlock()
+	} else {
+		defer s.mu.Unlock()
+	}
+	switch {
+	case params.String("apikey") != Key:
+		return nil, Errorf(104, "Invalid Mailchimp API Key: %v", params.String("apikey"))
+	case handler != nil:
+		return handler(params)
+	case !ok:
+		return nil, Errorf(-32601, "Method %v is not exported by this server", method)
+	}
+	return routine(s, params)
+}
+
+func reply(w http.ResponseWriter, result interface{}, err error) {
+	w.Header().Set("Content-Type", "application/json")
+	if err != nil {
+		chimpErr, ok := err.(*Error)
+		if !ok {
+			chimpErr = &Error{-99, err.Error()}
+		}
+		result = chimpErr
+	}
+	//Mailchimp sends bare scalars like true without a trailing newline, which
+	//json.Encoder would add
+	b, err := json.Marshal(result)
+	if err != nil {
+		b, _ = json.Marshal(&Error{-99, err.Error()})
+	}
+	w.Write(b)
+}
+
+//id returns a new identifier, formatted like Mailchimp's ten character ids
+func (s *Server) id() string {
+	s.nextID++
+	return fmt.Sprintf("%010x", s.nextID)
+}
+
+//now returns the current time in GMT, formatted as Mailchimp formats times
+func (s *Server) now() string {
+	return s.Now().UTC().Format(timeFormat)
+}
+
+const timeFormat = "2006-01-02 15:04:05"
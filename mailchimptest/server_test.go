@@ -0,0 +1,113 @@
+package mailchimptest
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+)
+
+//post requests method from s and returns the raw response body
+func post(t *testing.T, s *Server, method string, params map[string]interface{}) string {
+	b, err := json.Marshal(params)
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp, err := http.Post(s.BaseURL()+"?method="+method, "application/json", strings.NewReader(string(b)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer resp.Body.Close()
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return string(body)
+}
+
+func TestServerErrors(t *testing.T) {
+	s := NewServer()
+	defer s.Close()
+	tests := []struct {
+		method string
+		params map[string]interface{}
+		want   string
+	}{
+		{"ping", map[string]interface{}{"apikey": Key}, `"Everything's Chimpy!"`},
+		{"ping", map[string]interface{}{"apikey": "abc-us1"}, `{"code":104,"error":"Invalid Mailchimp API Key: abc-us1"}`},
+		{"campaignStats", map[string]interface{}{"apikey": Key}, `{"code":-32601,"error":"Method campaignStats is not exported by this server"}`},
+		{"campaignContent", map[string]interface{}{"apikey": Key}, `{"code":-32602,"error":"Invalid parameters: cid is required"}`},
+		{"campaignContent", map[string]interface{}{"apikey": Key, "cid": "nope"}, `{"code":300,"error":"Invalid Campaign ID: nope"}`},
+	}
+	for _, test := range tests {
+		if got := post(t, s, test.method, test.params); got != test.want {
+			t.Errorf("%v: expected %v but got %v", test.method, test.want, got)
+		}
+	}
+}
+
+func TestServerHandle(t *testing.T) {
+	s := NewServer()
+	defer s.Close()
+	s.Handle("campaignStats", func(p Params) (interface{}, error) {
+		if p.String("cid") == "" {
+			return nil, Errorf(300, "Invalid Campaign ID")
+		}
+		return map[string]int{"opens": 3}, nil
+	})
+	s.Handle("ping", func(p Params) (interface{}, error) {
+		return nil, errors.New("down for maintenance")
+	})
+	if got := post(t, s, "campaignStats", map[string]interface{}{"apikey": Key, "cid": "a"}); got != `{"opens":3}` {
+		t.Error("campaignStats: expected the handler's result but got", got)
+	}
+	if got := post(t, s, "campaignStats", map[string]interface{}{"apikey": Key}); got != `{"code":300,"error":"Invalid Campaign ID"}` {
+		t.Error("campaignStats: expected the handler's error but got", got)
+	}
+	if got := post(t, s, "ping", map[string]interface{}{"apikey": Key}); got != `{"code":-99,"error":"down for maintenance"}` {
+		t.Error("ping: expected a server error but got", got)
+	}
+	if got := post(t, s, "campaignStats", map[string]interface{}{"apikey": "abc-us1"}); !strings.Contains(got, `"code":104`) {
+		t.Error("campaignStats: expected handlers to require the key but got", got)
+	}
+
+	calls := s.Calls()
+	if len(calls) != 4 || calls[0].Method != "campaignStats" || calls[0].Params.String("cid") != "a" {
+		t.Error("Calls: expected the four requests in order but got", calls)
+	}
+}
+
+func TestServerCampaignsPaging(t *testing.T) {
+	s := NewServer()
+	defer s.Close()
+	s.Now = func() time.Time { return time.Date(2012, 3, 4, 5, 6, 7, 0, time.UTC) }
+	s.AddList("l1", "List")
+	for i := 0; i < 3; i++ {
+		got := post(t, s, "campaignCreate", map[string]interface{}{
+			"apikey":  Key,
+			"type":    "regular",
+			"options": map[string]interface{}{"list_id": "l1", "subject": "s", "from_email": "a@example.com", "from_name": "A"},
+			"content": map[string]interface{}{"html": "<p>hi</p>"},
+		})
+		if !strings.HasPrefix(got, `"`) {
+			t.Fatal("campaignCreate: expected a campaign id but got", got)
+		}
+	}
+	var result struct {
+		Total int `json:"total"`
+		Data  []struct {
+			ID         string `json:"id"`
+			CreateTime string `json:"create_time"`
+		} `json:"data"`
+	}
+	got := post(t, s, "campaigns", map[string]interface{}{"apikey": Key, "start": 1, "limit": 2})
+	if err := json.Unmarshal([]byte(got), &result); err != nil {
+		t.Fatal(err)
+	}
+	if result.Total != 3 || len(result.Data) != 1 || result.Data[0].CreateTime != "2012-03-04 05:06:07" {
+		t.Error("campaigns: expected the last of three campaigns on the second page but got", got)
+	}
+}
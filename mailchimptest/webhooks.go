@@ -0,0 +1,82 @@
+package mailchimptest
+
+import "net/url"
+
+//webhook is a webhook of a list
+type webhook struct {
+	URL     string          `json:"url"`
+	Actions map[string]bool `json:"actions"`
+	Sources map[string]bool `json:"sources"`
+}
+
+//webhookActions and webhookSources map the actions and sources of a webhook to
+//whether listWebhookAdd enables them when they are not given
+var (
+	webhookActions = map[string]bool{"subscribe": true, "unsubscribe": true, "profile": true, "cleaned": true, "upemail": true, "campaign": true}
+	webhookSources = map[string]bool{"user": true, "admin": true, "api": false}
+)
+
+//webhook returns the index of the webhook of l with the given url, or -1
+func (l *list) webhook(u string) int {
+	for i, hook := range l.webhooks {
+		if hook.URL == u {
+			return i
+		}
+	}
+	return -1
+}
+
+func (s *Server) listWebhooks(p Params) (interface{}, error) {
+	l, err := s.list(p)
+	if err != nil {
+		return nil, err
+	}
+	hooks := make([]webhook, len(l.webhooks))
+	for i, hook := range l.webhooks {
+		hooks[i] = *hook
+	}
+	return hooks, nil
+}
+
+func (s *Server) listWebhookAdd(p Params) (interface{}, error) {
+	l, err := s.list(p)
+	if err != nil {
+		return nil, err
+	}
+	if err := p.require("url"); err != nil {
+		return nil, err
+	}
+	u := p.String("url")
+	if parsed, err := url.Parse(u); err != nil || parsed.Host == "" || parsed.Scheme != "http" && parsed.Scheme != "https" {
+		return nil, Errorf(-32602, "Invalid webhook URL: %v", u)
+	}
+	if l.webhook(u) >= 0 {
+		return nil, Errorf(-32602, "A webhook with the URL %v already exists", u)
+	}
+	hook := &webhook{URL: u, Actions: make(map[string]bool), Sources: make(map[string]bool)}
+	actions, sources := p.Map("actions"), p.Map("sources")
+	for name, def := range webhookActions {
+		hook.Actions[name] = actions.Bool(name, def)
+	}
+	for name, def := range webhookSources {
+		hook.Sources[name] = sources.Bool(name, def)
+	}
+	l.webhooks = append(l.webhooks, hook)
+	return true, nil
+}
+
+func (s *Server) listWebhookDel(p Params) (interface{}, error) {
+	l, err := s.list(p)
+	if err != nil {
+		return nil, err
+	}
+	if err := p.require("url"); err != nil {
+		return nil, err
+	}
+	i := l.webhook(p.String("url"))
+	if i < 0 {
+		return nil, Errorf(-32602, "Invalid webhook URL: %v", p.String("url"))
+	}
+	l.webhooks = append(l.webhooks[:i], l.webhooks[i+1:]...)
+	return true, nil
+}
@@ -0,0 +1,203 @@
+package mailchimp
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"strings"
+	"time"
+)
+
+//ListMembersChangedSince walks the members of a list with the given status,
+//or subscribed if it is empty, whose data changed at or after since, e.g. for
+//an incremental sync. Take the time before walking the members and pass it
+//the next time, so that changes made while walking are not missed.
+func (a *API) ListMembersChangedSince(ctx context.Context, id, status string, since time.Time) *Pager[ListMember] {
+	parameters := map[string]interface{}{"id": id, "since": chimpTime(since)}
+	if status != "" {
+		parameters["status"] = status
+	}
+	return a.ListMembersPager(ctx, parameters)
+}
+
+//MergeVars are the merge fields of a list member, sent as the merge_vars of
+//ListSubscribe and ListUpdateMember and returned as the merges of
+//ListMemberInfo. Mailchimp keeps the special merge vars, such as the member's
+//interest groups, alongside the list's own fields in one object; MergeVars
+//separates them.
+type MergeVars struct {
+	//Fields are the list's merge fields keyed by tag, e.g. FNAME. Mailchimp
+	//returns the member's address as the EMAIL field.
+	Fields map[string]interface{}
+	//Groupings are the interest groups the member is in
+	Groupings []Grouping
+	//NewEmail changes the member's email address in ListUpdateMember
+	NewEmail string
+	//OptinIP is the address the member confirmed their subscription from
+	OptinIP string
+	//OptinTime is when the member confirmed their subscription
+	OptinTime ChimpTime
+	//Language is the member's language code, e.g. fr
+	Language string
+}
+
+//String returns the merge field with the given tag as a string, or "" if the
+//member has no such field
+func (m *MergeVars) String(tag string) string {
+	switch v := m.Fields[tag].(type) {
+	case string:
+		return v
+	case nil:
+		return ""
+	default:
+		b, _ := json.Marshal(v)
+		return string(b)
+	}
+}
+
+func (m MergeVars) MarshalJSON() ([]byte, error) {
+	merges := make(map[string]interface{}, len(m.Fields)+5)
+	for tag, v := range m.Fields {
+		merges[tag] = v
+	}
+	if m.Groupings != nil {
+		merges["GROUPINGS"] = m.Groupings
+	}
+	if m.NewEmail != "" {
+		merges["NEW-EMAIL"] = m.NewEmail
+	}
+	if m.OptinIP != "" {
+		merges["OPTIN_IP"] = m.OptinIP
+	}
+	if !m.OptinTime.IsZero() {
+		merges["OPTIN_TIME"] = m.OptinTime
+	}
+	if m.Language != "" {
+		merges["MC_LANGUAGE"] = m.Language
+	}
+	return json.Marshal(merges)
+}
+
+func (m *MergeVars) UnmarshalJSON(data []byte) error {
+	if emptyArray(data) {
+		*m = MergeVars{}
+		return nil
+	}
+	var merges map[string]json.RawMessage
+	if err := json.Unmarshal(data, &merges); err != nil {
+		return err
+	}
+	*m = MergeVars{Fields: make(map[string]interface{}, len(merges))}
+	for tag, raw := range merges {
+		var err error
+		switch tag {
+		case "GROUPINGS":
+			err = json.Unmarshal(raw, &m.Groupings)
+		case "NEW-EMAIL":
+			err = json.Unmarshal(raw, &m.NewEmail)
+		case "OPTIN_IP":
+			err = json.Unmarshal(raw, &m.OptinIP)
+		case "OPTIN_TIME":
+			err = json.Unmarshal(raw, &m.OptinTime)
+		case "MC_LANGUAGE":
+			err = json.Unmarshal(raw, &m.Language)
+		default:
+			var v interface{}
+			err = json.Unmarshal(raw, &v)
+			m.Fields[tag] = v
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+//Grouping is the groups of an interest grouping that a member is in. When
+//subscribing or updating a member the grouping is selected by ID or, if that
+//is zero, by Name.
+type Grouping struct {
+	ID     int
+	Name   string
+	Groups []string
+}
+
+//groupingJSON is how Mailchimp sends groupings, with the groups joined by
+//commas, which are escaped with a backslash in group names
+type groupingJSON struct {
+	ID     FlexInt `json:"id,omitempty"`
+	Name   string  `json:"name,omitempty"`
+	Groups string  `json:"groups"`
+}
+
+func (g Grouping) MarshalJSON() ([]byte, error) {
+	groups := make([]string, len(g.Groups))
+	for i, group := range g.Groups {
+		groups[i] = strings.ReplaceAll(group, ",", `\,`)
+	}
+	j := groupingJSON{ID: FlexInt(g.ID), Groups: strings.Join(groups, ",")}
+	if g.ID == 0 {
+		j.Name = g.Name
+	}
+	return json.Marshal(j)
+}
+
+func (g *Grouping) UnmarshalJSON(data []byte) error {
+	var j groupingJSON
+	if err := json.Unmarshal(data, &j); err != nil {
+		return err
+	}
+	*g = Grouping{ID: int(j.ID), Name: j.Name}
+	var group strings.Builder
+	for i := 0; i < len(j.Groups); i++ {
+		switch c := j.Groups[i]; {
+		case c == '\\' && i+1 < len(j.Groups) && j.Groups[i+1] == ',':
+			group.WriteByte(',')
+			i++
+		case c == ',':
+			g.Groups = append(g.Groups, strings.TrimSpace(group.String()))
+			group.Reset()
+		default:
+			group.WriteByte(c)
+		}
+	}
+	if last := strings.TrimSpace(group.String()); last != "" || len(g.Groups) > 0 {
+		g.Groups = append(g.Groups, last)
+	}
+	return nil
+}
+
+//emptyArray reports whether data is [], which Mailchimp sends in place of an
+//empty object, e.g. as the geo of a member who has never opened a campaign
+func emptyArray(data []byte) bool {
+	return bytes.Equal(bytes.Join(bytes.Fields(data), nil), []byte("[]"))
+}
+
+//MemberLists are a member's statuses in lists, keyed by list id
+type MemberLists map[string]string
+
+func (l *MemberLists) UnmarshalJSON(data []byte) error {
+	if emptyArray(data) {
+		*l = nil
+		return nil
+	}
+	return json.Unmarshal(data, (*map[string]string)(l))
+}
+
+func (g *MemberGeo) UnmarshalJSON(data []byte) error {
+	if emptyArray(data) {
+		*g = MemberGeo{}
+		return nil
+	}
+	type plain MemberGeo
+	return json.Unmarshal(data, (*plain)(g))
+}
+
+func (c *MemberClient) UnmarshalJSON(data []byte) error {
+	if emptyArray(data) {
+		*c = MemberClient{}
+		return nil
+	}
+	type plain MemberClient
+	return json.Unmarshal(data, (*plain)(c))
+}
@@ -0,0 +1,83 @@
+package mailchimp
+
+import (
+	"context"
+	"encoding/json"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestMergeVarsMarshal(t *testing.T) {
+	merges := MergeVars{
+		Fields: map[string]interface{}{"FNAME": "Jane"},
+		Groupings: []Grouping{
+			{ID: 8437, Name: "ignored", Groups: []string{"Coffee", "Tea, Green"}},
+			{Name: "Frequency", Groups: []string{}},
+		},
+		NewEmail:  "jane.doe@example.com",
+		OptinTime: ChimpTime{time.Date(2013, 1, 4, 18, 14, 30, 0, time.UTC)},
+	}
+	b, err := json.Marshal(merges)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := `{"FNAME":"Jane","GROUPINGS":[{"id":8437,"groups":"Coffee,Tea\\, Green"},{"name":"Frequency","groups":""}],"NEW-EMAIL":"jane.doe@example.com","OPTIN_TIME":"2013-01-04 18:14:30"}`
+	verify(t, "MergeVars", want, string(b))
+
+	var decoded MergeVars
+	if err := json.Unmarshal(b, &decoded); err != nil {
+		t.Fatal(err)
+	}
+	merges.Groupings[0].Name = ""
+	merges.Groupings[1].Groups = nil
+	if !reflect.DeepEqual(decoded, merges) {
+		t.Errorf("expected %+v but got %+v", merges, decoded)
+	}
+}
+
+func TestMergeVarsParameters(t *testing.T) {
+	parameters, err := ToParameters(&ListSubscribeParams{
+		ID:           "f6b4ea2a1c",
+		EmailAddress: "jane@example.com",
+		MergeVars:    &MergeVars{Fields: map[string]interface{}{"FNAME": "Jane"}, Language: "fr"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	merges, ok := parameters["merge_vars"].(map[string]interface{})
+	if !ok || merges["FNAME"] != "Jane" || merges["MC_LANGUAGE"] != "fr" || len(merges) != 2 {
+		t.Error("expected the merge vars to be sent flattened but got", parameters["merge_vars"])
+	}
+}
+
+func TestListMemberInfoDecoding(t *testing.T) {
+	info, err := fixtureAPI(t, "listMemberInfo").ListMemberInfoContext(context.Background(), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	jane := info.Data[0]
+	verify(t, "FNAME", "Jane", jane.Merges.String("FNAME"))
+	verify(t, "ADDRESS", `{"addr1":"1 Main St","addr2":"","city":"Atlanta","country":"US","state":"GA","zip":"30308"}`, jane.Merges.String("ADDRESS"))
+	verify(t, "missing field", "", jane.Merges.String("MMERGE9"))
+	want := []Grouping{{ID: 8437, Name: "Interests", Groups: []string{"Coffee", "Tea, Green"}}, {ID: 8441, Name: "Frequency"}}
+	if !reflect.DeepEqual(jane.Merges.Groupings, want) {
+		t.Errorf("expected groupings %+v but got %+v", want, jane.Merges.Groupings)
+	}
+	verify(t, "member_rating", 4, int(jane.MemberRating))
+	verify(t, "geo", -84.388, float64(jane.Geo.Longitude))
+	verify(t, "lists", "unsubscribed", jane.Lists["a91b6e3c02"])
+	verify(t, "static_segments", "Early adopters", jane.StaticSegments[0].Name)
+	verify(t, "error", "nobody@example.com", info.Data[1].EmailAddress)
+}
+
+func TestMemberEmptyObjects(t *testing.T) {
+	var info MemberInfo
+	err := json.Unmarshal([]byte(`{"merges":{"EMAIL":"a@example.com"},"lists":[],"geo":[],"clients":[ ]}`), &info)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if info.Lists != nil || info.Geo != (MemberGeo{}) || info.Clients != (MemberClient{}) {
+		t.Errorf("expected empty arrays to decode as empty objects but got %+v", info)
+	}
+}
@@ -0,0 +1,43 @@
+package mailchimp
+
+//MergeVarOptions are the options of a merge field given to ListMergeVarAdd
+//and ListMergeVarUpdate. Unset options keep their current values, or
+//Mailchimp's defaults for a new field.
+type MergeVarOptions struct {
+	//Name renames the field in ListMergeVarUpdate
+	Name string `json:"name,omitempty"`
+	//FieldType is one of text, number, radio, dropdown, date, birthday,
+	//address, zip, phone, url or imageurl; text by default
+	FieldType string `json:"field_type,omitempty"`
+	Required  *bool  `json:"req,omitempty"`
+	Public    *bool  `json:"public,omitempty"`
+	Show      *bool  `json:"show,omitempty"`
+	Order     int    `json:"order,omitempty"`
+	//DefaultValue is the value of the field for members who don't give one
+	DefaultValue string `json:"default_value,omitempty"`
+	HelpText     string `json:"helptext,omitempty"`
+	//Choices are the choices of radio and dropdown fields
+	Choices []string `json:"choices,omitempty"`
+	//DateFormat is the format of date and birthday fields, e.g. MM/DD/YYYY
+	DateFormat string `json:"dateformat,omitempty"`
+	//PhoneFormat is US for US numbers, or empty for international ones
+	PhoneFormat string `json:"phoneformat,omitempty"`
+	//DefaultCountry is the ISO 3166 code of the default country of address
+	//fields
+	DefaultCountry string `json:"defaultcountry,omitempty"`
+}
+
+//Options returns the options that define v, e.g. to add the same field to
+//another list with ListMergeVarAdd
+func (v *MergeVar) Options() *MergeVarOptions {
+	return &MergeVarOptions{
+		FieldType:    v.FieldType,
+		Required:     Bool(bool(v.Required)),
+		Public:       Bool(bool(v.Public)),
+		Show:         Bool(bool(v.Show)),
+		Order:        int(v.Order),
+		DefaultValue: v.Default,
+		HelpText:     v.HelpText,
+		Choices:      append([]string(nil), v.Choices...),
+	}
+}
@@ -0,0 +1,28 @@
+package mailchimp
+
+import (
+	"context"
+	"reflect"
+	"testing"
+)
+
+func TestMergeVarOptions(t *testing.T) {
+	vars, err := fixtureAPI(t, "listMergeVars").ListMergeVarsContext(context.Background(), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	options := vars[2].Options()
+	want := &MergeVarOptions{
+		FieldType:    "dropdown",
+		Required:     Bool(false),
+		Public:       Bool(true),
+		Show:         Bool(false),
+		Order:        3,
+		DefaultValue: "Blue",
+		HelpText:     "Pick one",
+		Choices:      []string{"Red", "Green", "Blue"},
+	}
+	if !reflect.DeepEqual(options, want) {
+		t.Errorf("expected %+v but got %+v", want, options)
+	}
+}
@@ -0,0 +1,91 @@
+package mailchimp
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+)
+
+//Option configures an API created by NewClient
+type Option func(*API) error
+
+//WithHTTPClient makes requests with client instead of http.DefaultClient
+func WithHTTPClient(client *http.Client) Option {
+	return func(a *API) error {
+		if client == nil {
+			return errors.New("mailchimp: nil http.Client")
+		}
+		a.client = client
+		return nil
+	}
+}
+
+//WithTransport makes requests through transport, e.g. to route them via a proxy.
+//Other settings of the client, such as its timeout, are kept.
+func WithTransport(transport http.RoundTripper) Option {
+	return func(a *API) error {
+		if transport == nil {
+			return errors.New("mailchimp: nil http.RoundTripper")
+		}
+		client := *a.client
+		client.Transport = transport
+		a.client = &client
+		return nil
+	}
+}
+
+//WithBaseURL sends requests to base instead of the Mailchimp datacenter,
+//e.g. http://127.0.0.1:8080/1.3/ for a local stand-in server.
+//It takes precedence over WithDatacenter.
+func WithBaseURL(base string) Option {
+	return func(a *API) error {
+		u, err := url.Parse(base)
+		if err != nil {
+			return err
+		}
+		if u.Scheme == "" || u.Host == "" {
+			return fmt.Errorf("mailchimp: base URL %q is not absolute", base)
+		}
+		u.RawQuery = ""
+		u.Fragment = ""
+		a.endpoint = u.String() + "?method="
+		return nil
+	}
+}
+
+//WithUserAgent sets the User-Agent header sent with every request
+func WithUserAgent(userAgent string) Option {
+	return func(a *API) error {
+		a.userAgent = userAgent
+		return nil
+	}
+}
+
+//WithDatacenter overrides the datacenter, e.g. "us2", named at the end of the API key
+func WithDatacenter(dc string) Option {
+	return func(a *API) error {
+		if dc == "" || datacenter.FindString(dc) != dc {
+			return fmt.Errorf("mailchimp: invalid datacenter %q", dc)
+		}
+		a.datacenter = dc
+		return nil
+	}
+}
+
+//WithStrictDecoding makes methods return a DecodeError when a response has
+//fields that are missing from the method's result type, so that changes to
+//Mailchimp's responses are noticed
+func WithStrictDecoding() Option {
+	return func(a *API) error {
+		a.strict = true
+		return nil
+	}
+}
+
+func withScheme(scheme string) Option {
+	return func(a *API) error {
+		a.scheme = scheme
+		return nil
+	}
+}
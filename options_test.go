@@ -0,0 +1,91 @@
+package mailchimp
+
+import (
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestNewWithoutHttpsFlag(t *testing.T) {
+	api, err := New("abc123-us2")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if api.endpoint != "https://us2.api.mailchimp.com/1.3/?method=" {
+		t.Error("New: unexpected endpoint", api.endpoint)
+	}
+	api, _ = New("abc123-us2", false)
+	if !strings.HasPrefix(api.endpoint, "http://") {
+		t.Error("New: expected an http endpoint but got", api.endpoint)
+	}
+}
+
+func TestNewClientInvalidKey(t *testing.T) {
+	for _, key := range []string{"", "abc123", "abc123-", "-us1", "abc 123-us1"} {
+		if _, err := NewClient(key); err != ErrInvalidKey {
+			t.Errorf("NewClient(%q): expected ErrInvalidKey but got %v", key, err)
+		}
+	}
+}
+
+func TestWithDatacenter(t *testing.T) {
+	api, err := NewClient("abc123-us1", WithDatacenter("us9"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if api.endpoint != "https://us9.api.mailchimp.com/1.3/?method=" {
+		t.Error("WithDatacenter: unexpected endpoint", api.endpoint)
+	}
+	if _, err := NewClient("abc123-us1", WithDatacenter("us9.evil.com/")); err == nil {
+		t.Error("WithDatacenter: expected an error for an invalid datacenter")
+	}
+	if _, err := NewClient("abc123-us1", WithDatacenter("")); err == nil {
+		t.Error("WithDatacenter: expected an error for an empty datacenter")
+	}
+}
+
+type countingTransport struct {
+	requests int
+}
+
+func (c *countingTransport) RoundTrip(r *http.Request) (*http.Response, error) {
+	c.requests++
+	return http.DefaultTransport.RoundTrip(r)
+}
+
+func TestWithBaseURLTransportAndUserAgent(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := ioutil.ReadAll(r.Body)
+		if r.URL.Path != "/1.3/" || r.URL.Query().Get("method") != "ping" {
+			t.Error("unexpected request url", r.URL)
+		}
+		if r.UserAgent() != "mailchimp-test/1.0" {
+			t.Error("unexpected user agent", r.UserAgent())
+		}
+		if !strings.Contains(string(body), `"apikey":"abc123-us1"`) {
+			t.Error("request body is missing the apikey", string(body))
+		}
+		w.Write([]byte(`"Everything's Chimpy!"`))
+	}))
+	defer server.Close()
+	transport := new(countingTransport)
+	api, err := NewClient("abc123-us1",
+		WithBaseURL(server.URL+"/1.3/"),
+		WithTransport(transport),
+		WithUserAgent("mailchimp-test/1.0"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	result, err := api.Ping()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if result != "Everything's Chimpy!" {
+		t.Error("Ping: unexpected result", result)
+	}
+	if transport.requests != 1 {
+		t.Error("WithTransport: expected 1 request through the transport but got", transport.requests)
+	}
+}
@@ -0,0 +1,346 @@
+package mailchimp
+
+import (
+	"context"
+	"encoding/json"
+	"sort"
+	"strconv"
+)
+
+//pageLimits are the largest page size each paged routine accepts. Pagers
+//request pages of this size unless the parameters ask for smaller ones.
+//Every paged routine is generated from routines.json and adds itself in an
+//init function.
+var pageLimits = make(map[string]int)
+
+//Pager walks every item of a paged routine, fetching each page from
+//Mailchimp only when the items before it have been read. The start
+//parameter, which Mailchimp treats as a page number, sets the first page.
+//A Pager is not safe for concurrent use.
+//
+//	pager := chimp.CampaignMembersPager(ctx, parameters)
+//	for pager.Next() {
+//		member := pager.Item()
+//		//...
+//	}
+//	if err := pager.Err(); err != nil {
+//		//...
+//	}
+type Pager[T any] struct {
+	ctx        context.Context
+	method     string
+	parameters map[string]interface{}
+	fetch      fetchFunc[T]
+	page       int
+	limit      int
+	total      int
+	fetched    int
+	items      []T
+	item       T
+	done       bool
+	err        error
+	workers    int
+	queue      chan chan pageFetch[T]
+	cancel     context.CancelFunc
+}
+
+//pageFetch is a page fetched by one of the workers of a parallel Pager
+type pageFetch[T any] struct {
+	items []T
+	err   error
+}
+
+//fetchFunc fetches the page of a paged routine selected by parameters,
+//returning the total number of items and the items on the page
+type fetchFunc[T any] func(ctx context.Context, parameters map[string]interface{}) (int, []T, error)
+
+func newPager[T any](ctx context.Context, method string, parameters map[string]interface{}, fetch fetchFunc[T]) *Pager[T] {
+	p := &Pager[T]{
+		ctx:        ctx,
+		method:     method,
+		parameters: make(map[string]interface{}, len(parameters)+2),
+		fetch:      fetch,
+	}
+	for k, v := range parameters {
+		p.parameters[k] = v
+	}
+	p.page, _ = intParam(parameters["start"])
+	p.limit = pageLimit(method, parameters)
+	p.parameters["limit"] = p.limit
+	return p
+}
+
+//PagerOf returns a Pager that walks items without fetching anything, then
+//stops with err if it is not nil. It is meant for canned results in tests,
+//e.g. of a mock Client.
+func PagerOf[T any](items []T, err error) *Pager[T] {
+	return &Pager[T]{
+		ctx:     context.Background(),
+		items:   append([]T(nil), items...),
+		total:   len(items),
+		fetched: len(items),
+		done:    true,
+		err:     err,
+	}
+}
+
+//pageLimit returns the page size to request for method: the limit
+//parameter if there is one, capped to the routine's largest page size
+func pageLimit(method string, parameters map[string]interface{}) int {
+	limit, ok := intParam(parameters["limit"])
+	if max := pageLimits[method]; !ok || limit <= 0 || limit > max {
+		return max
+	}
+	return limit
+}
+
+//Next advances to the next item, fetching the next page when the current
+//one is used up, and reports whether there is one. It returns false after
+//the last item, after an error and once the context is done.
+func (p *Pager[T]) Next() bool {
+	for len(p.items) == 0 {
+		if p.done || p.err != nil {
+			return false
+		}
+		if p.queue != nil {
+			p.receive()
+			continue
+		}
+		if err := p.ctx.Err(); err != nil {
+			p.err = TimeoutError{p.method, err}
+			return false
+		}
+		p.parameters["start"] = p.page
+		total, items, err := p.fetch(p.ctx, p.parameters)
+		if err != nil {
+			p.err = err
+			return false
+		}
+		p.page++
+		p.total = total
+		p.fetched += len(items)
+		p.items = items
+		p.done = len(items) < p.limit || p.fetched >= total
+		if !p.done && p.workers > 1 {
+			p.startWorkers()
+		}
+	}
+	p.item, p.items = p.items[0], p.items[1:]
+	return true
+}
+
+//Parallel makes the Pager fetch the pages after the first with up to
+//workers concurrent requests, scheduling them from the Total reported with
+//the first page. Items are still returned in order, and no more than about
+//workers pages are held ahead of the one being read. The requests go through
+//the API's Limiter like any other. Parallel must be called before the first
+//call to Next, and a parallel Pager abandoned before its last item should be
+//closed.
+func (p *Pager[T]) Parallel(workers int) *Pager[T] {
+	p.workers = workers
+	return p
+}
+
+//Close stops the Pager, so that Next returns false, along with the requests
+//of a parallel Pager. It is only needed when a parallel Pager is abandoned
+//before Next returns false.
+func (p *Pager[T]) Close() {
+	if p.cancel != nil {
+		p.cancel()
+	}
+	p.items = nil
+	p.done = true
+}
+
+//startWorkers schedules the requests for the pages after the current one
+func (p *Pager[T]) startWorkers() {
+	remaining := p.total - p.page*p.limit
+	pages := (remaining + p.limit - 1) / p.limit
+	base := make(map[string]interface{}, len(p.parameters))
+	for k, v := range p.parameters {
+		base[k] = v
+	}
+	ctx, cancel := context.WithCancel(p.ctx)
+	p.cancel = cancel
+	p.queue = make(chan chan pageFetch[T], p.workers)
+	sem := make(chan struct{}, p.workers)
+	go func(queue chan<- chan pageFetch[T], first int) {
+		defer close(queue)
+		for page := first; page < first+pages; page++ {
+			select {
+			case sem <- struct{}{}:
+			case <-ctx.Done():
+				return
+			}
+			result := make(chan pageFetch[T], 1)
+			select {
+			case queue <- result:
+			case <-ctx.Done():
+				<-sem
+				return
+			}
+			parameters := make(map[string]interface{}, len(base))
+			for k, v := range base {
+				parameters[k] = v
+			}
+			parameters["start"] = page
+			go func() {
+				defer func() { <-sem }()
+				_, items, err := p.fetch(ctx, parameters)
+				result <- pageFetch[T]{items, err}
+			}()
+		}
+	}(p.queue, p.page)
+}
+
+//receive reads the next page fetched by the workers, in order
+func (p *Pager[T]) receive() {
+	result, ok := <-p.queue
+	if !ok {
+		if err := p.ctx.Err(); err != nil {
+			p.err = TimeoutError{p.method, err}
+		}
+		p.Close()
+		return
+	}
+	page := <-result
+	if page.err != nil {
+		p.err = page.err
+		p.Close()
+		return
+	}
+	p.page++
+	p.fetched += len(page.items)
+	p.items = page.items
+}
+
+//Item returns the item Next advanced to
+func (p *Pager[T]) Item() T {
+	return p.item
+}
+
+//Err returns the error that stopped the Pager, if any
+func (p *Pager[T]) Err() error {
+	return p.err
+}
+
+//Total returns the total number of items Mailchimp reported with the last
+//page fetched, or zero before the first
+func (p *Pager[T]) Total() int {
+	return p.total
+}
+
+//pageResult is the shape of the results of most paged routines
+type pageResult[T any] struct {
+	Total FlexInt `json:"total"`
+	Data  []T     `json:"data"`
+}
+
+//fetchPage fetches pages of routines whose results have the usual shape
+func fetchPage[T any](a *API, method string) fetchFunc[T] {
+	return func(ctx context.Context, parameters map[string]interface{}) (int, []T, error) {
+		result := new(pageResult[T])
+		if err := parseJson(ctx, a, method, parameters, result); err != nil {
+			return 0, nil, err
+		}
+		return int(result.Total), result.Data, nil
+	}
+}
+
+//intParam converts the numeric types parameters are commonly given in,
+//including the json.Number values of params structs, to int
+func intParam(v interface{}) (int, bool) {
+	switch n := v.(type) {
+	case int:
+		return n, true
+	case int64:
+		return int(n), true
+	case FlexInt:
+		return int(n), true
+	case float64:
+		return int(n), true
+	case json.Number:
+		i, err := n.Int64()
+		return int(i), err == nil
+	case string:
+		i, err := strconv.Atoi(n)
+		return i, err == nil
+	}
+	return 0, false
+}
+
+//CampaignEmailStatsAIMAllItem is the activity of one member, as walked by
+//CampaignEmailStatsAIMAllPager
+type CampaignEmailStatsAIMAllItem struct {
+	Email    string
+	Activity []CampaignEmailStatsAIMResultDataItem
+}
+
+//CampaignEmailStatsAIMAllPager walks every page of CampaignEmailStatsAIMAll.
+//Mailchimp returns each page as an object keyed by email address, so the
+//members of a page are walked in order of their addresses. It is written
+//here rather than generated, as routines.json's custom_pager says, because
+//generated Pagers walk a data array.
+func (a *API) CampaignEmailStatsAIMAllPager(ctx context.Context, parameters map[string]interface{}) *Pager[CampaignEmailStatsAIMAllItem] {
+	return newPager(ctx, "campaignEmailStatsAIMAll", parameters, a.fetchEmailStatsAIMAll)
+}
+
+func (a *API) fetchEmailStatsAIMAll(ctx context.Context, parameters map[string]interface{}) (int, []CampaignEmailStatsAIMAllItem, error) {
+	result, err := a.CampaignEmailStatsAIMAllContext(ctx, parameters)
+	if err != nil {
+		return 0, nil, err
+	}
+	return int(result.Total), emailStatsItems(result.Data), nil
+}
+
+//emailStatsItems flattens the data of a CampaignEmailStatsAIMAllResult
+//into items sorted by email address
+func emailStatsItems(data map[string][]CampaignEmailStatsAIMResultDataItem) []CampaignEmailStatsAIMAllItem {
+	items := make([]CampaignEmailStatsAIMAllItem, 0, len(data))
+	for email, activity := range data {
+		items = append(items, CampaignEmailStatsAIMAllItem{email, activity})
+	}
+	sort.Slice(items, func(i, j int) bool {
+		return items[i].Email < items[j].Email
+	})
+	return items
+}
+
+//SearchMembersPager walks every member found by the full search of
+//SearchMembers. Mailchimp returns matches 100 at a time, so a limit
+//parameter is ignored, and the offset parameter, like start, sets the first
+//page. routines.json gives the page limit but leaves this Pager to be
+//written by hand, because generated Pagers walk the data array of a result
+//with a total, page with start and limit, while searchMembers pages with
+//offset alone and nests its matches in full_search.
+func (a *API) SearchMembersPager(ctx context.Context, parameters map[string]interface{}) *Pager[MemberInfo] {
+	search := make(map[string]interface{}, len(parameters))
+	for k, v := range parameters {
+		switch k {
+		case "limit":
+		case "offset":
+			search["start"] = v
+		default:
+			search[k] = v
+		}
+	}
+	return newPager(ctx, "searchMembers", search, a.fetchSearchMembers)
+}
+
+func (a *API) fetchSearchMembers(ctx context.Context, parameters map[string]interface{}) (int, []MemberInfo, error) {
+	search := make(map[string]interface{}, len(parameters))
+	for k, v := range parameters {
+		switch k {
+		case "limit":
+		case "start":
+			search["offset"] = v
+		default:
+			search[k] = v
+		}
+	}
+	result, err := a.SearchMembersContext(ctx, search)
+	if err != nil {
+		return 0, nil, err
+	}
+	return int(result.FullSearch.Total), result.FullSearch.Members, nil
+}
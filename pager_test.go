@@ -0,0 +1,214 @@
+package mailchimp
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+//pagedServer serves total campaign members, member0@example.com onwards,
+//in pages selected by the start and limit parameters, recording the
+//parameters of every request
+type pagedServer struct {
+	*httptest.Server
+	mu          sync.Mutex
+	requests    []map[string]interface{}
+	inFlight    int32
+	maxInFlight int32
+	//failStart is a page that fails with a ChimpError, if not zero
+	failStart float64
+	delay     time.Duration
+}
+
+func newPagedServer(total int) *pagedServer {
+	s := &pagedServer{delay: time.Millisecond}
+	s.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var parameters map[string]interface{}
+		json.NewDecoder(r.Body).Decode(&parameters)
+		s.mu.Lock()
+		s.requests = append(s.requests, parameters)
+		s.mu.Unlock()
+		n := atomic.AddInt32(&s.inFlight, 1)
+		defer atomic.AddInt32(&s.inFlight, -1)
+		for {
+			max := atomic.LoadInt32(&s.maxInFlight)
+			if n <= max || atomic.CompareAndSwapInt32(&s.maxInFlight, max, n) {
+				break
+			}
+		}
+		time.Sleep(s.delay)
+		start, _ := parameters["start"].(float64)
+		limit, _ := parameters["limit"].(float64)
+		if s.failStart != 0 && start == s.failStart {
+			w.Write([]byte(`{"error":"Too many connections","code":-50}`))
+			return
+		}
+		result := CampaignMembersResult{Total: FlexInt(total)}
+		for i := int(start * limit); i < total && i < int((start+1)*limit); i++ {
+			result.Data = append(result.Data, CampaignMembersResultDataItem{Email: fmt.Sprintf("member%d@example.com", i)})
+		}
+		json.NewEncoder(w).Encode(result)
+	}))
+	return s
+}
+
+func (s *pagedServer) api(t *testing.T) *API {
+	api, err := NewClient("abc123-us1", WithBaseURL(s.URL+"/1.3/"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return api
+}
+
+func TestPager(t *testing.T) {
+	server := newPagedServer(25)
+	defer server.Close()
+	parameters := map[string]interface{}{"cid": "abc", "limit": 10}
+	pager := server.api(t).CampaignMembersPager(context.Background(), parameters)
+	n := 0
+	for ; pager.Next(); n++ {
+		verify(t, "CampaignMembersPager", fmt.Sprintf("member%d@example.com", n), pager.Item().Email)
+	}
+	if err := pager.Err(); err != nil {
+		t.Fatal("CampaignMembersPager:", err)
+	}
+	verify(t, "CampaignMembersPager items", 25, n)
+	verify(t, "CampaignMembersPager total", 25, pager.Total())
+	verify(t, "CampaignMembersPager requests", 3, len(server.requests))
+	if _, ok := parameters["start"]; ok {
+		t.Error("CampaignMembersPager: the caller's parameters were changed")
+	}
+}
+
+func TestPagerLimit(t *testing.T) {
+	for limit, expected := range map[interface{}]float64{nil: 1000, 50: 50, 5000: 1000, json.Number("20"): 20} {
+		server := newPagedServer(0)
+		pager := server.api(t).CampaignsPager(context.Background(), map[string]interface{}{"limit": limit})
+		for pager.Next() {
+		}
+		server.Close()
+		if len(server.requests) != 1 || server.requests[0]["limit"] != expected {
+			t.Errorf("CampaignsPager limit %v: expected a limit of %v but sent %v", limit, expected, server.requests)
+		}
+	}
+}
+
+func TestPagerStart(t *testing.T) {
+	server := newPagedServer(25)
+	defer server.Close()
+	pager := server.api(t).CampaignMembersPager(context.Background(), map[string]interface{}{"start": 1, "limit": 10})
+	if !pager.Next() {
+		t.Fatal("CampaignMembersPager:", pager.Err())
+	}
+	verify(t, "CampaignMembersPager", "member10@example.com", pager.Item().Email)
+}
+
+func TestPagerCanceled(t *testing.T) {
+	server := newPagedServer(25)
+	defer server.Close()
+	ctx, cancel := context.WithCancel(context.Background())
+	pager := server.api(t).CampaignMembersPager(ctx, map[string]interface{}{"limit": 10})
+	for i := 0; i < 10; i++ {
+		if !pager.Next() {
+			t.Fatal("CampaignMembersPager:", pager.Err())
+		}
+	}
+	cancel()
+	if pager.Next() {
+		t.Error("CampaignMembersPager: expected Next to stop once the context was canceled")
+	}
+	if !errors.Is(pager.Err(), context.Canceled) {
+		t.Error("CampaignMembersPager: expected context.Canceled but got", pager.Err())
+	}
+	verify(t, "CampaignMembersPager requests", 1, len(server.requests))
+}
+
+func TestPagerError(t *testing.T) {
+	server, _ := flakyServer(1, http.StatusOK, `{"error":"Invalid Campaign ID","code":300}`)
+	defer server.Close()
+	pager := retryAPI(t, server, RetryPolicy{}).CampaignUnsubscribesPager(context.Background(), nil)
+	if pager.Next() || !errors.Is(pager.Err(), ErrCampaignNotFound) {
+		t.Error("CampaignUnsubscribesPager: expected ErrCampaignNotFound but got", pager.Err())
+	}
+}
+
+func TestEmailStatsItems(t *testing.T) {
+	items := emailStatsItems(map[string][]CampaignEmailStatsAIMResultDataItem{
+		"b@example.com": {{Action: "open"}},
+		"a@example.com": {{Action: "click"}, {Action: "open"}},
+	})
+	verify(t, "emailStatsItems", 2, len(items))
+	verify(t, "emailStatsItems", "a@example.com", items[0].Email)
+	verify(t, "emailStatsItems", 2, len(items[0].Activity))
+}
+
+func TestPagerParallel(t *testing.T) {
+	server := newPagedServer(95)
+	server.delay = 10 * time.Millisecond
+	defer server.Close()
+	pager := server.api(t).CampaignMembersPager(context.Background(), map[string]interface{}{"limit": 10}).Parallel(4)
+	n := 0
+	for ; pager.Next(); n++ {
+		verify(t, "CampaignMembersPager", fmt.Sprintf("member%d@example.com", n), pager.Item().Email)
+	}
+	if err := pager.Err(); err != nil {
+		t.Fatal("CampaignMembersPager:", err)
+	}
+	verify(t, "CampaignMembersPager items", 95, n)
+	verify(t, "CampaignMembersPager requests", 10, len(server.requests))
+	if max := atomic.LoadInt32(&server.maxInFlight); max < 2 || max > 4 {
+		t.Error("CampaignMembersPager: expected between 2 and 4 concurrent requests but got", max)
+	}
+}
+
+func TestPagerParallelError(t *testing.T) {
+	server := newPagedServer(95)
+	server.failStart = 3
+	defer server.Close()
+	pager := server.api(t).CampaignMembersPager(context.Background(), map[string]interface{}{"limit": 10}).Parallel(4)
+	n := 0
+	for ; pager.Next(); n++ {
+	}
+	verify(t, "CampaignMembersPager items", 30, n)
+	if !errors.Is(pager.Err(), ErrTooManyConnections) {
+		t.Error("CampaignMembersPager: expected ErrTooManyConnections but got", pager.Err())
+	}
+}
+
+func TestPagerParallelClose(t *testing.T) {
+	server := newPagedServer(95)
+	defer server.Close()
+	pager := server.api(t).CampaignMembersPager(context.Background(), map[string]interface{}{"limit": 10}).Parallel(2)
+	if !pager.Next() {
+		t.Fatal("CampaignMembersPager:", pager.Err())
+	}
+	pager.Close()
+	if pager.Next() {
+		t.Error("CampaignMembersPager: expected Next to stop once the Pager was closed")
+	}
+	if pager.Err() != nil {
+		t.Error("CampaignMembersPager: expected no error after Close but got", pager.Err())
+	}
+}
+
+func TestPagerOf(t *testing.T) {
+	failed := errors.New("failed")
+	pager := PagerOf([]string{"a", "b"}, failed)
+	var items []string
+	for pager.Next() {
+		items = append(items, pager.Item())
+	}
+	if len(items) != 2 || items[1] != "b" || pager.Total() != 2 {
+		t.Error("PagerOf: expected the two items but got", items, pager.Total())
+	}
+	if pager.Err() != failed {
+		t.Error("PagerOf: expected the error after the items but got", pager.Err())
+	}
+}
@@ -0,0 +1,258 @@
+package mailchimp
+
+import (
+	"bytes"
+	"encoding/json"
+	"reflect"
+	"strings"
+)
+
+//Every routine that takes parameters has a params struct, e.g.
+//CampaignCreateParams for CampaignCreate, and a WithParams method, e.g.
+//CampaignCreateWithParams, that takes the struct instead of a
+//map[string]interface{}. The WithParams methods check that the required
+//parameters are set before making a request and return a ParamError if
+//one is missing. Optional booleans that Mailchimp defaults to true are
+//*bool, which can be set with Bool.
+
+//Bool returns a pointer to b, for optional boolean parameters
+func Bool(b bool) *bool {
+	return &b
+}
+
+//ToParameters validates params, e.g. a *CampaignCreateParams, and converts
+//it to the map taken by the methods that predate the params structs, as the
+//WithParams methods do. A nil pointer is treated as a struct with no
+//parameters set.
+func ToParameters(params interface{ Validate() error }) (map[string]interface{}, error) {
+	if v := reflect.ValueOf(params); v.Kind() == reflect.Ptr && v.IsNil() {
+		params = reflect.New(v.Type().Elem()).Interface().(interface{ Validate() error })
+	}
+	if err := params.Validate(); err != nil {
+		return nil, err
+	}
+	b, err := json.Marshal(params)
+	if err != nil {
+		return nil, err
+	}
+	decoder := json.NewDecoder(bytes.NewReader(b))
+	//keep numbers as they are rather than converting them to float64
+	decoder.UseNumber()
+	parameters := make(map[string]interface{})
+	if err = decoder.Decode(&parameters); err != nil {
+		return nil, err
+	}
+	omitZeroTimes(reflect.ValueOf(params), parameters)
+	return parameters, nil
+}
+
+var chimpTimeType = reflect.TypeOf(ChimpTime{})
+
+//omitZeroTimes deletes the dates left unset in the struct v, or in a struct
+//it holds, from parameters, the map v was converted to. ChimpTime encodes the
+//zero time as "" and omitempty does not apply to structs.
+func omitZeroTimes(v reflect.Value, parameters map[string]interface{}) {
+	for v.Kind() == reflect.Ptr {
+		if v.IsNil() {
+			return
+		}
+		v = v.Elem()
+	}
+	if v.Kind() != reflect.Struct {
+		return
+	}
+	for i := 0; i < v.NumField(); i++ {
+		field := v.Type().Field(i)
+		name, _, _ := strings.Cut(field.Tag.Get("json"), ",")
+		if !field.IsExported() || name == "" || name == "-" {
+			continue
+		}
+		if field.Type == chimpTimeType {
+			if v.Field(i).Interface().(ChimpTime).IsZero() {
+				delete(parameters, name)
+			}
+		} else if m, ok := parameters[name].(map[string]interface{}); ok {
+			omitZeroTimes(v.Field(i), m)
+		}
+	}
+}
+
+//CampaignOptions are the options of a campaign created by CampaignCreate
+type CampaignOptions struct {
+	ListID            string            `json:"list_id,omitempty"`
+	Subject           string            `json:"subject,omitempty"`
+	FromEmail         string            `json:"from_email,omitempty"`
+	FromName          string            `json:"from_name,omitempty"`
+	ToName            string            `json:"to_name,omitempty"`
+	TemplateID        int               `json:"template_id,omitempty"`
+	GalleryTemplateID int               `json:"gallery_template_id,omitempty"`
+	BaseTemplateID    int               `json:"base_template_id,omitempty"`
+	FolderID          int               `json:"folder_id,omitempty"`
+	Tracking          *CampaignTracking `json:"tracking,omitempty"`
+	Title             string            `json:"title,omitempty"`
+	Authenticate      bool              `json:"authenticate,omitempty"`
+	Analytics         map[string]string `json:"analytics,omitempty"`
+	AutoFooter        bool              `json:"auto_footer,omitempty"`
+	InlineCSS         bool              `json:"inline_css,omitempty"`
+	GenerateText      bool              `json:"generate_text,omitempty"`
+	AutoTweet         bool              `json:"auto_tweet,omitempty"`
+	AutoFBPost        []string          `json:"auto_fb_post,omitempty"`
+	FBComments        *bool             `json:"fb_comments,omitempty"`
+	Timewarp          bool              `json:"timewarp,omitempty"`
+	Ecomm360          bool              `json:"ecomm360,omitempty"`
+}
+
+//CampaignTracking turns open and click tracking on or off; nil fields keep Mailchimp's defaults
+type CampaignTracking struct {
+	Opens      *bool `json:"opens,omitempty"`
+	HTMLClicks *bool `json:"html_clicks,omitempty"`
+	TextClicks *bool `json:"text_clicks,omitempty"`
+}
+
+//CampaignCreateContent is the content of a campaign created by CampaignCreate
+type CampaignCreateContent struct {
+	HTML        string            `json:"html,omitempty"`
+	Sections    map[string]string `json:"sections,omitempty"`
+	Text        string            `json:"text,omitempty"`
+	URL         string            `json:"url,omitempty"`
+	Archive     string            `json:"archive,omitempty"`
+	ArchiveType string            `json:"archive_type,omitempty"`
+}
+
+//empty reports whether c has none of the kinds of content Mailchimp accepts
+func (c *CampaignCreateContent) empty() bool {
+	return c.HTML == "" && len(c.Sections) == 0 && c.Text == "" && c.URL == "" && c.Archive == ""
+}
+
+//SegmentOptions select the segment of a list that a campaign is sent to
+type SegmentOptions struct {
+	Match      string             `json:"match,omitempty"`
+	Conditions []SegmentCondition `json:"conditions,omitempty"`
+}
+
+//SegmentCondition is a single condition of SegmentOptions
+type SegmentCondition struct {
+	Field string      `json:"field,omitempty"`
+	Op    string      `json:"op,omitempty"`
+	Value interface{} `json:"value,omitempty"`
+}
+
+//ShareReportOptions are the options of CampaignShareReport
+type ShareReportOptions struct {
+	ToEmail string `json:"to_email,omitempty"`
+	ThemeID int    `json:"theme_id,omitempty"`
+	CSSURL  string `json:"css_url,omitempty"`
+}
+
+//CampaignsFilters are the filters of Campaigns; empty fields do not filter
+type CampaignsFilters struct {
+	CampaignID    string    `json:"campaign_id,omitempty"`
+	ParentID      string    `json:"parent_id,omitempty"`
+	ListID        string    `json:"list_id,omitempty"`
+	FolderID      int       `json:"folder_id,omitempty"`
+	TemplateID    int       `json:"template_id,omitempty"`
+	Status        string    `json:"status,omitempty"`
+	Type          string    `json:"type,omitempty"`
+	FromName      string    `json:"from_name,omitempty"`
+	FromEmail     string    `json:"from_email,omitempty"`
+	Title         string    `json:"title,omitempty"`
+	Subject       string    `json:"subject,omitempty"`
+	SendtimeStart ChimpTime `json:"sendtime_start,omitempty"`
+	SendtimeEnd   ChimpTime `json:"sendtime_end,omitempty"`
+	UsesSegment   *bool     `json:"uses_segment,omitempty"`
+	Exact         *bool     `json:"exact,omitempty"`
+}
+
+//EcommOrder is an order recorded by EcommOrderAdd and CampaignEcommOrderAdd
+type EcommOrder struct {
+	ID         string `json:"id,omitempty"`
+	CampaignID string `json:"campaign_id,omitempty"`
+	EmailID    string `json:"email_id,omitempty"`
+	Email      string `json:"email,omitempty"`
+	//Total is always sent, since an order may be free
+	Total     float64          `json:"total"`
+	OrderDate ChimpTime        `json:"order_date,omitempty"`
+	Shipping  float64          `json:"shipping,omitempty"`
+	Tax       float64          `json:"tax,omitempty"`
+	StoreID   string           `json:"store_id,omitempty"`
+	StoreName string           `json:"store_name,omitempty"`
+	PluginID  string           `json:"plugin_id,omitempty"`
+	Items     []EcommOrderItem `json:"items,omitempty"`
+}
+
+//EcommOrderItem is a line of an EcommOrder
+type EcommOrderItem struct {
+	LineNum      int     `json:"line_num,omitempty"`
+	ProductID    int     `json:"product_id,omitempty"`
+	SKU          string  `json:"sku,omitempty"`
+	ProductName  string  `json:"product_name,omitempty"`
+	CategoryID   int     `json:"category_id,omitempty"`
+	CategoryName string  `json:"category_name,omitempty"`
+	Qty          float64 `json:"qty,omitempty"`
+	Cost         float64 `json:"cost,omitempty"`
+}
+
+//CampaignsForEmailOptions are the options of CampaignsForEmail
+type CampaignsForEmailOptions struct {
+	ListID string `json:"list_id,omitempty"`
+}
+
+//The params structs are generated from routines.json with the routines. The
+//validate methods below check what the description of a routine can't, and
+//are called by the generated Validate methods once the required parameters
+//have been checked.
+
+//validate checks the options and content Mailchimp requires of a campaign
+func (p *CampaignCreateParams) validate() error {
+	switch {
+	case p.Options.ListID == "":
+		return ParamError{"campaignCreate", "options.list_id"}
+	case p.Options.Subject == "":
+		return ParamError{"campaignCreate", "options.subject"}
+	case p.Options.FromEmail == "":
+		return ParamError{"campaignCreate", "options.from_email"}
+	case p.Options.FromName == "":
+		return ParamError{"campaignCreate", "options.from_name"}
+	case p.Content.empty():
+		return ParamError{"campaignCreate", "content"}
+	}
+	return nil
+}
+
+//validate checks the fields Mailchimp requires of an order
+func (p *CampaignEcommOrderAddParams) validate() error {
+	switch {
+	case p.Order.ID == "":
+		return ParamError{"campaignEcommOrderAdd", "order.id"}
+	case p.Order.CampaignID == "":
+		return ParamError{"campaignEcommOrderAdd", "order.campaign_id"}
+	case p.Order.EmailID == "":
+		return ParamError{"campaignEcommOrderAdd", "order.email_id"}
+	case p.Order.StoreID == "":
+		return ParamError{"campaignEcommOrderAdd", "order.store_id"}
+	case len(p.Order.Items) == 0:
+		return ParamError{"campaignEcommOrderAdd", "order.items"}
+	}
+	return nil
+}
+
+//validate checks that the options have conditions to test
+func (p *CampaignSegmentTestParams) validate() error {
+	if len(p.Options.Conditions) == 0 {
+		return ParamError{"campaignSegmentTest", "options.conditions"}
+	}
+	return nil
+}
+
+//validate checks the fields Mailchimp requires of an order
+func (p *EcommOrderAddParams) validate() error {
+	switch {
+	case p.Order.ID == "":
+		return ParamError{"ecommOrderAdd", "order.id"}
+	case p.Order.StoreID == "":
+		return ParamError{"ecommOrderAdd", "order.store_id"}
+	case len(p.Order.Items) == 0:
+		return ParamError{"ecommOrderAdd", "order.items"}
+	}
+	return nil
+}
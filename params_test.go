@@ -0,0 +1,140 @@
+package mailchimp
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestParamsValidate(t *testing.T) {
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+	}))
+	defer server.Close()
+	api, err := NewClient("abc123-us1", WithBaseURL(server.URL+"/1.3/"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = api.CampaignCreateWithParams(context.Background(), &CampaignCreateParams{
+		Type:    "regular",
+		Options: CampaignOptions{ListID: "abc", Subject: "Go API test", FromEmail: "support@example.com"},
+	})
+	var paramErr ParamError
+	if !errors.As(err, &paramErr) || paramErr.Param != "options.from_name" || paramErr.Method != "campaignCreate" {
+		t.Error("CampaignCreateWithParams: expected a ParamError for options.from_name but got", err)
+	}
+	if !errors.Is(err, ErrValidation) {
+		t.Error("ParamError should match ErrValidation")
+	}
+	_, err = api.CampaignCreateWithParams(context.Background(), &CampaignCreateParams{
+		Type:    "regular",
+		Options: CampaignOptions{ListID: "abc", Subject: "Go API test", FromEmail: "support@example.com", FromName: "Support"},
+		Content: CampaignCreateContent{ArchiveType: "zip"},
+	})
+	if !errors.As(err, &paramErr) || paramErr.Param != "content" {
+		t.Error("CampaignCreateWithParams: expected a ParamError for content but got", err)
+	}
+	if _, err = api.EcommOrderDelWithParams(context.Background(), nil); !errors.As(err, &paramErr) || paramErr.Method != "ecommOrderDelete" {
+		t.Error("EcommOrderDelWithParams: expected a ParamError but got", err)
+	}
+	if _, err = api.CampaignScheduleWithParams(context.Background(), &CampaignScheduleParams{CID: "abc"}); !errors.As(err, &paramErr) || paramErr.Param != "schedule_time" {
+		t.Error("CampaignScheduleWithParams: expected a ParamError for schedule_time but got", err)
+	}
+	if requests != 0 {
+		t.Error("no request should be made for invalid parameters but got", requests)
+	}
+}
+
+func TestParamsRequest(t *testing.T) {
+	var sent map[string]interface{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := ioutil.ReadAll(r.Body)
+		json.Unmarshal(body, &sent)
+		w.Write([]byte(`{"add_count":1,"update_count":0,"error_count":0,"errors":[]}`))
+	}))
+	defer server.Close()
+	api, err := NewClient("abc123-us1", WithBaseURL(server.URL+"/1.3/"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	result, err := api.ListBatchSubscribeWithParams(context.Background(), &ListBatchSubscribeParams{
+		ID:          "abc",
+		Batch:       []map[string]interface{}{{"EMAIL": "jane@example.com", "EMAIL_TYPE": "html"}},
+		DoubleOptin: Bool(false),
+	})
+	if err != nil {
+		t.Fatal("ListBatchSubscribeWithParams:", err)
+	}
+	if result.AddCount != 1 {
+		t.Error("ListBatchSubscribeWithParams: expected an add_count of 1 but got", result.AddCount)
+	}
+	if sent["id"] != "abc" || sent["double_optin"] != false || sent["apikey"] != "abc123-us1" {
+		t.Error("unexpected parameters", sent)
+	}
+	for _, unset := range []string{"update_existing", "replace_interests"} {
+		if _, ok := sent[unset]; ok {
+			t.Error("unset parameter was sent:", unset)
+		}
+	}
+}
+
+func TestToParametersKeepsIntegers(t *testing.T) {
+	parameters, err := ToParameters(&FolderUpdateParams{FID: 1000000, Name: "Archive"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	b, _ := json.Marshal(parameters)
+	if string(b) != `{"fid":1000000,"name":"Archive"}` {
+		t.Error("unexpected parameters", string(b))
+	}
+}
+
+func TestToParametersTimes(t *testing.T) {
+	est := time.FixedZone("EST", -5*60*60)
+	parameters, err := ToParameters(&CampaignScheduleParams{
+		CID:          "abc",
+		ScheduleTime: ChimpTime{time.Date(2011, 3, 15, 9, 30, 0, 0, est)},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	b, _ := json.Marshal(parameters)
+	if string(b) != `{"cid":"abc","schedule_time":"2011-03-15 14:30:00"}` {
+		t.Error("expected the time in GMT and the unset time left out but got", string(b))
+	}
+	parameters, err = ToParameters(&CampaignsParams{Filters: &CampaignsFilters{
+		Status:        "sent",
+		SendtimeStart: ChimpTime{time.Date(2011, 3, 1, 0, 0, 0, 0, time.UTC)},
+	}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	b, _ = json.Marshal(parameters)
+	if string(b) != `{"filters":{"sendtime_start":"2011-03-01 00:00:00","status":"sent"}}` {
+		t.Error("expected the unset filter time left out but got", string(b))
+	}
+}
+
+func TestToParametersFreeOrder(t *testing.T) {
+	parameters, err := ToParameters(&EcommOrderAddParams{Order: EcommOrder{
+		ID:      "1001",
+		StoreID: "store1",
+		Items:   []EcommOrderItem{{ProductID: 7, ProductName: "Sample", CategoryID: 1, CategoryName: "Samples", Qty: 1}},
+	}})
+	if err != nil {
+		t.Fatal("EcommOrderAddParams: a free order should be valid but got", err)
+	}
+	order := parameters["order"].(map[string]interface{})
+	if total, ok := order["total"]; !ok || total != json.Number("0") {
+		t.Error("expected the zero total to be sent but got", order)
+	}
+	if _, ok := order["order_date"]; ok {
+		t.Error("expected the unset order date to be left out but got", order)
+	}
+}
@@ -0,0 +1,117 @@
+package mailchimp
+
+import (
+	"context"
+	"errors"
+	"math/rand"
+	"time"
+)
+
+//RetryPolicy controls how an API retries requests that fail with a network
+//error, a retryable ChimpError code or a retryable HTTP status. The zero
+//value never retries.
+//
+//Only routines that read data are retried unless Methods says otherwise,
+//since retrying a request that Mailchimp received but whose response was
+//lost could e.g. send a campaign twice.
+type RetryPolicy struct {
+	//MaxAttempts is the total number of attempts, including the first
+	MaxAttempts int
+	//BaseDelay is the longest wait before the first retry. It doubles with
+	//every further retry up to MaxDelay, and the actual wait is a random
+	//duration up to that limit. A MaxDelay of zero means no limit.
+	BaseDelay time.Duration
+	MaxDelay  time.Duration
+	//Codes are the ChimpError codes that are worth retrying
+	Codes []int
+	//Statuses are the HTTP status codes that are worth retrying
+	Statuses []int
+	//Methods overrides the default for individual routines, keyed by the
+	//Mailchimp routine name, e.g. "campaignSendNow": false never retries
+	//that routine and "listBatchSubscribe": true retries it
+	Methods map[string]bool
+}
+
+//DefaultRetryPolicy retries read routines up to twice on network errors, the
+//server-side ChimpError codes for timeouts, database errors and too many
+//connections, and on HTTP 429 and 5xx gateway statuses
+var DefaultRetryPolicy = RetryPolicy{
+	MaxAttempts: 3,
+	BaseDelay:   500 * time.Millisecond,
+	MaxDelay:    10 * time.Second,
+	Codes:       []int{-98, -91, -50},
+	Statuses:    []int{429, 500, 502, 503, 504},
+}
+
+//WithRetryPolicy retries failed requests according to policy
+func WithRetryPolicy(policy RetryPolicy) Option {
+	return func(a *API) error {
+		if policy.MaxAttempts < 0 || policy.BaseDelay < 0 || policy.MaxDelay < 0 {
+			return errors.New("mailchimp: negative value in RetryPolicy")
+		}
+		a.retry = policy
+		return nil
+	}
+}
+
+//readMethods are the routines that only read data and are therefore safe to
+//retry by default. The routines generated from routines.json add themselves
+//in an init function, leaving Ping, which is written by hand.
+var readMethods = map[string]bool{
+	"ping": true,
+}
+
+//retryable reports whether a request for method that failed with err and
+//HTTP status should be attempted again
+func (p RetryPolicy) retryable(ctx context.Context, method string, status int, err error) bool {
+	if ctx.Err() != nil {
+		return false
+	}
+	if retry, ok := p.Methods[method]; ok && !retry {
+		return false
+	} else if !ok && !readMethods[method] {
+		return false
+	}
+	var chimpErr ChimpError
+	if errors.As(err, &chimpErr) {
+		return containsInt(p.Codes, chimpErr.Code)
+	}
+	if status != 0 {
+		return containsInt(p.Statuses, status)
+	}
+	//no response was received
+	return true
+}
+
+//wait sleeps before the retry that follows the given attempt, returning a
+//TimeoutError if ctx is done first
+func (p RetryPolicy) wait(ctx context.Context, method string, attempt int) error {
+	limit := p.BaseDelay
+	for i := 1; i < attempt && limit > 0 && (p.MaxDelay == 0 || limit < p.MaxDelay); i++ {
+		limit *= 2
+	}
+	if p.MaxDelay > 0 && limit > p.MaxDelay {
+		limit = p.MaxDelay
+	}
+	var delay time.Duration
+	if limit > 0 {
+		delay = time.Duration(rand.Int63n(int64(limit) + 1))
+	}
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return TimeoutError{method, ctx.Err()}
+	case <-timer.C:
+		return nil
+	}
+}
+
+func containsInt(values []int, value int) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,133 @@
+package mailchimp
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+//flakyServer fails the first failures requests with the given response
+//and answers 1 afterwards, which parses as both an int and a bool
+func flakyServer(failures int32, status int, body string) (*httptest.Server, *int32) {
+	requests := new(int32)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if atomic.AddInt32(requests, 1) <= failures {
+			w.WriteHeader(status)
+			w.Write([]byte(body))
+			return
+		}
+		w.Write([]byte(`1`))
+	}))
+	return server, requests
+}
+
+func retryAPI(t *testing.T, server *httptest.Server, policy RetryPolicy) *API {
+	api, err := NewClient("abc123-us1", WithBaseURL(server.URL+"/1.3/"), WithRetryPolicy(policy))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return api
+}
+
+var fastRetries = RetryPolicy{
+	MaxAttempts: 3,
+	BaseDelay:   time.Millisecond,
+	MaxDelay:    5 * time.Millisecond,
+	Codes:       []int{-50},
+	Statuses:    []int{503},
+}
+
+func TestRetryReadMethod(t *testing.T) {
+	server, requests := flakyServer(2, http.StatusServiceUnavailable, "busy")
+	defer server.Close()
+	result, err := retryAPI(t, server, fastRetries).CampaignSegmentTest(nil)
+	if err != nil {
+		t.Fatal("CampaignSegmentTest:", err)
+	}
+	if *requests != 3 || result != 1 {
+		t.Errorf("expected 3 requests and a result of 1 but got %d and %d", *requests, result)
+	}
+}
+
+func TestRetryChimpErrorCode(t *testing.T) {
+	server, requests := flakyServer(1, http.StatusOK, `{"error":"Too many connections","code":-50}`)
+	defer server.Close()
+	if _, err := retryAPI(t, server, fastRetries).CampaignSegmentTest(nil); err != nil {
+		t.Error("CampaignSegmentTest:", err)
+	}
+	if *requests != 2 {
+		t.Error("expected 2 requests but got", *requests)
+	}
+}
+
+func TestRetryGivesUp(t *testing.T) {
+	server, requests := flakyServer(5, http.StatusServiceUnavailable, "busy")
+	defer server.Close()
+	if _, err := retryAPI(t, server, fastRetries).Campaigns(nil); err == nil {
+		t.Error("Campaigns: expected an error after the last attempt")
+	}
+	if *requests != 3 {
+		t.Error("expected 3 requests but got", *requests)
+	}
+}
+
+func TestNoRetryForMutatingMethods(t *testing.T) {
+	server, requests := flakyServer(1, http.StatusServiceUnavailable, "busy")
+	defer server.Close()
+	if _, err := retryAPI(t, server, fastRetries).CampaignSendNow(map[string]interface{}{"cid": "abc"}); err == nil {
+		t.Error("CampaignSendNow: expected an error")
+	}
+	if *requests != 1 {
+		t.Error("expected 1 request but got", *requests)
+	}
+}
+
+func TestRetryMethodsOverride(t *testing.T) {
+	policy := fastRetries
+	policy.Methods = map[string]bool{"campaignDelete": true, "campaigns": false}
+	server, requests := flakyServer(2, http.StatusServiceUnavailable, "busy")
+	defer server.Close()
+	api := retryAPI(t, server, policy)
+	if _, err := api.Campaigns(nil); err == nil {
+		t.Error("Campaigns: expected an error")
+	}
+	if _, err := api.CampaignDelete(nil); err != nil {
+		t.Error("CampaignDelete:", err)
+	}
+	if *requests != 3 {
+		t.Error("expected 3 requests but got", *requests)
+	}
+}
+
+func TestRetryNonRetryableCode(t *testing.T) {
+	server, requests := flakyServer(1, http.StatusOK, `{"error":"Invalid Mailchimp List ID","code":200}`)
+	defer server.Close()
+	if _, err := retryAPI(t, server, fastRetries).ListActivity(nil); err == nil {
+		t.Error("ListActivity: expected an error")
+	}
+	if *requests != 1 {
+		t.Error("expected 1 request but got", *requests)
+	}
+}
+
+func TestRetryWaitCanceled(t *testing.T) {
+	server, _ := flakyServer(5, http.StatusServiceUnavailable, "busy")
+	defer server.Close()
+	policy := fastRetries
+	policy.BaseDelay, policy.MaxDelay = time.Hour, time.Hour
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	start := time.Now()
+	_, err := retryAPI(t, server, policy).CampaignsContext(ctx, nil)
+	var timeout TimeoutError
+	if !errors.As(err, &timeout) {
+		t.Error("CampaignsContext: expected a TimeoutError but got", err)
+	}
+	if time.Since(start) > time.Second {
+		t.Error("CampaignsContext: backoff did not stop when the context was done")
+	}
+}
@@ -0,0 +1,4068 @@
+// Code generated by mailchimpgen; DO NOT EDIT.
+
+package mailchimp
+
+import "context"
+
+type CampaignAbuseReportsResultDataItem struct {
+	Date  ChimpTime `json:"date"`
+	Email string    `json:"email"`
+	Type  string    `json:"type"`
+}
+
+type CampaignAbuseReportsResult struct {
+	Total FlexInt                              `json:"total"`
+	Data  []CampaignAbuseReportsResultDataItem `json:"data"`
+}
+
+//CampaignAbuseReports gets the email addresses that complained about a campaign
+//http://apidocs.mailchimp.com/api/1.3/campaignabusereports.func.php
+func (a *API) CampaignAbuseReports(parameters map[string]interface{}) (*CampaignAbuseReportsResult, error) {
+	return a.CampaignAbuseReportsContext(context.Background(), parameters)
+}
+
+func (a *API) CampaignAbuseReportsContext(ctx context.Context, parameters map[string]interface{}) (retVal *CampaignAbuseReportsResult, err error) {
+	retVal = new(CampaignAbuseReportsResult)
+	err = parseJson(ctx, a, "campaignAbuseReports", timeParameters(parameters, "since"), retVal)
+	return
+}
+
+//CampaignAbuseReportsParams are the parameters of CampaignAbuseReports
+//http://apidocs.mailchimp.com/api/1.3/campaignabusereports.func.php
+type CampaignAbuseReportsParams struct {
+	CID   string    `json:"cid,omitempty"`
+	Since ChimpTime `json:"since,omitempty"`
+	Start int       `json:"start,omitempty"`
+	Limit int       `json:"limit,omitempty"`
+}
+
+func (p *CampaignAbuseReportsParams) Validate() error {
+	switch {
+	case p.CID == "":
+		return ParamError{"campaignAbuseReports", "cid"}
+	}
+	return nil
+}
+
+func (a *API) CampaignAbuseReportsWithParams(ctx context.Context, params *CampaignAbuseReportsParams) (*CampaignAbuseReportsResult, error) {
+	parameters, err := ToParameters(params)
+	if err != nil {
+		return nil, err
+	}
+	return a.CampaignAbuseReportsContext(ctx, parameters)
+}
+
+//CampaignAbuseReportsPager walks every page of CampaignAbuseReports
+func (a *API) CampaignAbuseReportsPager(ctx context.Context, parameters map[string]interface{}) *Pager[CampaignAbuseReportsResultDataItem] {
+	return newPager(ctx, "campaignAbuseReports", parameters, fetchPage[CampaignAbuseReportsResultDataItem](a, "campaignAbuseReports"))
+}
+
+type CampaignAdviceResultItem struct {
+	Msg  string `json:"msg"`
+	Type string `json:"type"`
+}
+
+//CampaignAdvice gets the advice on how a campaign performed that is shown in
+//the overview of its report
+//http://apidocs.mailchimp.com/api/1.3/campaignadvice.func.php
+func (a *API) CampaignAdvice(parameters map[string]interface{}) ([]CampaignAdviceResultItem, error) {
+	return a.CampaignAdviceContext(context.Background(), parameters)
+}
+
+func (a *API) CampaignAdviceContext(ctx context.Context, parameters map[string]interface{}) (retVal []CampaignAdviceResultItem, err error) {
+	err = parseJson(ctx, a, "campaignAdvice", parameters, &retVal)
+	return
+}
+
+//CampaignAdviceParams are the parameters of CampaignAdvice
+//http://apidocs.mailchimp.com/api/1.3/campaignadvice.func.php
+type CampaignAdviceParams struct {
+	CID string `json:"cid,omitempty"`
+}
+
+func (p *CampaignAdviceParams) Validate() error {
+	switch {
+	case p.CID == "":
+		return ParamError{"campaignAdvice", "cid"}
+	}
+	return nil
+}
+
+func (a *API) CampaignAdviceWithParams(ctx context.Context, params *CampaignAdviceParams) ([]CampaignAdviceResultItem, error) {
+	parameters, err := ToParameters(params)
+	if err != nil {
+		return nil, err
+	}
+	return a.CampaignAdviceContext(ctx, parameters)
+}
+
+type CampaignAnalyticsResultGoals struct {
+	Name        string  `json:"name"`
+	Conversions FlexInt `json:"conversions"`
+}
+
+type CampaignAnalyticsResult struct {
+	Visits           FlexInt                      `json:"visits"`
+	Pages            FlexInt                      `json:"pages"`
+	NewVisits        FlexInt                      `json:"new_visits"`
+	Bounces          FlexInt                      `json:"bounces"`
+	TimeOnSite       FlexFloat                    `json:"time_on_site"`
+	GoalConversions  FlexInt                      `json:"goal_conversions"`
+	GoalValue        FlexFloat                    `json:"goal_value"`
+	Revenue          FlexFloat                    `json:"revenue"`
+	Transactions     FlexInt                      `json:"transactions"`
+	EcommConversions FlexInt                      `json:"ecomm_conversions"`
+	Goals            CampaignAnalyticsResultGoals `json:"goals"`
+}
+
+//CampaignAnalytics gets the Google Analytics data collected for a campaign,
+//once it has been sent and tracked with Analytics
+//http://apidocs.mailchimp.com/api/1.3/campaignanalytics.func.php
+func (a *API) CampaignAnalytics(parameters map[string]interface{}) (*CampaignAnalyticsResult, error) {
+	return a.CampaignAnalyticsContext(context.Background(), parameters)
+}
+
+func (a *API) CampaignAnalyticsContext(ctx context.Context, parameters map[string]interface{}) (retVal *CampaignAnalyticsResult, err error) {
+	retVal = new(CampaignAnalyticsResult)
+	err = parseJson(ctx, a, "campaignAnalytics", parameters, retVal)
+	return
+}
+
+//CampaignAnalyticsParams are the parameters of CampaignAnalytics
+//http://apidocs.mailchimp.com/api/1.3/campaignanalytics.func.php
+type CampaignAnalyticsParams struct {
+	CID string `json:"cid,omitempty"`
+}
+
+func (p *CampaignAnalyticsParams) Validate() error {
+	switch {
+	case p.CID == "":
+		return ParamError{"campaignAnalytics", "cid"}
+	}
+	return nil
+}
+
+func (a *API) CampaignAnalyticsWithParams(ctx context.Context, params *CampaignAnalyticsParams) (*CampaignAnalyticsResult, error) {
+	parameters, err := ToParameters(params)
+	if err != nil {
+		return nil, err
+	}
+	return a.CampaignAnalyticsContext(ctx, parameters)
+}
+
+type CampaignBounceMessageResult struct {
+	Date    ChimpTime `json:"date"`
+	Email   string    `json:"email"`
+	Message string    `json:"message"`
+}
+
+//CampaignBounceMessage gets the full bounce message of one email address a
+//campaign was sent to
+//http://apidocs.mailchimp.com/api/1.3/campaignbouncemessage.func.php
+func (a *API) CampaignBounceMessage(parameters map[string]interface{}) (*CampaignBounceMessageResult, error) {
+	return a.CampaignBounceMessageContext(context.Background(), parameters)
+}
+
+func (a *API) CampaignBounceMessageContext(ctx context.Context, parameters map[string]interface{}) (retVal *CampaignBounceMessageResult, err error) {
+	retVal = new(CampaignBounceMessageResult)
+	err = parseJson(ctx, a, "campaignBounceMessage", parameters, retVal)
+	return
+}
+
+//CampaignBounceMessageParams are the parameters of CampaignBounceMessage
+//http://apidocs.mailchimp.com/api/1.3/campaignbouncemessage.func.php
+type CampaignBounceMessageParams struct {
+	CID   string `json:"cid,omitempty"`
+	Email string `json:"email,omitempty"`
+}
+
+func (p *CampaignBounceMessageParams) Validate() error {
+	switch {
+	case p.CID == "":
+		return ParamError{"campaignBounceMessage", "cid"}
+	case p.Email == "":
+		return ParamError{"campaignBounceMessage", "email"}
+	}
+	return nil
+}
+
+func (a *API) CampaignBounceMessageWithParams(ctx context.Context, params *CampaignBounceMessageParams) (*CampaignBounceMessageResult, error) {
+	parameters, err := ToParameters(params)
+	if err != nil {
+		return nil, err
+	}
+	return a.CampaignBounceMessageContext(ctx, parameters)
+}
+
+type CampaignBounceMessagesResult struct {
+	Total FlexInt                       `json:"total"`
+	Data  []CampaignBounceMessageResult `json:"data"`
+}
+
+//CampaignBounceMessages gets the full bounce messages of a campaign
+//http://apidocs.mailchimp.com/api/1.3/campaignbouncemessages.func.php
+func (a *API) CampaignBounceMessages(parameters map[string]interface{}) (*CampaignBounceMessagesResult, error) {
+	return a.CampaignBounceMessagesContext(context.Background(), parameters)
+}
+
+func (a *API) CampaignBounceMessagesContext(ctx context.Context, parameters map[string]interface{}) (retVal *CampaignBounceMessagesResult, err error) {
+	retVal = new(CampaignBounceMessagesResult)
+	err = parseJson(ctx, a, "campaignBounceMessages", timeParameters(parameters, "since"), retVal)
+	return
+}
+
+//CampaignBounceMessagesParams are the parameters of CampaignBounceMessages
+//http://apidocs.mailchimp.com/api/1.3/campaignbouncemessages.func.php
+type CampaignBounceMessagesParams struct {
+	CID   string    `json:"cid,omitempty"`
+	Since ChimpTime `json:"since,omitempty"`
+	Start int       `json:"start,omitempty"`
+	Limit int       `json:"limit,omitempty"`
+}
+
+func (p *CampaignBounceMessagesParams) Validate() error {
+	switch {
+	case p.CID == "":
+		return ParamError{"campaignBounceMessages", "cid"}
+	}
+	return nil
+}
+
+func (a *API) CampaignBounceMessagesWithParams(ctx context.Context, params *CampaignBounceMessagesParams) (*CampaignBounceMessagesResult, error) {
+	parameters, err := ToParameters(params)
+	if err != nil {
+		return nil, err
+	}
+	return a.CampaignBounceMessagesContext(ctx, parameters)
+}
+
+//CampaignBounceMessagesPager walks every page of CampaignBounceMessages
+func (a *API) CampaignBounceMessagesPager(ctx context.Context, parameters map[string]interface{}) *Pager[CampaignBounceMessageResult] {
+	return newPager(ctx, "campaignBounceMessages", parameters, fetchPage[CampaignBounceMessageResult](a, "campaignBounceMessages"))
+}
+
+type CampaignClickDetailAIMResultDataItem struct {
+	Email  string  `json:"email"`
+	Clicks FlexInt `json:"clicks"`
+}
+
+type CampaignClickDetailAIMResult struct {
+	Total FlexInt                                `json:"total"`
+	Data  []CampaignClickDetailAIMResultDataItem `json:"data"`
+}
+
+//CampaignClickDetailAIM gets the members who clicked a url of a campaign, and
+//how often each did
+//http://apidocs.mailchimp.com/api/1.3/campaignclickdetailaim.func.php
+func (a *API) CampaignClickDetailAIM(parameters map[string]interface{}) (*CampaignClickDetailAIMResult, error) {
+	return a.CampaignClickDetailAIMContext(context.Background(), parameters)
+}
+
+func (a *API) CampaignClickDetailAIMContext(ctx context.Context, parameters map[string]interface{}) (retVal *CampaignClickDetailAIMResult, err error) {
+	retVal = new(CampaignClickDetailAIMResult)
+	err = parseJson(ctx, a, "campaignClickDetailAIM", parameters, retVal)
+	return
+}
+
+//CampaignClickDetailAIMParams are the parameters of CampaignClickDetailAIM
+//http://apidocs.mailchimp.com/api/1.3/campaignclickdetailaim.func.php
+type CampaignClickDetailAIMParams struct {
+	CID   string `json:"cid,omitempty"`
+	URL   string `json:"url,omitempty"`
+	Start int    `json:"start,omitempty"`
+	Limit int    `json:"limit,omitempty"`
+}
+
+func (p *CampaignClickDetailAIMParams) Validate() error {
+	switch {
+	case p.CID == "":
+		return ParamError{"campaignClickDetailAIM", "cid"}
+	case p.URL == "":
+		return ParamError{"campaignClickDetailAIM", "url"}
+	}
+	return nil
+}
+
+func (a *API) CampaignClickDetailAIMWithParams(ctx context.Context, params *CampaignClickDetailAIMParams) (*CampaignClickDetailAIMResult, error) {
+	parameters, err := ToParameters(params)
+	if err != nil {
+		return nil, err
+	}
+	return a.CampaignClickDetailAIMContext(ctx, parameters)
+}
+
+//CampaignClickDetailAIMPager walks every page of CampaignClickDetailAIM
+func (a *API) CampaignClickDetailAIMPager(ctx context.Context, parameters map[string]interface{}) *Pager[CampaignClickDetailAIMResultDataItem] {
+	return newPager(ctx, "campaignClickDetailAIM", parameters, fetchPage[CampaignClickDetailAIMResultDataItem](a, "campaignClickDetailAIM"))
+}
+
+type CampaignClickStatsResultItem struct {
+	Clicks FlexInt `json:"clicks"`
+	Unique FlexInt `json:"unique"`
+}
+
+//CampaignClickStats gets the clicks on each url of a campaign, keyed by the url
+//http://apidocs.mailchimp.com/api/1.3/campaignclickstats.func.php
+func (a *API) CampaignClickStats(parameters map[string]interface{}) (map[string]CampaignClickStatsResultItem, error) {
+	return a.CampaignClickStatsContext(context.Background(), parameters)
+}
+
+func (a *API) CampaignClickStatsContext(ctx context.Context, parameters map[string]interface{}) (retVal map[string]CampaignClickStatsResultItem, err error) {
+	err = parseJson(ctx, a, "campaignClickStats", parameters, &retVal)
+	return
+}
+
+//CampaignClickStatsParams are the parameters of CampaignClickStats
+//http://apidocs.mailchimp.com/api/1.3/campaignclickstats.func.php
+type CampaignClickStatsParams struct {
+	CID string `json:"cid,omitempty"`
+}
+
+func (p *CampaignClickStatsParams) Validate() error {
+	switch {
+	case p.CID == "":
+		return ParamError{"campaignClickStats", "cid"}
+	}
+	return nil
+}
+
+func (a *API) CampaignClickStatsWithParams(ctx context.Context, params *CampaignClickStatsParams) (map[string]CampaignClickStatsResultItem, error) {
+	parameters, err := ToParameters(params)
+	if err != nil {
+		return nil, err
+	}
+	return a.CampaignClickStatsContext(ctx, parameters)
+}
+
+type CampaignContentResult struct {
+	HTML string `json:"html"`
+	Text string `json:"text"`
+}
+
+//CampaignContent gets the html and text content of a campaign as it was or will
+//be sent
+//http://apidocs.mailchimp.com/api/1.3/campaigncontent.func.php
+func (a *API) CampaignContent(parameters map[string]interface{}) (*CampaignContentResult, error) {
+	return a.CampaignContentContext(context.Background(), parameters)
+}
+
+func (a *API) CampaignContentContext(ctx context.Context, parameters map[string]interface{}) (retVal *CampaignContentResult, err error) {
+	retVal = new(CampaignContentResult)
+	err = parseJson(ctx, a, "campaignContent", parameters, retVal)
+	return
+}
+
+//CampaignContentParams are the parameters of CampaignContent
+//http://apidocs.mailchimp.com/api/1.3/campaigncontent.func.php
+type CampaignContentParams struct {
+	CID        string `json:"cid,omitempty"`
+	ForArchive bool   `json:"for_archive,omitempty"`
+}
+
+func (p *CampaignContentParams) Validate() error {
+	switch {
+	case p.CID == "":
+		return ParamError{"campaignContent", "cid"}
+	}
+	return nil
+}
+
+func (a *API) CampaignContentWithParams(ctx context.Context, params *CampaignContentParams) (*CampaignContentResult, error) {
+	parameters, err := ToParameters(params)
+	if err != nil {
+		return nil, err
+	}
+	return a.CampaignContentContext(ctx, parameters)
+}
+
+//CampaignCreate creates a campaign, returning its id
+//http://apidocs.mailchimp.com/api/1.3/campaigncreate.func.php
+func (a *API) CampaignCreate(parameters map[string]interface{}) (string, error) {
+	return a.CampaignCreateContext(context.Background(), parameters)
+}
+
+func (a *API) CampaignCreateContext(ctx context.Context, parameters map[string]interface{}) (string, error) {
+	return parseString(ctx, a, "campaignCreate", parameters)
+}
+
+//CampaignCreateParams are the parameters of CampaignCreate
+//http://apidocs.mailchimp.com/api/1.3/campaigncreate.func.php
+type CampaignCreateParams struct {
+	Type        string                 `json:"type,omitempty"`
+	Options     CampaignOptions        `json:"options,omitempty"`
+	Content     CampaignCreateContent  `json:"content,omitempty"`
+	SegmentOpts *SegmentOptions        `json:"segment_opts,omitempty"`
+	TypeOpts    map[string]interface{} `json:"type_opts,omitempty"`
+}
+
+func (p *CampaignCreateParams) Validate() error {
+	switch {
+	case p.Type == "":
+		return ParamError{"campaignCreate", "type"}
+	}
+	return p.validate()
+}
+
+func (a *API) CampaignCreateWithParams(ctx context.Context, params *CampaignCreateParams) (string, error) {
+	parameters, err := ToParameters(params)
+	if err != nil {
+		return "", err
+	}
+	return a.CampaignCreateContext(ctx, parameters)
+}
+
+//CampaignDelete deletes a campaign, which cannot be undone
+//http://apidocs.mailchimp.com/api/1.3/campaigndelete.func.php
+func (a *API) CampaignDelete(parameters map[string]interface{}) (bool, error) {
+	return a.CampaignDeleteContext(context.Background(), parameters)
+}
+
+func (a *API) CampaignDeleteContext(ctx context.Context, parameters map[string]interface{}) (bool, error) {
+	return parseBoolean(ctx, a, "campaignDelete", parameters)
+}
+
+//CampaignDeleteParams are the parameters of CampaignDelete
+//http://apidocs.mailchimp.com/api/1.3/campaigndelete.func.php
+type CampaignDeleteParams struct {
+	CID string `json:"cid,omitempty"`
+}
+
+func (p *CampaignDeleteParams) Validate() error {
+	switch {
+	case p.CID == "":
+		return ParamError{"campaignDelete", "cid"}
+	}
+	return nil
+}
+
+func (a *API) CampaignDeleteWithParams(ctx context.Context, params *CampaignDeleteParams) (bool, error) {
+	parameters, err := ToParameters(params)
+	if err != nil {
+		return false, err
+	}
+	return a.CampaignDeleteContext(ctx, parameters)
+}
+
+//CampaignEcommOrderAdd records an Ecommerce360 order for the campaign that led
+//to it
+//http://apidocs.mailchimp.com/api/1.3/campaignecommorderadd.func.php
+func (a *API) CampaignEcommOrderAdd(parameters map[string]interface{}) (bool, error) {
+	return a.CampaignEcommOrderAddContext(context.Background(), parameters)
+}
+
+func (a *API) CampaignEcommOrderAddContext(ctx context.Context, parameters map[string]interface{}) (bool, error) {
+	return parseBoolean(ctx, a, "campaignEcommOrderAdd", parameters)
+}
+
+//CampaignEcommOrderAddParams are the parameters of CampaignEcommOrderAdd
+//http://apidocs.mailchimp.com/api/1.3/campaignecommorderadd.func.php
+type CampaignEcommOrderAddParams struct {
+	Order EcommOrder `json:"order,omitempty"`
+}
+
+func (p *CampaignEcommOrderAddParams) Validate() error {
+	return p.validate()
+}
+
+func (a *API) CampaignEcommOrderAddWithParams(ctx context.Context, params *CampaignEcommOrderAddParams) (bool, error) {
+	parameters, err := ToParameters(params)
+	if err != nil {
+		return false, err
+	}
+	return a.CampaignEcommOrderAddContext(ctx, parameters)
+}
+
+type CampaignEcommOrdersResultDataItemLinesItem struct {
+	LineNum             FlexInt   `json:"line_num"`
+	ProductID           FlexInt   `json:"product_id"`
+	ProductName         string    `json:"product_name"`
+	ProductSKU          string    `json:"product_sku"`
+	ProductCategoryID   FlexInt   `json:"product_category_id"`
+	ProductCategoryName string    `json:"product_category_name"`
+	Qty                 FlexInt   `json:"qty"`
+	Cost                FlexFloat `json:"cost"`
+}
+
+type CampaignEcommOrdersResultDataItem struct {
+	StoreID    string                                       `json:"store_id"`
+	StoreName  string                                       `json:"store_name"`
+	OrderID    string                                       `json:"order_id"`
+	Email      string                                       `json:"email"`
+	OrderTotal FlexFloat                                    `json:"order_total"`
+	TaxTotal   FlexFloat                                    `json:"tax_total"`
+	ShipTotal  FlexFloat                                    `json:"ship_total"`
+	OrderDate  ChimpTime                                    `json:"order_date"`
+	Lines      []CampaignEcommOrdersResultDataItemLinesItem `json:"lines"`
+}
+
+type CampaignEcommOrdersResult struct {
+	Total FlexInt                             `json:"total"`
+	Data  []CampaignEcommOrdersResultDataItem `json:"data"`
+}
+
+//CampaignEcommOrders gets the Ecommerce360 orders recorded for a campaign
+//http://apidocs.mailchimp.com/api/1.3/campaignecommorders.func.php
+func (a *API) CampaignEcommOrders(parameters map[string]interface{}) (*CampaignEcommOrdersResult, error) {
+	return a.CampaignEcommOrdersContext(context.Background(), parameters)
+}
+
+func (a *API) CampaignEcommOrdersContext(ctx context.Context, parameters map[string]interface{}) (retVal *CampaignEcommOrdersResult, err error) {
+	retVal = new(CampaignEcommOrdersResult)
+	err = parseJson(ctx, a, "campaignEcommOrders", timeParameters(parameters, "since"), retVal)
+	return
+}
+
+//CampaignEcommOrdersParams are the parameters of CampaignEcommOrders
+//http://apidocs.mailchimp.com/api/1.3/campaignecommorders.func.php
+type CampaignEcommOrdersParams struct {
+	CID   string    `json:"cid,omitempty"`
+	Since ChimpTime `json:"since,omitempty"`
+	Start int       `json:"start,omitempty"`
+	Limit int       `json:"limit,omitempty"`
+}
+
+func (p *CampaignEcommOrdersParams) Validate() error {
+	switch {
+	case p.CID == "":
+		return ParamError{"campaignEcommOrders", "cid"}
+	}
+	return nil
+}
+
+func (a *API) CampaignEcommOrdersWithParams(ctx context.Context, params *CampaignEcommOrdersParams) (*CampaignEcommOrdersResult, error) {
+	parameters, err := ToParameters(params)
+	if err != nil {
+		return nil, err
+	}
+	return a.CampaignEcommOrdersContext(ctx, parameters)
+}
+
+//CampaignEcommOrdersPager walks every page of CampaignEcommOrders
+func (a *API) CampaignEcommOrdersPager(ctx context.Context, parameters map[string]interface{}) *Pager[CampaignEcommOrdersResultDataItem] {
+	return newPager(ctx, "campaignEcommOrders", parameters, fetchPage[CampaignEcommOrdersResultDataItem](a, "campaignEcommOrders"))
+}
+
+type CampaignEepUrlStatsResultTwitterStatusesItem struct {
+	Status     string    `json:"status"`
+	ScreenName string    `json:"screen_name"`
+	StatusID   string    `json:"status_id"`
+	Datetime   ChimpTime `json:"datetime"`
+	IsRetweet  FlexBool  `json:"is_retweet"`
+}
+
+type CampaignEepUrlStatsResultTwitter struct {
+	Tweets       FlexInt                                        `json:"tweets"`
+	FirstTweet   ChimpTime                                      `json:"first_tweet"`
+	LastTweet    ChimpTime                                      `json:"last_tweet"`
+	Retweets     FlexInt                                        `json:"retweets"`
+	FirstRetweet ChimpTime                                      `json:"first_retweet"`
+	LastRetweet  ChimpTime                                      `json:"last_retweet"`
+	Statuses     []CampaignEepUrlStatsResultTwitterStatusesItem `json:"statuses"`
+}
+
+type CampaignEepUrlStatsResultClicksLocationsItem struct {
+	Country string  `json:"country"`
+	Region  string  `json:"region"`
+	Total   FlexInt `json:"total"`
+}
+
+type CampaignEepUrlStatsResultClicks struct {
+	Clicks     FlexInt                                        `json:"clicks"`
+	FirstClick ChimpTime                                      `json:"first_click"`
+	LastClick  ChimpTime                                      `json:"last_click"`
+	Locations  []CampaignEepUrlStatsResultClicksLocationsItem `json:"locations"`
+}
+
+type CampaignEepUrlStatsResultReferrersItem struct {
+	Referrer   string    `json:"referrer"`
+	Clicks     FlexInt   `json:"clicks"`
+	FirstClick ChimpTime `json:"first_click"`
+	LastClick  ChimpTime `json:"last_click"`
+}
+
+type CampaignEepUrlStatsResult struct {
+	Twitter   CampaignEepUrlStatsResultTwitter         `json:"twitter"`
+	Clicks    CampaignEepUrlStatsResultClicks          `json:"clicks"`
+	Referrers []CampaignEepUrlStatsResultReferrersItem `json:"referrers"`
+}
+
+//CampaignEepUrlStats gets the mentions of a campaign's eepurl on Twitter, the
+//clicks on it and the sites that referred them
+//http://apidocs.mailchimp.com/api/1.3/campaigneepurlstats.func.php
+func (a *API) CampaignEepUrlStats(parameters map[string]interface{}) (*CampaignEepUrlStatsResult, error) {
+	return a.CampaignEepUrlStatsContext(context.Background(), parameters)
+}
+
+func (a *API) CampaignEepUrlStatsContext(ctx context.Context, parameters map[string]interface{}) (retVal *CampaignEepUrlStatsResult, err error) {
+	retVal = new(CampaignEepUrlStatsResult)
+	err = parseJson(ctx, a, "campaignEepUrlStats", parameters, retVal)
+	return
+}
+
+//CampaignEepUrlStatsParams are the parameters of CampaignEepUrlStats
+//http://apidocs.mailchimp.com/api/1.3/campaigneepurlstats.func.php
+type CampaignEepUrlStatsParams struct {
+	CID string `json:"cid,omitempty"`
+}
+
+func (p *CampaignEepUrlStatsParams) Validate() error {
+	switch {
+	case p.CID == "":
+		return ParamError{"campaignEepUrlStats", "cid"}
+	}
+	return nil
+}
+
+func (a *API) CampaignEepUrlStatsWithParams(ctx context.Context, params *CampaignEepUrlStatsParams) (*CampaignEepUrlStatsResult, error) {
+	parameters, err := ToParameters(params)
+	if err != nil {
+		return nil, err
+	}
+	return a.CampaignEepUrlStatsContext(ctx, parameters)
+}
+
+type CampaignEmailDomainPerformanceResultItem struct {
+	Domain    string  `json:"domain"`
+	TotalSent FlexInt `json:"total_sent"`
+	Email     FlexInt `json:"email"`
+	Bounces   FlexInt `json:"bounces"`
+	Opens     FlexInt `json:"opens"`
+	Clicks    FlexInt `json:"clicks"`
+	Unsubs    FlexInt `json:"unsubs"`
+	Delivered FlexInt `json:"delivered"`
+	EmailsPct FlexInt `json:"emails_pct"`
+	OpensPct  FlexInt `json:"opens_pct"`
+	ClicksPct FlexInt `json:"clicks_pct"`
+	UnsubsPct FlexInt `json:"unsubs_pct"`
+}
+
+//CampaignEmailDomainPerformance gets the statistics of a campaign for each
+//domain of the email addresses it was sent to
+//http://apidocs.mailchimp.com/api/1.3/campaignemaildomainperformance.func.php
+func (a *API) CampaignEmailDomainPerformance(parameters map[string]interface{}) ([]CampaignEmailDomainPerformanceResultItem, error) {
+	return a.CampaignEmailDomainPerformanceContext(context.Background(), parameters)
+}
+
+func (a *API) CampaignEmailDomainPerformanceContext(ctx context.Context, parameters map[string]interface{}) (retVal []CampaignEmailDomainPerformanceResultItem, err error) {
+	err = parseJson(ctx, a, "campaignEmailDomainPerformance", parameters, &retVal)
+	return
+}
+
+//CampaignEmailDomainPerformanceParams are the parameters of CampaignEmailDomainPerformance
+//http://apidocs.mailchimp.com/api/1.3/campaignemaildomainperformance.func.php
+type CampaignEmailDomainPerformanceParams struct {
+	CID string `json:"cid,omitempty"`
+}
+
+func (p *CampaignEmailDomainPerformanceParams) Validate() error {
+	switch {
+	case p.CID == "":
+		return ParamError{"campaignEmailDomainPerformance", "cid"}
+	}
+	return nil
+}
+
+func (a *API) CampaignEmailDomainPerformanceWithParams(ctx context.Context, params *CampaignEmailDomainPerformanceParams) ([]CampaignEmailDomainPerformanceResultItem, error) {
+	parameters, err := ToParameters(params)
+	if err != nil {
+		return nil, err
+	}
+	return a.CampaignEmailDomainPerformanceContext(ctx, parameters)
+}
+
+type CampaignEmailStatsAIMResultDataItem struct {
+	Action    string    `json:"action"`
+	Timestamp ChimpTime `json:"timestamp"`
+	URL       string    `json:"url"`
+}
+
+type CampaignEmailStatsAIMResult struct {
+	Success FlexInt                               `json:"success"`
+	Error   FlexInt                               `json:"error"`
+	Data    []CampaignEmailStatsAIMResultDataItem `json:"data"`
+}
+
+//CampaignEmailStatsAIM gets the opens and clicks of one member of a campaign
+//http://apidocs.mailchimp.com/api/1.3/campaignemailstatsaim.func.php
+func (a *API) CampaignEmailStatsAIM(parameters map[string]interface{}) (*CampaignEmailStatsAIMResult, error) {
+	return a.CampaignEmailStatsAIMContext(context.Background(), parameters)
+}
+
+func (a *API) CampaignEmailStatsAIMContext(ctx context.Context, parameters map[string]interface{}) (retVal *CampaignEmailStatsAIMResult, err error) {
+	retVal = new(CampaignEmailStatsAIMResult)
+	err = parseJson(ctx, a, "campaignEmailStatsAIM", parameters, retVal)
+	return
+}
+
+//CampaignEmailStatsAIMParams are the parameters of CampaignEmailStatsAIM
+//http://apidocs.mailchimp.com/api/1.3/campaignemailstatsaim.func.php
+type CampaignEmailStatsAIMParams struct {
+	CID          string   `json:"cid,omitempty"`
+	EmailAddress []string `json:"email_address,omitempty"`
+}
+
+func (p *CampaignEmailStatsAIMParams) Validate() error {
+	switch {
+	case p.CID == "":
+		return ParamError{"campaignEmailStatsAIM", "cid"}
+	case len(p.EmailAddress) == 0:
+		return ParamError{"campaignEmailStatsAIM", "email_address"}
+	}
+	return nil
+}
+
+func (a *API) CampaignEmailStatsAIMWithParams(ctx context.Context, params *CampaignEmailStatsAIMParams) (*CampaignEmailStatsAIMResult, error) {
+	parameters, err := ToParameters(params)
+	if err != nil {
+		return nil, err
+	}
+	return a.CampaignEmailStatsAIMContext(ctx, parameters)
+}
+
+type CampaignEmailStatsAIMAllResult struct {
+	Total FlexInt                                          `json:"total"`
+	Data  map[string][]CampaignEmailStatsAIMResultDataItem `json:"data"`
+}
+
+//CampaignEmailStatsAIMAll gets the opens and clicks of every member of a
+//campaign, keyed by email address
+//http://apidocs.mailchimp.com/api/1.3/campaignemailstatsaimall.func.php
+func (a *API) CampaignEmailStatsAIMAll(parameters map[string]interface{}) (*CampaignEmailStatsAIMAllResult, error) {
+	return a.CampaignEmailStatsAIMAllContext(context.Background(), parameters)
+}
+
+func (a *API) CampaignEmailStatsAIMAllContext(ctx context.Context, parameters map[string]interface{}) (retVal *CampaignEmailStatsAIMAllResult, err error) {
+	retVal = new(CampaignEmailStatsAIMAllResult)
+	err = parseJson(ctx, a, "campaignEmailStatsAIMAll", parameters, retVal)
+	return
+}
+
+//CampaignEmailStatsAIMAllParams are the parameters of CampaignEmailStatsAIMAll
+//http://apidocs.mailchimp.com/api/1.3/campaignemailstatsaimall.func.php
+type CampaignEmailStatsAIMAllParams struct {
+	CID   string `json:"cid,omitempty"`
+	Start int    `json:"start,omitempty"`
+	Limit int    `json:"limit,omitempty"`
+}
+
+func (p *CampaignEmailStatsAIMAllParams) Validate() error {
+	switch {
+	case p.CID == "":
+		return ParamError{"campaignEmailStatsAIMAll", "cid"}
+	}
+	return nil
+}
+
+func (a *API) CampaignEmailStatsAIMAllWithParams(ctx context.Context, params *CampaignEmailStatsAIMAllParams) (*CampaignEmailStatsAIMAllResult, error) {
+	parameters, err := ToParameters(params)
+	if err != nil {
+		return nil, err
+	}
+	return a.CampaignEmailStatsAIMAllContext(ctx, parameters)
+}
+
+type CampaignGeoOpensResultItem struct {
+	Code         string   `json:"code"`
+	Name         string   `json:"name"`
+	Opens        FlexInt  `json:"opens"`
+	RegionDetail FlexBool `json:"region_detail"`
+}
+
+//CampaignGeoOpens gets the number of opens of a campaign in each country
+//http://apidocs.mailchimp.com/api/1.3/campaigngeoopens.func.php
+func (a *API) CampaignGeoOpens(parameters map[string]interface{}) ([]CampaignGeoOpensResultItem, error) {
+	return a.CampaignGeoOpensContext(context.Background(), parameters)
+}
+
+func (a *API) CampaignGeoOpensContext(ctx context.Context, parameters map[string]interface{}) (retVal []CampaignGeoOpensResultItem, err error) {
+	err = parseJson(ctx, a, "campaignGeoOpens", parameters, &retVal)
+	return
+}
+
+//CampaignGeoOpensParams are the parameters of CampaignGeoOpens
+//http://apidocs.mailchimp.com/api/1.3/campaigngeoopens.func.php
+type CampaignGeoOpensParams struct {
+	CID string `json:"cid,omitempty"`
+}
+
+func (p *CampaignGeoOpensParams) Validate() error {
+	switch {
+	case p.CID == "":
+		return ParamError{"campaignGeoOpens", "cid"}
+	}
+	return nil
+}
+
+func (a *API) CampaignGeoOpensWithParams(ctx context.Context, params *CampaignGeoOpensParams) ([]CampaignGeoOpensResultItem, error) {
+	parameters, err := ToParameters(params)
+	if err != nil {
+		return nil, err
+	}
+	return a.CampaignGeoOpensContext(ctx, parameters)
+}
+
+type CampaignGeoOpensForCountryReturnItem struct {
+	Code  string  `json:"code"`
+	Name  string  `json:"name"`
+	Opens FlexInt `json:"opens"`
+}
+
+//CampaignGeoOpensForCountry gets the number of opens of a campaign in each
+//region of a country
+//http://apidocs.mailchimp.com/api/1.3/campaigngeoopensforcountry.func.php
+func (a *API) CampaignGeoOpensForCountry(parameters map[string]interface{}) ([]CampaignGeoOpensForCountryReturnItem, error) {
+	return a.CampaignGeoOpensForCountryContext(context.Background(), parameters)
+}
+
+func (a *API) CampaignGeoOpensForCountryContext(ctx context.Context, parameters map[string]interface{}) (retVal []CampaignGeoOpensForCountryReturnItem, err error) {
+	err = parseJson(ctx, a, "campaignGeoOpensForCountry", parameters, &retVal)
+	return
+}
+
+//CampaignGeoOpensForCountryParams are the parameters of CampaignGeoOpensForCountry
+//http://apidocs.mailchimp.com/api/1.3/campaigngeoopensforcountry.func.php
+type CampaignGeoOpensForCountryParams struct {
+	CID  string `json:"cid,omitempty"`
+	Code string `json:"code,omitempty"`
+}
+
+func (p *CampaignGeoOpensForCountryParams) Validate() error {
+	switch {
+	case p.CID == "":
+		return ParamError{"campaignGeoOpensForCountry", "cid"}
+	case p.Code == "":
+		return ParamError{"campaignGeoOpensForCountry", "code"}
+	}
+	return nil
+}
+
+func (a *API) CampaignGeoOpensForCountryWithParams(ctx context.Context, params *CampaignGeoOpensForCountryParams) ([]CampaignGeoOpensForCountryReturnItem, error) {
+	parameters, err := ToParameters(params)
+	if err != nil {
+		return nil, err
+	}
+	return a.CampaignGeoOpensForCountryContext(ctx, parameters)
+}
+
+type CampaignMembersResultDataItem struct {
+	Email        string `json:"email"`
+	Status       string `json:"status"`
+	AbsplitGroup string `json:"absplit_group"`
+	TzGroup      string `json:"tz_group"`
+}
+
+type CampaignMembersResult struct {
+	Total FlexInt                         `json:"total"`
+	Data  []CampaignMembersResultDataItem `json:"data"`
+}
+
+//CampaignMembers gets the members a campaign was sent to and whether it was
+//delivered to each
+//http://apidocs.mailchimp.com/api/1.3/campaignmembers.func.php
+func (a *API) CampaignMembers(parameters map[string]interface{}) (*CampaignMembersResult, error) {
+	return a.CampaignMembersContext(context.Background(), parameters)
+}
+
+func (a *API) CampaignMembersContext(ctx context.Context, parameters map[string]interface{}) (retVal *CampaignMembersResult, err error) {
+	retVal = new(CampaignMembersResult)
+	err = parseJson(ctx, a, "campaignMembers", parameters, retVal)
+	return
+}
+
+//CampaignMembersParams are the parameters of CampaignMembers
+//http://apidocs.mailchimp.com/api/1.3/campaignmembers.func.php
+type CampaignMembersParams struct {
+	CID    string `json:"cid,omitempty"`
+	Status string `json:"status,omitempty"`
+	Start  int    `json:"start,omitempty"`
+	Limit  int    `json:"limit,omitempty"`
+}
+
+func (p *CampaignMembersParams) Validate() error {
+	switch {
+	case p.CID == "":
+		return ParamError{"campaignMembers", "cid"}
+	}
+	return nil
+}
+
+func (a *API) CampaignMembersWithParams(ctx context.Context, params *CampaignMembersParams) (*CampaignMembersResult, error) {
+	parameters, err := ToParameters(params)
+	if err != nil {
+		return nil, err
+	}
+	return a.CampaignMembersContext(ctx, parameters)
+}
+
+//CampaignMembersPager walks every page of CampaignMembers
+func (a *API) CampaignMembersPager(ctx context.Context, parameters map[string]interface{}) *Pager[CampaignMembersResultDataItem] {
+	return newPager(ctx, "campaignMembers", parameters, fetchPage[CampaignMembersResultDataItem](a, "campaignMembers"))
+}
+
+type CampaignNotOpenedAIMResult struct {
+	Total FlexInt  `json:"total"`
+	Data  []string `json:"data"`
+}
+
+//CampaignNotOpenedAIM gets the email addresses of the members who have not
+//opened a campaign
+//http://apidocs.mailchimp.com/api/1.3/campaignnotopenedaim.func.php
+func (a *API) CampaignNotOpenedAIM(parameters map[string]interface{}) (*CampaignNotOpenedAIMResult, error) {
+	return a.CampaignNotOpenedAIMContext(context.Background(), parameters)
+}
+
+func (a *API) CampaignNotOpenedAIMContext(ctx context.Context, parameters map[string]interface{}) (retVal *CampaignNotOpenedAIMResult, err error) {
+	retVal = new(CampaignNotOpenedAIMResult)
+	err = parseJson(ctx, a, "campaignNotOpenedAIM", parameters, retVal)
+	return
+}
+
+//CampaignNotOpenedAIMParams are the parameters of CampaignNotOpenedAIM
+//http://apidocs.mailchimp.com/api/1.3/campaignnotopenedaim.func.php
+type CampaignNotOpenedAIMParams struct {
+	CID   string `json:"cid,omitempty"`
+	Start int    `json:"start,omitempty"`
+	Limit int    `json:"limit,omitempty"`
+}
+
+func (p *CampaignNotOpenedAIMParams) Validate() error {
+	switch {
+	case p.CID == "":
+		return ParamError{"campaignNotOpenedAIM", "cid"}
+	}
+	return nil
+}
+
+func (a *API) CampaignNotOpenedAIMWithParams(ctx context.Context, params *CampaignNotOpenedAIMParams) (*CampaignNotOpenedAIMResult, error) {
+	parameters, err := ToParameters(params)
+	if err != nil {
+		return nil, err
+	}
+	return a.CampaignNotOpenedAIMContext(ctx, parameters)
+}
+
+//CampaignNotOpenedAIMPager walks every page of CampaignNotOpenedAIM
+func (a *API) CampaignNotOpenedAIMPager(ctx context.Context, parameters map[string]interface{}) *Pager[string] {
+	return newPager(ctx, "campaignNotOpenedAIM", parameters, fetchPage[string](a, "campaignNotOpenedAIM"))
+}
+
+type CampaignOpenedAIMResultDataItem struct {
+	Email     string  `json:"email"`
+	OpenCount FlexInt `json:"open_count"`
+}
+
+type CampaignOpenedAIMResult struct {
+	Total FlexInt                           `json:"total"`
+	Data  []CampaignOpenedAIMResultDataItem `json:"data"`
+}
+
+//CampaignOpenedAIM gets the members who have opened a campaign, and how often
+//each did
+//http://apidocs.mailchimp.com/api/1.3/campaignopenedaim.func.php
+func (a *API) CampaignOpenedAIM(parameters map[string]interface{}) (*CampaignOpenedAIMResult, error) {
+	return a.CampaignOpenedAIMContext(context.Background(), parameters)
+}
+
+func (a *API) CampaignOpenedAIMContext(ctx context.Context, parameters map[string]interface{}) (retVal *CampaignOpenedAIMResult, err error) {
+	retVal = new(CampaignOpenedAIMResult)
+	err = parseJson(ctx, a, "campaignOpenedAIM", parameters, retVal)
+	return
+}
+
+//CampaignOpenedAIMParams are the parameters of CampaignOpenedAIM
+//http://apidocs.mailchimp.com/api/1.3/campaignopenedaim.func.php
+type CampaignOpenedAIMParams struct {
+	CID   string `json:"cid,omitempty"`
+	Start int    `json:"start,omitempty"`
+	Limit int    `json:"limit,omitempty"`
+}
+
+func (p *CampaignOpenedAIMParams) Validate() error {
+	switch {
+	case p.CID == "":
+		return ParamError{"campaignOpenedAIM", "cid"}
+	}
+	return nil
+}
+
+func (a *API) CampaignOpenedAIMWithParams(ctx context.Context, params *CampaignOpenedAIMParams) (*CampaignOpenedAIMResult, error) {
+	parameters, err := ToParameters(params)
+	if err != nil {
+		return nil, err
+	}
+	return a.CampaignOpenedAIMContext(ctx, parameters)
+}
+
+//CampaignOpenedAIMPager walks every page of CampaignOpenedAIM
+func (a *API) CampaignOpenedAIMPager(ctx context.Context, parameters map[string]interface{}) *Pager[CampaignOpenedAIMResultDataItem] {
+	return newPager(ctx, "campaignOpenedAIM", parameters, fetchPage[CampaignOpenedAIMResultDataItem](a, "campaignOpenedAIM"))
+}
+
+//CampaignPause pauses an RSS campaign
+//http://apidocs.mailchimp.com/api/1.3/campaignpause.func.php
+func (a *API) CampaignPause(parameters map[string]interface{}) (bool, error) {
+	return a.CampaignPauseContext(context.Background(), parameters)
+}
+
+func (a *API) CampaignPauseContext(ctx context.Context, parameters map[string]interface{}) (bool, error) {
+	return parseBoolean(ctx, a, "campaignPause", parameters)
+}
+
+//CampaignPauseParams are the parameters of CampaignPause
+//http://apidocs.mailchimp.com/api/1.3/campaignpause.func.php
+type CampaignPauseParams struct {
+	CID string `json:"cid,omitempty"`
+}
+
+func (p *CampaignPauseParams) Validate() error {
+	switch {
+	case p.CID == "":
+		return ParamError{"campaignPause", "cid"}
+	}
+	return nil
+}
+
+func (a *API) CampaignPauseWithParams(ctx context.Context, params *CampaignPauseParams) (bool, error) {
+	parameters, err := ToParameters(params)
+	if err != nil {
+		return false, err
+	}
+	return a.CampaignPauseContext(ctx, parameters)
+}
+
+//CampaignReplicate copies a campaign, returning the id of the copy
+//http://apidocs.mailchimp.com/api/1.3/campaignreplicate.func.php
+func (a *API) CampaignReplicate(parameters map[string]interface{}) (string, error) {
+	return a.CampaignReplicateContext(context.Background(), parameters)
+}
+
+func (a *API) CampaignReplicateContext(ctx context.Context, parameters map[string]interface{}) (string, error) {
+	return parseString(ctx, a, "campaignReplicate", parameters)
+}
+
+//CampaignReplicateParams are the parameters of CampaignReplicate
+//http://apidocs.mailchimp.com/api/1.3/campaignreplicate.func.php
+type CampaignReplicateParams struct {
+	CID string `json:"cid,omitempty"`
+}
+
+func (p *CampaignReplicateParams) Validate() error {
+	switch {
+	case p.CID == "":
+		return ParamError{"campaignReplicate", "cid"}
+	}
+	return nil
+}
+
+func (a *API) CampaignReplicateWithParams(ctx context.Context, params *CampaignReplicateParams) (string, error) {
+	parameters, err := ToParameters(params)
+	if err != nil {
+		return "", err
+	}
+	return a.CampaignReplicateContext(ctx, parameters)
+}
+
+//CampaignResume resumes a paused RSS campaign
+//http://apidocs.mailchimp.com/api/1.3/campaignresume.func.php
+func (a *API) CampaignResume(parameters map[string]interface{}) (bool, error) {
+	return a.CampaignResumeContext(context.Background(), parameters)
+}
+
+func (a *API) CampaignResumeContext(ctx context.Context, parameters map[string]interface{}) (bool, error) {
+	return parseBoolean(ctx, a, "campaignResume", parameters)
+}
+
+//CampaignResumeParams are the parameters of CampaignResume
+//http://apidocs.mailchimp.com/api/1.3/campaignresume.func.php
+type CampaignResumeParams struct {
+	CID string `json:"cid,omitempty"`
+}
+
+func (p *CampaignResumeParams) Validate() error {
+	switch {
+	case p.CID == "":
+		return ParamError{"campaignResume", "cid"}
+	}
+	return nil
+}
+
+func (a *API) CampaignResumeWithParams(ctx context.Context, params *CampaignResumeParams) (bool, error) {
+	parameters, err := ToParameters(params)
+	if err != nil {
+		return false, err
+	}
+	return a.CampaignResumeContext(ctx, parameters)
+}
+
+//CampaignSchedule schedules a campaign to be sent at a time in the future
+//http://apidocs.mailchimp.com/api/1.3/campaignschedule.func.php
+func (a *API) CampaignSchedule(parameters map[string]interface{}) (bool, error) {
+	return a.CampaignScheduleContext(context.Background(), parameters)
+}
+
+func (a *API) CampaignScheduleContext(ctx context.Context, parameters map[string]interface{}) (bool, error) {
+	return parseBoolean(ctx, a, "campaignSchedule", timeParameters(parameters, "schedule_time", "schedule_time_b"))
+}
+
+//CampaignScheduleParams are the parameters of CampaignSchedule
+//http://apidocs.mailchimp.com/api/1.3/campaignschedule.func.php
+type CampaignScheduleParams struct {
+	CID          string    `json:"cid,omitempty"`
+	ScheduleTime ChimpTime `json:"schedule_time,omitempty"`
+	//the time of the B campaign of an A/B split
+	ScheduleTimeB ChimpTime `json:"schedule_time_b,omitempty"`
+}
+
+func (p *CampaignScheduleParams) Validate() error {
+	switch {
+	case p.CID == "":
+		return ParamError{"campaignSchedule", "cid"}
+	case p.ScheduleTime.IsZero():
+		return ParamError{"campaignSchedule", "schedule_time"}
+	}
+	return nil
+}
+
+func (a *API) CampaignScheduleWithParams(ctx context.Context, params *CampaignScheduleParams) (bool, error) {
+	parameters, err := ToParameters(params)
+	if err != nil {
+		return false, err
+	}
+	return a.CampaignScheduleContext(ctx, parameters)
+}
+
+//CampaignSegmentTest counts the members of a list that segment options select
+//http://apidocs.mailchimp.com/api/1.3/campaignsegmenttest.func.php
+func (a *API) CampaignSegmentTest(parameters map[string]interface{}) (int, error) {
+	return a.CampaignSegmentTestContext(context.Background(), parameters)
+}
+
+func (a *API) CampaignSegmentTestContext(ctx context.Context, parameters map[string]interface{}) (int, error) {
+	return parseInt(ctx, a, "campaignSegmentTest", parameters)
+}
+
+//CampaignSegmentTestParams are the parameters of CampaignSegmentTest
+//http://apidocs.mailchimp.com/api/1.3/campaignsegmenttest.func.php
+type CampaignSegmentTestParams struct {
+	ListID  string         `json:"list_id,omitempty"`
+	Options SegmentOptions `json:"options,omitempty"`
+}
+
+func (p *CampaignSegmentTestParams) Validate() error {
+	switch {
+	case p.ListID == "":
+		return ParamError{"campaignSegmentTest", "list_id"}
+	}
+	return p.validate()
+}
+
+func (a *API) CampaignSegmentTestWithParams(ctx context.Context, params *CampaignSegmentTestParams) (int, error) {
+	parameters, err := ToParameters(params)
+	if err != nil {
+		return 0, err
+	}
+	return a.CampaignSegmentTestContext(ctx, parameters)
+}
+
+//CampaignSendNow sends a campaign immediately
+//http://apidocs.mailchimp.com/api/1.3/campaignsendnow.func.php
+func (a *API) CampaignSendNow(parameters map[string]interface{}) (bool, error) {
+	return a.CampaignSendNowContext(context.Background(), parameters)
+}
+
+func (a *API) CampaignSendNowContext(ctx context.Context, parameters map[string]interface{}) (bool, error) {
+	return parseBoolean(ctx, a, "campaignSendNow", parameters)
+}
+
+//CampaignSendNowParams are the parameters of CampaignSendNow
+//http://apidocs.mailchimp.com/api/1.3/campaignsendnow.func.php
+type CampaignSendNowParams struct {
+	CID string `json:"cid,omitempty"`
+}
+
+func (p *CampaignSendNowParams) Validate() error {
+	switch {
+	case p.CID == "":
+		return ParamError{"campaignSendNow", "cid"}
+	}
+	return nil
+}
+
+func (a *API) CampaignSendNowWithParams(ctx context.Context, params *CampaignSendNowParams) (bool, error) {
+	parameters, err := ToParameters(params)
+	if err != nil {
+		return false, err
+	}
+	return a.CampaignSendNowContext(ctx, parameters)
+}
+
+//CampaignSendTest sends a test of a campaign to the given email addresses
+//http://apidocs.mailchimp.com/api/1.3/campaignsendtest.func.php
+func (a *API) CampaignSendTest(parameters map[string]interface{}) (bool, error) {
+	return a.CampaignSendTestContext(context.Background(), parameters)
+}
+
+func (a *API) CampaignSendTestContext(ctx context.Context, parameters map[string]interface{}) (bool, error) {
+	return parseBoolean(ctx, a, "campaignSendTest", parameters)
+}
+
+//CampaignSendTestParams are the parameters of CampaignSendTest
+//http://apidocs.mailchimp.com/api/1.3/campaignsendtest.func.php
+type CampaignSendTestParams struct {
+	CID        string   `json:"cid,omitempty"`
+	TestEmails []string `json:"test_emails,omitempty"`
+	SendType   string   `json:"send_type,omitempty"`
+}
+
+func (p *CampaignSendTestParams) Validate() error {
+	switch {
+	case p.CID == "":
+		return ParamError{"campaignSendTest", "cid"}
+	case len(p.TestEmails) == 0:
+		return ParamError{"campaignSendTest", "test_emails"}
+	}
+	return nil
+}
+
+func (a *API) CampaignSendTestWithParams(ctx context.Context, params *CampaignSendTestParams) (bool, error) {
+	parameters, err := ToParameters(params)
+	if err != nil {
+		return false, err
+	}
+	return a.CampaignSendTestContext(ctx, parameters)
+}
+
+type CampaignShareReportResult struct {
+	Title     string `json:"title"`
+	URL       string `json:"url"`
+	SecureURL string `json:"secure_url"`
+	Password  string `json:"password"`
+}
+
+//CampaignShareReport gets a link to the report of a campaign that can be
+//shared, optionally emailing it
+//http://apidocs.mailchimp.com/api/1.3/campaignsharereport.func.php
+func (a *API) CampaignShareReport(parameters map[string]interface{}) (*CampaignShareReportResult, error) {
+	return a.CampaignShareReportContext(context.Background(), parameters)
+}
+
+func (a *API) CampaignShareReportContext(ctx context.Context, parameters map[string]interface{}) (retVal *CampaignShareReportResult, err error) {
+	retVal = new(CampaignShareReportResult)
+	err = parseJson(ctx, a, "campaignShareReport", parameters, retVal)
+	return
+}
+
+//CampaignShareReportParams are the parameters of CampaignShareReport
+//http://apidocs.mailchimp.com/api/1.3/campaignsharereport.func.php
+type CampaignShareReportParams struct {
+	CID  string              `json:"cid,omitempty"`
+	Opts *ShareReportOptions `json:"opts,omitempty"`
+}
+
+func (p *CampaignShareReportParams) Validate() error {
+	switch {
+	case p.CID == "":
+		return ParamError{"campaignShareReport", "cid"}
+	}
+	return nil
+}
+
+func (a *API) CampaignShareReportWithParams(ctx context.Context, params *CampaignShareReportParams) (*CampaignShareReportResult, error) {
+	parameters, err := ToParameters(params)
+	if err != nil {
+		return nil, err
+	}
+	return a.CampaignShareReportContext(ctx, parameters)
+}
+
+type CampaignStatsResultAbsplit struct {
+	BouncesA         FlexInt `json:"bounces_a"`
+	BouncesB         FlexInt `json:"bounces_b"`
+	ForwardsA        FlexInt `json:"forwards_a"`
+	ForwardsB        FlexInt `json:"forwards_b"`
+	AbuseReportsA    FlexInt `json:"abuse_reports_a"`
+	AbuseReportsB    FlexInt `json:"abuse_reports_b"`
+	UnsubsA          FlexInt `json:"unsubs_a"`
+	UnsubsB          FlexInt `json:"unsubs_b"`
+	RecipientsClickA FlexInt `json:"recipients_click_a"`
+	RecipientsClickB FlexInt `json:"recipients_click_b"`
+	ForwardsOpensA   FlexInt `json:"forwards_opens_a"`
+	ForwardsOpensB   FlexInt `json:"forwards_opens_b"`
+}
+
+type CampaignStatsResultTimewarpItem struct {
+	Opens       FlexInt   `json:"opens"`
+	LastOpen    ChimpTime `json:"last_open"`
+	UniqueOpens FlexInt   `json:"unique_opens"`
+	Clicks      FlexInt   `json:"clicks"`
+	LastClick   ChimpTime `json:"last_click"`
+	Bounces     FlexInt   `json:"bounces"`
+	Total       FlexInt   `json:"total"`
+	Sent        FlexInt   `json:"sent"`
+}
+
+type CampaignStatsResultTimeseriesItem struct {
+	Timestamp       ChimpTime `json:"timestamp"`
+	EmailsSent      FlexInt   `json:"emails_sent"`
+	UniqueOpens     FlexInt   `json:"unique_opens"`
+	RecipientsClick FlexInt   `json:"recipients_click"`
+}
+
+type CampaignStatsResult struct {
+	SyntaxErrors    FlexInt                                    `json:"syntax_errors"`
+	HardBounces     FlexInt                                    `json:"hard_bounces"`
+	SoftBounces     FlexInt                                    `json:"soft_bounces"`
+	Unsubscribes    FlexInt                                    `json:"unsubscribes"`
+	AbuseReports    FlexInt                                    `json:"abuse_reports"`
+	Forwards        FlexInt                                    `json:"forwards"`
+	ForwardsOpens   FlexInt                                    `json:"forwards_opens"`
+	Opens           FlexInt                                    `json:"opens"`
+	LastOpen        ChimpTime                                  `json:"last_open"`
+	UniqueOpens     FlexInt                                    `json:"unique_opens"`
+	Clicks          FlexInt                                    `json:"clicks"`
+	UniqueClicks    FlexInt                                    `json:"unique_clicks"`
+	LastClick       ChimpTime                                  `json:"last_click"`
+	UsersWhoClicked FlexInt                                    `json:"users_who_clicked"`
+	EmailsSent      FlexInt                                    `json:"emails_sent"`
+	UniqueLikes     FlexInt                                    `json:"unique_likes"`
+	RecipientLikes  FlexInt                                    `json:"recipient_likes"`
+	FacebookLikes   FlexInt                                    `json:"facebook_likes"`
+	Absplit         CampaignStatsResultAbsplit                 `json:"absplit"`
+	Timewarp        map[string]CampaignStatsResultTimewarpItem `json:"timewarp"`
+	Timeseries      []CampaignStatsResultTimeseriesItem        `json:"timeseries"`
+}
+
+//CampaignStats gets the statistics of a campaign
+//http://apidocs.mailchimp.com/api/1.3/campaignstats.func.php
+func (a *API) CampaignStats(parameters map[string]interface{}) (*CampaignStatsResult, error) {
+	return a.CampaignStatsContext(context.Background(), parameters)
+}
+
+func (a *API) CampaignStatsContext(ctx context.Context, parameters map[string]interface{}) (retVal *CampaignStatsResult, err error) {
+	retVal = new(CampaignStatsResult)
+	err = parseJson(ctx, a, "campaignStats", parameters, retVal)
+	return
+}
+
+//CampaignStatsParams are the parameters of CampaignStats
+//http://apidocs.mailchimp.com/api/1.3/campaignstats.func.php
+type CampaignStatsParams struct {
+	CID string `json:"cid,omitempty"`
+}
+
+func (p *CampaignStatsParams) Validate() error {
+	switch {
+	case p.CID == "":
+		return ParamError{"campaignStats", "cid"}
+	}
+	return nil
+}
+
+func (a *API) CampaignStatsWithParams(ctx context.Context, params *CampaignStatsParams) (*CampaignStatsResult, error) {
+	parameters, err := ToParameters(params)
+	if err != nil {
+		return nil, err
+	}
+	return a.CampaignStatsContext(ctx, parameters)
+}
+
+//CampaignTemplateContent gets the content of each editable section of a
+//campaign's template, keyed by the names of the sections, which depend on the
+//template
+//http://apidocs.mailchimp.com/api/1.3/campaigntemplatecontent.func.php
+func (a *API) CampaignTemplateContent(parameters map[string]interface{}) (map[string]interface{}, error) {
+	return a.CampaignTemplateContentContext(context.Background(), parameters)
+}
+
+func (a *API) CampaignTemplateContentContext(ctx context.Context, parameters map[string]interface{}) (retVal map[string]interface{}, err error) {
+	err = parseJson(ctx, a, "campaignTemplateContent", parameters, &retVal)
+	return
+}
+
+//CampaignTemplateContentParams are the parameters of CampaignTemplateContent
+//http://apidocs.mailchimp.com/api/1.3/campaigntemplatecontent.func.php
+type CampaignTemplateContentParams struct {
+	CID string `json:"cid,omitempty"`
+}
+
+func (p *CampaignTemplateContentParams) Validate() error {
+	switch {
+	case p.CID == "":
+		return ParamError{"campaignTemplateContent", "cid"}
+	}
+	return nil
+}
+
+func (a *API) CampaignTemplateContentWithParams(ctx context.Context, params *CampaignTemplateContentParams) (map[string]interface{}, error) {
+	parameters, err := ToParameters(params)
+	if err != nil {
+		return nil, err
+	}
+	return a.CampaignTemplateContentContext(ctx, parameters)
+}
+
+//CampaignUnschedule unschedules a campaign that has not been sent
+//http://apidocs.mailchimp.com/api/1.3/campaignunschedule.func.php
+func (a *API) CampaignUnschedule(parameters map[string]interface{}) (bool, error) {
+	return a.CampaignUnscheduleContext(context.Background(), parameters)
+}
+
+func (a *API) CampaignUnscheduleContext(ctx context.Context, parameters map[string]interface{}) (bool, error) {
+	return parseBoolean(ctx, a, "campaignUnschedule", parameters)
+}
+
+//CampaignUnscheduleParams are the parameters of CampaignUnschedule
+//http://apidocs.mailchimp.com/api/1.3/campaignunschedule.func.php
+type CampaignUnscheduleParams struct {
+	CID string `json:"cid,omitempty"`
+}
+
+func (p *CampaignUnscheduleParams) Validate() error {
+	switch {
+	case p.CID == "":
+		return ParamError{"campaignUnschedule", "cid"}
+	}
+	return nil
+}
+
+func (a *API) CampaignUnscheduleWithParams(ctx context.Context, params *CampaignUnscheduleParams) (bool, error) {
+	parameters, err := ToParameters(params)
+	if err != nil {
+		return false, err
+	}
+	return a.CampaignUnscheduleContext(ctx, parameters)
+}
+
+type CampaignUnsubscribesResultDataItem struct {
+	Email      string `json:"email"`
+	Reason     string `json:"reason"`
+	ReasonText string `json:"reason_text"`
+}
+
+type CampaignUnsubscribesResult struct {
+	Total FlexInt                              `json:"total"`
+	Data  []CampaignUnsubscribesResultDataItem `json:"data"`
+}
+
+//CampaignUnsubscribes gets the members who unsubscribed from a campaign
+//http://apidocs.mailchimp.com/api/1.3/campaignunsubscribes.func.php
+func (a *API) CampaignUnsubscribes(parameters map[string]interface{}) (*CampaignUnsubscribesResult, error) {
+	return a.CampaignUnsubscribesContext(context.Background(), parameters)
+}
+
+func (a *API) CampaignUnsubscribesContext(ctx context.Context, parameters map[string]interface{}) (retVal *CampaignUnsubscribesResult, err error) {
+	retVal = new(CampaignUnsubscribesResult)
+	err = parseJson(ctx, a, "campaignUnsubscribes", parameters, retVal)
+	return
+}
+
+//CampaignUnsubscribesParams are the parameters of CampaignUnsubscribes
+//http://apidocs.mailchimp.com/api/1.3/campaignunsubscribes.func.php
+type CampaignUnsubscribesParams struct {
+	CID   string `json:"cid,omitempty"`
+	Start int    `json:"start,omitempty"`
+	Limit int    `json:"limit,omitempty"`
+}
+
+func (p *CampaignUnsubscribesParams) Validate() error {
+	switch {
+	case p.CID == "":
+		return ParamError{"campaignUnsubscribes", "cid"}
+	}
+	return nil
+}
+
+func (a *API) CampaignUnsubscribesWithParams(ctx context.Context, params *CampaignUnsubscribesParams) (*CampaignUnsubscribesResult, error) {
+	parameters, err := ToParameters(params)
+	if err != nil {
+		return nil, err
+	}
+	return a.CampaignUnsubscribesContext(ctx, parameters)
+}
+
+//CampaignUnsubscribesPager walks every page of CampaignUnsubscribes
+func (a *API) CampaignUnsubscribesPager(ctx context.Context, parameters map[string]interface{}) *Pager[CampaignUnsubscribesResultDataItem] {
+	return newPager(ctx, "campaignUnsubscribes", parameters, fetchPage[CampaignUnsubscribesResultDataItem](a, "campaignUnsubscribes"))
+}
+
+//CampaignUpdate changes one option of a campaign that has not been sent
+//http://apidocs.mailchimp.com/api/1.3/campaignupdate.func.php
+func (a *API) CampaignUpdate(parameters map[string]interface{}) (bool, error) {
+	return a.CampaignUpdateContext(context.Background(), parameters)
+}
+
+func (a *API) CampaignUpdateContext(ctx context.Context, parameters map[string]interface{}) (bool, error) {
+	return parseBoolean(ctx, a, "campaignUpdate", parameters)
+}
+
+//CampaignUpdateParams are the parameters of CampaignUpdate
+//http://apidocs.mailchimp.com/api/1.3/campaignupdate.func.php
+type CampaignUpdateParams struct {
+	CID   string      `json:"cid,omitempty"`
+	Name  string      `json:"name,omitempty"`
+	Value interface{} `json:"value,omitempty"`
+}
+
+func (p *CampaignUpdateParams) Validate() error {
+	switch {
+	case p.CID == "":
+		return ParamError{"campaignUpdate", "cid"}
+	case p.Name == "":
+		return ParamError{"campaignUpdate", "name"}
+	case p.Value == nil:
+		return ParamError{"campaignUpdate", "value"}
+	}
+	return nil
+}
+
+func (a *API) CampaignUpdateWithParams(ctx context.Context, params *CampaignUpdateParams) (bool, error) {
+	parameters, err := ToParameters(params)
+	if err != nil {
+		return false, err
+	}
+	return a.CampaignUpdateContext(ctx, parameters)
+}
+
+type CampaignsResultDataTracking struct {
+	HTMLClicks FlexBool `json:"html_clicks"`
+	TextClicks FlexBool `json:"text_clicks"`
+	Opens      FlexBool `json:"opens"`
+}
+
+type CampaignsResultDataSegmentOpts struct {
+	Match      string                   `json:"match"`
+	Conditions []map[string]interface{} `json:"conditions"`
+}
+
+type CampaignsResultData struct {
+	ID               string                         `json:"id"`
+	WebID            FlexInt                        `json:"web_id"`
+	ListID           string                         `json:"list_id"`
+	FolderID         FlexInt                        `json:"folder_id"`
+	TemplateID       FlexInt                        `json:"template_id"`
+	ContentType      string                         `json:"content_type"`
+	Title            string                         `json:"title"`
+	Type             string                         `json:"type"`
+	CreateTime       ChimpTime                      `json:"create_time"`
+	SendTime         ChimpTime                      `json:"send_time"`
+	EmailsSent       FlexInt                        `json:"emails_sent"`
+	Status           string                         `json:"status"`
+	FromName         string                         `json:"from_name"`
+	FromEmail        string                         `json:"from_email"`
+	Subject          string                         `json:"subject"`
+	ToName           string                         `json:"to_name"`
+	ArchiveURL       string                         `json:"archive_url"`
+	InlineCSS        FlexBool                       `json:"inline_css"`
+	Analytics        string                         `json:"analytics"`
+	AnalyticsTag     string                         `json:"analytics_tag"`
+	Authenticate     FlexBool                       `json:"authenticate"`
+	Ecomm360         FlexBool                       `json:"ecomm360"`
+	AutoTweet        FlexBool                       `json:"auto_tweet"`
+	AutoFBPost       string                         `json:"auto_fb_post"`
+	AutoFooter       FlexBool                       `json:"auto_footer"`
+	Timewarp         FlexBool                       `json:"timewarp"`
+	TimewarpSchedule string                         `json:"timewarp_schedule"`
+	Tracking         CampaignsResultDataTracking    `json:"tracking"`
+	SegmentText      string                         `json:"segment_text"`
+	SegmentOpts      CampaignsResultDataSegmentOpts `json:"segment_opts"`
+	TypeOpts         map[string]interface{}         `json:"type_opts"`
+}
+
+type CampaignsResult struct {
+	Total FlexInt               `json:"total"`
+	Data  []CampaignsResultData `json:"data"`
+}
+
+//Campaigns gets the campaigns matching the filters
+//http://apidocs.mailchimp.com/api/1.3/campaigns.func.php
+func (a *API) Campaigns(parameters map[string]interface{}) (*CampaignsResult, error) {
+	return a.CampaignsContext(context.Background(), parameters)
+}
+
+func (a *API) CampaignsContext(ctx context.Context, parameters map[string]interface{}) (retVal *CampaignsResult, err error) {
+	retVal = new(CampaignsResult)
+	err = parseJson(ctx, a, "campaigns", parameters, retVal)
+	return
+}
+
+//CampaignsParams are the parameters of Campaigns
+//http://apidocs.mailchimp.com/api/1.3/campaigns.func.php
+type CampaignsParams struct {
+	Filters *CampaignsFilters `json:"filters,omitempty"`
+	Start   int               `json:"start,omitempty"`
+	Limit   int               `json:"limit,omitempty"`
+}
+
+func (p *CampaignsParams) Validate() error {
+	return nil
+}
+
+func (a *API) CampaignsWithParams(ctx context.Context, params *CampaignsParams) (*CampaignsResult, error) {
+	parameters, err := ToParameters(params)
+	if err != nil {
+		return nil, err
+	}
+	return a.CampaignsContext(ctx, parameters)
+}
+
+//CampaignsPager walks every page of Campaigns
+func (a *API) CampaignsPager(ctx context.Context, parameters map[string]interface{}) *Pager[CampaignsResultData] {
+	return newPager(ctx, "campaigns", parameters, fetchPage[CampaignsResultData](a, "campaigns"))
+}
+
+//CampaignsForEmail gets the ids of the campaigns sent to an email address
+//http://apidocs.mailchimp.com/api/1.3/campaignsforemail.func.php
+func (a *API) CampaignsForEmail(parameters map[string]interface{}) ([]string, error) {
+	return a.CampaignsForEmailContext(context.Background(), parameters)
+}
+
+func (a *API) CampaignsForEmailContext(ctx context.Context, parameters map[string]interface{}) (retVal []string, err error) {
+	err = parseJson(ctx, a, "campaignsForEmail", parameters, &retVal)
+	return
+}
+
+//CampaignsForEmailParams are the parameters of CampaignsForEmail
+//http://apidocs.mailchimp.com/api/1.3/campaignsforemail.func.php
+type CampaignsForEmailParams struct {
+	EmailAddress string                    `json:"email_address,omitempty"`
+	Options      *CampaignsForEmailOptions `json:"options,omitempty"`
+}
+
+func (p *CampaignsForEmailParams) Validate() error {
+	switch {
+	case p.EmailAddress == "":
+		return ParamError{"campaignsForEmail", "email_address"}
+	}
+	return nil
+}
+
+func (a *API) CampaignsForEmailWithParams(ctx context.Context, params *CampaignsForEmailParams) ([]string, error) {
+	parameters, err := ToParameters(params)
+	if err != nil {
+		return nil, err
+	}
+	return a.CampaignsForEmailContext(ctx, parameters)
+}
+
+type ChimpChatterResultItem struct {
+	Message    string    `json:"message"`
+	Type       string    `json:"type"`
+	URL        string    `json:"url"`
+	ListID     string    `json:"list_id"`
+	CampaignID string    `json:"campaign_id"`
+	UpdateTime ChimpTime `json:"update_time"`
+}
+
+//ChimpChatter gets the latest Chimp Chatter, the feed of the account's activity
+//http://apidocs.mailchimp.com/api/1.3/chimpchatter.func.php
+func (a *API) ChimpChatter(parameters map[string]interface{}) ([]ChimpChatterResultItem, error) {
+	return a.ChimpChatterContext(context.Background(), parameters)
+}
+
+func (a *API) ChimpChatterContext(ctx context.Context, parameters map[string]interface{}) (retVal []ChimpChatterResultItem, err error) {
+	err = parseJson(ctx, a, "chimpChatter", parameters, &retVal)
+	return
+}
+
+//EcommOrderAdd records an Ecommerce360 order that is not tied to a campaign
+//http://apidocs.mailchimp.com/api/1.3/ecommorderadd.func.php
+func (a *API) EcommOrderAdd(parameters map[string]interface{}) (bool, error) {
+	return a.EcommOrderAddContext(context.Background(), parameters)
+}
+
+func (a *API) EcommOrderAddContext(ctx context.Context, parameters map[string]interface{}) (bool, error) {
+	return parseBoolean(ctx, a, "ecommOrderAdd", parameters)
+}
+
+//EcommOrderAddParams are the parameters of EcommOrderAdd
+//http://apidocs.mailchimp.com/api/1.3/ecommorderadd.func.php
+type EcommOrderAddParams struct {
+	Order EcommOrder `json:"order,omitempty"`
+}
+
+func (p *EcommOrderAddParams) Validate() error {
+	return p.validate()
+}
+
+func (a *API) EcommOrderAddWithParams(ctx context.Context, params *EcommOrderAddParams) (bool, error) {
+	parameters, err := ToParameters(params)
+	if err != nil {
+		return false, err
+	}
+	return a.EcommOrderAddContext(ctx, parameters)
+}
+
+//EcommOrderDel deletes an order recorded by EcommOrderAdd or
+//CampaignEcommOrderAdd
+//http://apidocs.mailchimp.com/api/1.3/ecommorderdelete.func.php
+func (a *API) EcommOrderDel(parameters map[string]interface{}) (bool, error) {
+	return a.EcommOrderDelContext(context.Background(), parameters)
+}
+
+func (a *API) EcommOrderDelContext(ctx context.Context, parameters map[string]interface{}) (bool, error) {
+	return parseBoolean(ctx, a, "ecommOrderDelete", parameters)
+}
+
+//EcommOrderDelParams are the parameters of EcommOrderDel
+//http://apidocs.mailchimp.com/api/1.3/ecommorderdelete.func.php
+type EcommOrderDelParams struct {
+	StoreID string `json:"store_id,omitempty"`
+	OrderID string `json:"order_id,omitempty"`
+}
+
+func (p *EcommOrderDelParams) Validate() error {
+	switch {
+	case p.StoreID == "":
+		return ParamError{"ecommOrderDelete", "store_id"}
+	case p.OrderID == "":
+		return ParamError{"ecommOrderDelete", "order_id"}
+	}
+	return nil
+}
+
+func (a *API) EcommOrderDelWithParams(ctx context.Context, params *EcommOrderDelParams) (bool, error) {
+	parameters, err := ToParameters(params)
+	if err != nil {
+		return false, err
+	}
+	return a.EcommOrderDelContext(ctx, parameters)
+}
+
+type EcommOrdersResultDataItemLinesItem struct {
+	LineNum             FlexInt   `json:"line_num"`
+	ProductID           FlexInt   `json:"product_id"`
+	ProductName         string    `json:"product_name"`
+	ProductSKU          string    `json:"product_sku"`
+	ProductCategoryID   FlexInt   `json:"product_category_id"`
+	ProductCategoryName string    `json:"product_category_name"`
+	Qty                 FlexInt   `json:"qty"`
+	Cost                FlexFloat `json:"cost"`
+}
+
+type EcommOrdersResultDataItem struct {
+	StoreID    string                               `json:"store_id"`
+	StoreName  string                               `json:"store_name"`
+	OrderID    string                               `json:"order_id"`
+	Email      string                               `json:"email"`
+	OrderTotal FlexFloat                            `json:"order_total"`
+	TaxTotal   FlexFloat                            `json:"tax_total"`
+	ShipTotal  FlexFloat                            `json:"ship_total"`
+	OrderDate  ChimpTime                            `json:"order_date"`
+	Lines      []EcommOrdersResultDataItemLinesItem `json:"lines"`
+}
+
+type EcommOrdersResult struct {
+	Total FlexInt                     `json:"total"`
+	Data  []EcommOrdersResultDataItem `json:"data"`
+}
+
+//EcommOrders gets the Ecommerce360 orders recorded for the account
+//http://apidocs.mailchimp.com/api/1.3/ecommorders.func.php
+func (a *API) EcommOrders(parameters map[string]interface{}) (*EcommOrdersResult, error) {
+	return a.EcommOrdersContext(context.Background(), parameters)
+}
+
+func (a *API) EcommOrdersContext(ctx context.Context, parameters map[string]interface{}) (retVal *EcommOrdersResult, err error) {
+	retVal = new(EcommOrdersResult)
+	err = parseJson(ctx, a, "ecommOrders", timeParameters(parameters, "since"), retVal)
+	return
+}
+
+//EcommOrdersParams are the parameters of EcommOrders
+//http://apidocs.mailchimp.com/api/1.3/ecommorders.func.php
+type EcommOrdersParams struct {
+	Since ChimpTime `json:"since,omitempty"`
+	Start int       `json:"start,omitempty"`
+	Limit int       `json:"limit,omitempty"`
+}
+
+func (p *EcommOrdersParams) Validate() error {
+	return nil
+}
+
+func (a *API) EcommOrdersWithParams(ctx context.Context, params *EcommOrdersParams) (*EcommOrdersResult, error) {
+	parameters, err := ToParameters(params)
+	if err != nil {
+		return nil, err
+	}
+	return a.EcommOrdersContext(ctx, parameters)
+}
+
+//EcommOrdersPager walks every page of EcommOrders
+func (a *API) EcommOrdersPager(ctx context.Context, parameters map[string]interface{}) *Pager[EcommOrdersResultDataItem] {
+	return newPager(ctx, "ecommOrders", parameters, fetchPage[EcommOrdersResultDataItem](a, "ecommOrders"))
+}
+
+//FolderAdd adds a folder of campaigns or autoresponders, returning its id
+//http://apidocs.mailchimp.com/api/1.3/folderadd.func.php
+func (a *API) FolderAdd(parameters map[string]interface{}) (int, error) {
+	return a.FolderAddContext(context.Background(), parameters)
+}
+
+func (a *API) FolderAddContext(ctx context.Context, parameters map[string]interface{}) (int, error) {
+	return parseInt(ctx, a, "folderAdd", parameters)
+}
+
+//FolderAddParams are the parameters of FolderAdd
+//http://apidocs.mailchimp.com/api/1.3/folderadd.func.php
+type FolderAddParams struct {
+	Name string `json:"name,omitempty"`
+	Type string `json:"type,omitempty"`
+}
+
+func (p *FolderAddParams) Validate() error {
+	switch {
+	case p.Name == "":
+		return ParamError{"folderAdd", "name"}
+	}
+	return nil
+}
+
+func (a *API) FolderAddWithParams(ctx context.Context, params *FolderAddParams) (int, error) {
+	parameters, err := ToParameters(params)
+	if err != nil {
+		return 0, err
+	}
+	return a.FolderAddContext(ctx, parameters)
+}
+
+//FolderDel deletes a folder, leaving the campaigns in it unfiled
+//http://apidocs.mailchimp.com/api/1.3/folderdel.func.php
+func (a *API) FolderDel(parameters map[string]interface{}) (bool, error) {
+	return a.FolderDelContext(context.Background(), parameters)
+}
+
+func (a *API) FolderDelContext(ctx context.Context, parameters map[string]interface{}) (bool, error) {
+	return parseBoolean(ctx, a, "folderDel", parameters)
+}
+
+//FolderDelParams are the parameters of FolderDel
+//http://apidocs.mailchimp.com/api/1.3/folderdel.func.php
+type FolderDelParams struct {
+	FID  int    `json:"fid,omitempty"`
+	Type string `json:"type,omitempty"`
+}
+
+func (p *FolderDelParams) Validate() error {
+	switch {
+	case p.FID == 0:
+		return ParamError{"folderDel", "fid"}
+	}
+	return nil
+}
+
+func (a *API) FolderDelWithParams(ctx context.Context, params *FolderDelParams) (bool, error) {
+	parameters, err := ToParameters(params)
+	if err != nil {
+		return false, err
+	}
+	return a.FolderDelContext(ctx, parameters)
+}
+
+//FolderUpdate renames a folder
+//http://apidocs.mailchimp.com/api/1.3/folderupdate.func.php
+func (a *API) FolderUpdate(parameters map[string]interface{}) (bool, error) {
+	return a.FolderUpdateContext(context.Background(), parameters)
+}
+
+func (a *API) FolderUpdateContext(ctx context.Context, parameters map[string]interface{}) (bool, error) {
+	return parseBoolean(ctx, a, "folderUpdate", parameters)
+}
+
+//FolderUpdateParams are the parameters of FolderUpdate
+//http://apidocs.mailchimp.com/api/1.3/folderupdate.func.php
+type FolderUpdateParams struct {
+	FID  int    `json:"fid,omitempty"`
+	Name string `json:"name,omitempty"`
+	Type string `json:"type,omitempty"`
+}
+
+func (p *FolderUpdateParams) Validate() error {
+	switch {
+	case p.FID == 0:
+		return ParamError{"folderUpdate", "fid"}
+	case p.Name == "":
+		return ParamError{"folderUpdate", "name"}
+	}
+	return nil
+}
+
+func (a *API) FolderUpdateWithParams(ctx context.Context, params *FolderUpdateParams) (bool, error) {
+	parameters, err := ToParameters(params)
+	if err != nil {
+		return false, err
+	}
+	return a.FolderUpdateContext(ctx, parameters)
+}
+
+type FoldersResultItem struct {
+	FolderID    FlexInt   `json:"folder_id"`
+	Name        string    `json:"name"`
+	DateCreated ChimpTime `json:"date_created"`
+	Type        string    `json:"type"`
+}
+
+//Folders gets the folders of campaigns or autoresponders
+//http://apidocs.mailchimp.com/api/1.3/folders.func.php
+func (a *API) Folders(parameters map[string]interface{}) ([]FoldersResultItem, error) {
+	return a.FoldersContext(context.Background(), parameters)
+}
+
+func (a *API) FoldersContext(ctx context.Context, parameters map[string]interface{}) (retVal []FoldersResultItem, err error) {
+	err = parseJson(ctx, a, "folders", parameters, &retVal)
+	return
+}
+
+//FoldersParams are the parameters of Folders
+//http://apidocs.mailchimp.com/api/1.3/folders.func.php
+type FoldersParams struct {
+	Type string `json:"type,omitempty"`
+}
+
+func (p *FoldersParams) Validate() error {
+	return nil
+}
+
+func (a *API) FoldersWithParams(ctx context.Context, params *FoldersParams) ([]FoldersResultItem, error) {
+	parameters, err := ToParameters(params)
+	if err != nil {
+		return nil, err
+	}
+	return a.FoldersContext(ctx, parameters)
+}
+
+//GenerateText generates the plain text version of html content
+//http://apidocs.mailchimp.com/api/1.3/generatetext.func.php
+func (a *API) GenerateText(parameters map[string]interface{}) (string, error) {
+	return a.GenerateTextContext(context.Background(), parameters)
+}
+
+func (a *API) GenerateTextContext(ctx context.Context, parameters map[string]interface{}) (string, error) {
+	return parseString(ctx, a, "generateText", parameters)
+}
+
+//GenerateTextParams are the parameters of GenerateText
+//http://apidocs.mailchimp.com/api/1.3/generatetext.func.php
+type GenerateTextParams struct {
+	Type    string      `json:"type,omitempty"`
+	Content interface{} `json:"content,omitempty"`
+}
+
+func (p *GenerateTextParams) Validate() error {
+	switch {
+	case p.Type == "":
+		return ParamError{"generateText", "type"}
+	case p.Content == nil:
+		return ParamError{"generateText", "content"}
+	}
+	return nil
+}
+
+func (a *API) GenerateTextWithParams(ctx context.Context, params *GenerateTextParams) (string, error) {
+	parameters, err := ToParameters(params)
+	if err != nil {
+		return "", err
+	}
+	return a.GenerateTextContext(ctx, parameters)
+}
+
+type GetAccountDetailsResultContact struct {
+	Fname    string `json:"fname"`
+	Lname    string `json:"lname"`
+	Email    string `json:"email"`
+	Company  string `json:"company"`
+	Address1 string `json:"address1"`
+	Address2 string `json:"address2"`
+	City     string `json:"city"`
+	State    string `json:"state"`
+	Zip      string `json:"zip"`
+	Country  string `json:"country"`
+	URL      string `json:"url"`
+	Phone    string `json:"phone"`
+	Fax      string `json:"fax"`
+}
+
+type GetAccountDetailsResultModulesItem struct {
+	Name  string    `json:"name"`
+	Added ChimpTime `json:"added"`
+}
+
+type GetAccountDetailsResultOrdersItem struct {
+	OrderID     FlexInt   `json:"order_id"`
+	Type        string    `json:"type"`
+	Amount      FlexFloat `json:"amount"`
+	Date        ChimpTime `json:"date"`
+	CreditsUsed FlexFloat `json:"credits_used"`
+}
+
+type GetAccountDetailsResultRewardsCredits struct {
+	ThisMonth   FlexInt `json:"this_month"`
+	TotalEarned FlexInt `json:"total_earned"`
+	Remaining   FlexInt `json:"remaining"`
+}
+
+type GetAccountDetailsResultRewardsInspections struct {
+	ThisMonth   FlexInt `json:"this_month"`
+	TotalEarned FlexInt `json:"total_earned"`
+	Remaining   FlexInt `json:"remaining"`
+}
+
+type GetAccountDetailsResultRewardsReferralsItem struct {
+	Name       string    `json:"name"`
+	Email      string    `json:"email"`
+	SignupDate ChimpTime `json:"signup_date"`
+	Type       string    `json:"type"`
+}
+
+type GetAccountDetailsResultRewardsAppliedItem struct {
+	Value     FlexInt   `json:"value"`
+	Date      ChimpTime `json:"date"`
+	OrderID   FlexInt   `json:"order_id"`
+	OrderDesc string    `json:"order_desc"`
+}
+
+type GetAccountDetailsResultRewards struct {
+	ReferralsThisMonth FlexInt                                       `json:"referrals_this_month"`
+	NotifyOn           string                                        `json:"notify_on"`
+	NotifyEmail        string                                        `json:"notify_email"`
+	Credits            GetAccountDetailsResultRewardsCredits         `json:"credits"`
+	Inspections        GetAccountDetailsResultRewardsInspections     `json:"inspections"`
+	Referrals          []GetAccountDetailsResultRewardsReferralsItem `json:"referrals"`
+	Applied            []GetAccountDetailsResultRewardsAppliedItem   `json:"applied"`
+}
+
+type GetAccountDetailsResult struct {
+	Username       string                               `json:"username"`
+	UserID         string                               `json:"user_id"`
+	IsTrial        FlexBool                             `json:"is_trial"`
+	IsApproved     FlexBool                             `json:"is_approved"`
+	HasActivated   FlexBool                             `json:"has_activated"`
+	Timezone       string                               `json:"timezone"`
+	PlanType       string                               `json:"plan_type"`
+	PlanLow        FlexInt                              `json:"plan_low"`
+	PlanHigh       FlexInt                              `json:"plan_high"`
+	PlanStartDate  ChimpTime                            `json:"plan_start_date"`
+	EmailsLeft     FlexInt                              `json:"emails_left"`
+	PendingMonthly FlexBool                             `json:"pending_monthly"`
+	FirstPayment   ChimpTime                            `json:"first_payment"`
+	LastPayment    ChimpTime                            `json:"last_payment"`
+	TimesLoggedIn  FlexInt                              `json:"times_logged_in"`
+	LastLogin      ChimpTime                            `json:"last_login"`
+	AffiliateLink  string                               `json:"affiliate_link"`
+	Contact        GetAccountDetailsResultContact       `json:"contact"`
+	Modules        []GetAccountDetailsResultModulesItem `json:"modules"`
+	Orders         []GetAccountDetailsResultOrdersItem  `json:"orders"`
+	Rewards        GetAccountDetailsResultRewards       `json:"rewards"`
+}
+
+//GetAccountDetails gets the details of the account the API key belongs to
+//http://apidocs.mailchimp.com/api/1.3/getaccountdetails.func.php
+func (a *API) GetAccountDetails(parameters map[string]interface{}) (*GetAccountDetailsResult, error) {
+	return a.GetAccountDetailsContext(context.Background(), parameters)
+}
+
+func (a *API) GetAccountDetailsContext(ctx context.Context, parameters map[string]interface{}) (retVal *GetAccountDetailsResult, err error) {
+	retVal = new(GetAccountDetailsResult)
+	err = parseJson(ctx, a, "getAccountDetails", parameters, retVal)
+	return
+}
+
+//GetAccountDetailsParams are the parameters of GetAccountDetails
+//http://apidocs.mailchimp.com/api/1.3/getaccountdetails.func.php
+type GetAccountDetailsParams struct {
+	Exclude []string `json:"exclude,omitempty"`
+}
+
+func (p *GetAccountDetailsParams) Validate() error {
+	return nil
+}
+
+func (a *API) GetAccountDetailsWithParams(ctx context.Context, params *GetAccountDetailsParams) (*GetAccountDetailsResult, error) {
+	parameters, err := ToParameters(params)
+	if err != nil {
+		return nil, err
+	}
+	return a.GetAccountDetailsContext(ctx, parameters)
+}
+
+type GetVerifiedDomainsResultItem struct {
+	Domain string `json:"domain"`
+	Status string `json:"status"`
+	Emails string `json:"emails"`
+}
+
+//GetVerifiedDomains gets the domains verified for sending from the account
+//http://apidocs.mailchimp.com/api/1.3/getverifieddomains.func.php
+func (a *API) GetVerifiedDomains(parameters map[string]interface{}) ([]GetVerifiedDomainsResultItem, error) {
+	return a.GetVerifiedDomainsContext(context.Background(), parameters)
+}
+
+func (a *API) GetVerifiedDomainsContext(ctx context.Context, parameters map[string]interface{}) (retVal []GetVerifiedDomainsResultItem, err error) {
+	err = parseJson(ctx, a, "getVerifiedDomains", parameters, &retVal)
+	return
+}
+
+type GmonkeyActivityResultItemGeo struct {
+	Latitude  string `json:"latitude"`
+	Longitude string `json:"longitude"`
+	Gmtoff    string `json:"gmtoff"`
+	Dstoff    string `json:"dstoff"`
+	Timezone  string `json:"timezone"`
+	Cc        string `json:"cc"`
+	Region    string `json:"region"`
+}
+
+type GmonkeyActivityResultItem struct {
+	Action       string                       `json:"action"`
+	Timestamp    ChimpTime                    `json:"timestamp"`
+	URL          string                       `json:"url"`
+	UniqueID     string                       `json:"unique_id"`
+	Title        string                       `json:"title"`
+	ListName     string                       `json:"list_name"`
+	Email        string                       `json:"email"`
+	Fname        string                       `json:"fname"`
+	Lname        string                       `json:"lname"`
+	MemberRating FlexInt                      `json:"member_rating"`
+	MemberSince  ChimpTime                    `json:"member_since"`
+	Geo          GmonkeyActivityResultItemGeo `json:"geo"`
+}
+
+//GmonkeyActivity gets the recent activity of the account's Golden Monkeys
+//http://apidocs.mailchimp.com/api/1.3/gmonkeyactivity.func.php
+func (a *API) GmonkeyActivity(parameters map[string]interface{}) ([]GmonkeyActivityResultItem, error) {
+	return a.GmonkeyActivityContext(context.Background(), parameters)
+}
+
+func (a *API) GmonkeyActivityContext(ctx context.Context, parameters map[string]interface{}) (retVal []GmonkeyActivityResultItem, err error) {
+	err = parseJson(ctx, a, "gmonkeyActivity", parameters, &retVal)
+	return
+}
+
+type GmonkeyAddResultDataItem struct {
+	EmailAddress string `json:"email_address"`
+	Error        string `json:"error"`
+}
+
+type GmonkeyAddResult struct {
+	Success FlexInt                    `json:"success"`
+	Errors  FlexInt                    `json:"errors"`
+	Data    []GmonkeyAddResultDataItem `json:"data"`
+}
+
+//GmonkeyAdd adds members of a list to the Golden Monkeys
+//http://apidocs.mailchimp.com/api/1.3/gmonkeyadd.func.php
+func (a *API) GmonkeyAdd(parameters map[string]interface{}) (*GmonkeyAddResult, error) {
+	return a.GmonkeyAddContext(context.Background(), parameters)
+}
+
+func (a *API) GmonkeyAddContext(ctx context.Context, parameters map[string]interface{}) (retVal *GmonkeyAddResult, err error) {
+	retVal = new(GmonkeyAddResult)
+	err = parseJson(ctx, a, "gmonkeyAdd", parameters, retVal)
+	return
+}
+
+//GmonkeyAddParams are the parameters of GmonkeyAdd
+//http://apidocs.mailchimp.com/api/1.3/gmonkeyadd.func.php
+type GmonkeyAddParams struct {
+	CID          string   `json:"cid,omitempty"`
+	ID           string   `json:"id,omitempty"`
+	EmailAddress []string `json:"email_address,omitempty"`
+}
+
+func (p *GmonkeyAddParams) Validate() error {
+	switch {
+	case p.CID == "":
+		return ParamError{"gmonkeyAdd", "cid"}
+	case p.ID == "":
+		return ParamError{"gmonkeyAdd", "id"}
+	case len(p.EmailAddress) == 0:
+		return ParamError{"gmonkeyAdd", "email_address"}
+	}
+	return nil
+}
+
+func (a *API) GmonkeyAddWithParams(ctx context.Context, params *GmonkeyAddParams) (*GmonkeyAddResult, error) {
+	parameters, err := ToParameters(params)
+	if err != nil {
+		return nil, err
+	}
+	return a.GmonkeyAddContext(ctx, parameters)
+}
+
+type GmonkeyDelResultDataItem struct {
+	EmailAddress string `json:"email_address"`
+	Error        string `json:"error"`
+}
+
+type GmonkeyDelResult struct {
+	Success FlexInt                    `json:"success"`
+	Errors  FlexInt                    `json:"errors"`
+	Data    []GmonkeyDelResultDataItem `json:"data"`
+}
+
+//GmonkeyDel removes members of a list from the Golden Monkeys
+//http://apidocs.mailchimp.com/api/1.3/gmonkeydel.func.php
+func (a *API) GmonkeyDel(parameters map[string]interface{}) (*GmonkeyDelResult, error) {
+	return a.GmonkeyDelContext(context.Background(), parameters)
+}
+
+func (a *API) GmonkeyDelContext(ctx context.Context, parameters map[string]interface{}) (retVal *GmonkeyDelResult, err error) {
+	retVal = new(GmonkeyDelResult)
+	err = parseJson(ctx, a, "gmonkeyDel", parameters, retVal)
+	return
+}
+
+//GmonkeyDelParams are the parameters of GmonkeyDel
+//http://apidocs.mailchimp.com/api/1.3/gmonkeydel.func.php
+type GmonkeyDelParams struct {
+	CID          string   `json:"cid,omitempty"`
+	ID           string   `json:"id,omitempty"`
+	EmailAddress []string `json:"email_address,omitempty"`
+}
+
+func (p *GmonkeyDelParams) Validate() error {
+	switch {
+	case p.CID == "":
+		return ParamError{"gmonkeyDel", "cid"}
+	case p.ID == "":
+		return ParamError{"gmonkeyDel", "id"}
+	case len(p.EmailAddress) == 0:
+		return ParamError{"gmonkeyDel", "email_address"}
+	}
+	return nil
+}
+
+func (a *API) GmonkeyDelWithParams(ctx context.Context, params *GmonkeyDelParams) (*GmonkeyDelResult, error) {
+	parameters, err := ToParameters(params)
+	if err != nil {
+		return nil, err
+	}
+	return a.GmonkeyDelContext(ctx, parameters)
+}
+
+type GmonkeyMembersItem struct {
+	ListID       string    `json:"list_id"`
+	ListName     string    `json:"list_name"`
+	Email        string    `json:"email"`
+	Fname        string    `json:"fname"`
+	Lname        string    `json:"lname"`
+	MemberRating FlexInt   `json:"member_rating"`
+	MemberSince  ChimpTime `json:"member_since"`
+}
+
+//GmonkeyMembers gets the members of the account's lists who are Golden Monkeys
+//http://apidocs.mailchimp.com/api/1.3/gmonkeymembers.func.php
+func (a *API) GmonkeyMembers(parameters map[string]interface{}) ([]GmonkeyMembersItem, error) {
+	return a.GmonkeyMembersContext(context.Background(), parameters)
+}
+
+func (a *API) GmonkeyMembersContext(ctx context.Context, parameters map[string]interface{}) (retVal []GmonkeyMembersItem, err error) {
+	err = parseJson(ctx, a, "gmonkeyMembers", parameters, &retVal)
+	return
+}
+
+//InlineCss moves the css of html content into the style attributes of its tags
+//http://apidocs.mailchimp.com/api/1.3/inlinecss.func.php
+func (a *API) InlineCss(parameters map[string]interface{}) (string, error) {
+	return a.InlineCssContext(context.Background(), parameters)
+}
+
+func (a *API) InlineCssContext(ctx context.Context, parameters map[string]interface{}) (string, error) {
+	return parseString(ctx, a, "inlineCss", parameters)
+}
+
+//InlineCssParams are the parameters of InlineCss
+//http://apidocs.mailchimp.com/api/1.3/inlinecss.func.php
+type InlineCssParams struct {
+	HTML     string `json:"html,omitempty"`
+	StripCSS bool   `json:"strip_css,omitempty"`
+}
+
+func (p *InlineCssParams) Validate() error {
+	switch {
+	case p.HTML == "":
+		return ParamError{"inlineCss", "html"}
+	}
+	return nil
+}
+
+func (a *API) InlineCssWithParams(ctx context.Context, params *InlineCssParams) (string, error) {
+	parameters, err := ToParameters(params)
+	if err != nil {
+		return "", err
+	}
+	return a.InlineCssContext(ctx, parameters)
+}
+
+type ListAbuseReportsResponseDataItem struct {
+	Date       ChimpTime `json:"date"`
+	Email      string    `json:"email"`
+	CampaignID string    `json:"campaign_id"`
+	Type       string    `json:"type"`
+}
+
+//ListAbuseReportsResponse is the type for values returned from the
+//ListAbuseReports method
+type ListAbuseReportsResponse struct {
+	Total FlexInt                            `json:"total"`
+	Data  []ListAbuseReportsResponseDataItem `json:"data"`
+}
+
+//ListAbuseReports gets the email addresses that complained about campaigns sent
+//to a list
+//http://apidocs.mailchimp.com/api/1.3/listabusereports.func.php
+func (a *API) ListAbuseReports(parameters map[string]interface{}) (*ListAbuseReportsResponse, error) {
+	return a.ListAbuseReportsContext(context.Background(), parameters)
+}
+
+func (a *API) ListAbuseReportsContext(ctx context.Context, parameters map[string]interface{}) (retVal *ListAbuseReportsResponse, err error) {
+	retVal = new(ListAbuseReportsResponse)
+	err = parseJson(ctx, a, "listAbuseReports", timeParameters(parameters, "since"), retVal)
+	return
+}
+
+//ListAbuseReportsParams are the parameters of ListAbuseReports
+//http://apidocs.mailchimp.com/api/1.3/listabusereports.func.php
+type ListAbuseReportsParams struct {
+	ID    string    `json:"id,omitempty"`
+	Start int       `json:"start,omitempty"`
+	Limit int       `json:"limit,omitempty"`
+	Since ChimpTime `json:"since,omitempty"`
+}
+
+func (p *ListAbuseReportsParams) Validate() error {
+	switch {
+	case p.ID == "":
+		return ParamError{"listAbuseReports", "id"}
+	}
+	return nil
+}
+
+func (a *API) ListAbuseReportsWithParams(ctx context.Context, params *ListAbuseReportsParams) (*ListAbuseReportsResponse, error) {
+	parameters, err := ToParameters(params)
+	if err != nil {
+		return nil, err
+	}
+	return a.ListAbuseReportsContext(ctx, parameters)
+}
+
+//ListAbuseReportsPager walks every page of ListAbuseReports
+func (a *API) ListAbuseReportsPager(ctx context.Context, parameters map[string]interface{}) *Pager[ListAbuseReportsResponseDataItem] {
+	return newPager(ctx, "listAbuseReports", parameters, fetchPage[ListAbuseReportsResponseDataItem](a, "listAbuseReports"))
+}
+
+//ListActivityElement is the type of elements in the slice returned from the
+//ListActivity method
+type ListActivityElement struct {
+	//not documented in the api docs; not sure what it means
+	UserID          FlexInt   `json:"user_id"`
+	Day             ChimpTime `json:"day"`
+	EmailsSent      FlexInt   `json:"emails_sent"`
+	UniqueOpens     FlexInt   `json:"unique_opens"`
+	RecipientClicks FlexInt   `json:"recipient_clicks"`
+	HardBounce      FlexInt   `json:"hard_bounce"`
+	SoftBounce      FlexInt   `json:"soft_bounce"`
+	AbuseReports    FlexInt   `json:"abuse_reports"`
+	Subs            FlexInt   `json:"subs"`
+	Unsubs          FlexInt   `json:"unsubs"`
+	OtherAdds       FlexInt   `json:"other_adds"`
+	OtherRemoves    FlexInt   `json:"other_removes"`
+}
+
+//ListActivity accesses up to the previous 180 days of daily detailed aggregated
+//activity stats for a given list
+//http://apidocs.mailchimp.com/api/1.3/listactivity.func.php
+func (a *API) ListActivity(parameters map[string]interface{}) ([]ListActivityElement, error) {
+	return a.ListActivityContext(context.Background(), parameters)
+}
+
+func (a *API) ListActivityContext(ctx context.Context, parameters map[string]interface{}) (retVal []ListActivityElement, err error) {
+	err = parseJson(ctx, a, "listActivity", parameters, &retVal)
+	return
+}
+
+//ListActivityParams are the parameters of ListActivity
+//http://apidocs.mailchimp.com/api/1.3/listactivity.func.php
+type ListActivityParams struct {
+	ID string `json:"id,omitempty"`
+}
+
+func (p *ListActivityParams) Validate() error {
+	switch {
+	case p.ID == "":
+		return ParamError{"listActivity", "id"}
+	}
+	return nil
+}
+
+func (a *API) ListActivityWithParams(ctx context.Context, params *ListActivityParams) ([]ListActivityElement, error) {
+	parameters, err := ToParameters(params)
+	if err != nil {
+		return nil, err
+	}
+	return a.ListActivityContext(ctx, parameters)
+}
+
+type ListBatchSubscribeResponseErrorsItem struct {
+	Email   string  `json:"email"`
+	Code    FlexInt `json:"code"`
+	Message string  `json:"message"`
+}
+
+//ListBatchSubscribeResponse is the type for values returned from the
+//ListBatchSubscribe method
+type ListBatchSubscribeResponse struct {
+	AddCount    FlexInt                                `json:"add_count"`
+	UpdateCount FlexInt                                `json:"update_count"`
+	ErrorCount  FlexInt                                `json:"error_count"`
+	Errors      []ListBatchSubscribeResponseErrorsItem `json:"errors"`
+}
+
+//ListBatchSubscribe subscribes a batch of email addresses to a list at once.
+//Batches should be limited to 5k - 10k records.
+//http://apidocs.mailchimp.com/api/1.3/listbatchsubscribe.func.php
+func (a *API) ListBatchSubscribe(parameters map[string]interface{}) (*ListBatchSubscribeResponse, error) {
+	return a.ListBatchSubscribeContext(context.Background(), parameters)
+}
+
+func (a *API) ListBatchSubscribeContext(ctx context.Context, parameters map[string]interface{}) (retVal *ListBatchSubscribeResponse, err error) {
+	retVal = new(ListBatchSubscribeResponse)
+	err = parseJson(ctx, a, "listBatchSubscribe", parameters, retVal)
+	return
+}
+
+//ListBatchSubscribeParams are the parameters of ListBatchSubscribe
+//http://apidocs.mailchimp.com/api/1.3/listbatchsubscribe.func.php
+type ListBatchSubscribeParams struct {
+	ID               string                   `json:"id,omitempty"`
+	Batch            []map[string]interface{} `json:"batch,omitempty"`
+	DoubleOptin      *bool                    `json:"double_optin,omitempty"`
+	UpdateExisting   bool                     `json:"update_existing,omitempty"`
+	ReplaceInterests *bool                    `json:"replace_interests,omitempty"`
+}
+
+func (p *ListBatchSubscribeParams) Validate() error {
+	switch {
+	case p.ID == "":
+		return ParamError{"listBatchSubscribe", "id"}
+	case len(p.Batch) == 0:
+		return ParamError{"listBatchSubscribe", "batch"}
+	}
+	return nil
+}
+
+func (a *API) ListBatchSubscribeWithParams(ctx context.Context, params *ListBatchSubscribeParams) (*ListBatchSubscribeResponse, error) {
+	parameters, err := ToParameters(params)
+	if err != nil {
+		return nil, err
+	}
+	return a.ListBatchSubscribeContext(ctx, parameters)
+}
+
+type ListBatchUnsubscribeResponseErrorsItem struct {
+	Email   string  `json:"email"`
+	Code    FlexInt `json:"code"`
+	Message string  `json:"message"`
+}
+
+//ListBatchUnsubscribeResponse is the type for values returned from the
+//ListBatchUnsubscribe method
+type ListBatchUnsubscribeResponse struct {
+	SuccessCount FlexInt                                  `json:"success_count"`
+	ErrorCount   FlexInt                                  `json:"error_count"`
+	Errors       []ListBatchUnsubscribeResponseErrorsItem `json:"errors"`
+}
+
+//ListBatchUnsubscribe unsubscribes a batch of email addresses from a list
+//http://apidocs.mailchimp.com/api/1.3/listbatchunsubscribe.func.php
+func (a *API) ListBatchUnsubscribe(parameters map[string]interface{}) (*ListBatchUnsubscribeResponse, error) {
+	return a.ListBatchUnsubscribeContext(context.Background(), parameters)
+}
+
+func (a *API) ListBatchUnsubscribeContext(ctx context.Context, parameters map[string]interface{}) (retVal *ListBatchUnsubscribeResponse, err error) {
+	retVal = new(ListBatchUnsubscribeResponse)
+	err = parseJson(ctx, a, "listBatchUnsubscribe", parameters, retVal)
+	return
+}
+
+//ListBatchUnsubscribeParams are the parameters of ListBatchUnsubscribe
+//http://apidocs.mailchimp.com/api/1.3/listbatchunsubscribe.func.php
+type ListBatchUnsubscribeParams struct {
+	ID           string   `json:"id,omitempty"`
+	Emails       []string `json:"emails,omitempty"`
+	DeleteMember bool     `json:"delete_member,omitempty"`
+	SendGoodbye  *bool    `json:"send_goodbye,omitempty"`
+	SendNotify   bool     `json:"send_notify,omitempty"`
+}
+
+func (p *ListBatchUnsubscribeParams) Validate() error {
+	switch {
+	case p.ID == "":
+		return ParamError{"listBatchUnsubscribe", "id"}
+	case len(p.Emails) == 0:
+		return ParamError{"listBatchUnsubscribe", "emails"}
+	}
+	return nil
+}
+
+func (a *API) ListBatchUnsubscribeWithParams(ctx context.Context, params *ListBatchUnsubscribeParams) (*ListBatchUnsubscribeResponse, error) {
+	parameters, err := ToParameters(params)
+	if err != nil {
+		return nil, err
+	}
+	return a.ListBatchUnsubscribeContext(ctx, parameters)
+}
+
+//ListClient is an email client. Mailchimp sends the members property as a
+//string, which FlexInt accepts.
+type ListClient struct {
+	Client  string    `json:"client"`
+	Icon    string    `json:"icon"`
+	Percent FlexFloat `json:"percent"`
+	Members FlexInt   `json:"members"`
+}
+
+//ListClientsPlatform is the clients of either desktop or mobile subscribers
+type ListClientsPlatform struct {
+	Penetration FlexFloat    `json:"penetration"`
+	Clients     []ListClient `json:"clients"`
+}
+
+//ListClientsResponse is the type for values returned from method ListClients
+type ListClientsResponse struct {
+	Desktop ListClientsPlatform `json:"desktop"`
+	Mobile  ListClientsPlatform `json:"mobile"`
+}
+
+//ListClients retrieves the clients that the list's subscribers have been tagged
+//as being used based on user agents seen e.g. hotmail or iPhone
+//http://apidocs.mailchimp.com/api/1.3/listclients.func.php
+func (a *API) ListClients(parameters map[string]interface{}) (*ListClientsResponse, error) {
+	return a.ListClientsContext(context.Background(), parameters)
+}
+
+func (a *API) ListClientsContext(ctx context.Context, parameters map[string]interface{}) (retVal *ListClientsResponse, err error) {
+	retVal = new(ListClientsResponse)
+	err = parseJson(ctx, a, "listClients", parameters, retVal)
+	return
+}
+
+//ListClientsParams are the parameters of ListClients
+//http://apidocs.mailchimp.com/api/1.3/listclients.func.php
+type ListClientsParams struct {
+	ID string `json:"id,omitempty"`
+}
+
+func (p *ListClientsParams) Validate() error {
+	switch {
+	case p.ID == "":
+		return ParamError{"listClients", "id"}
+	}
+	return nil
+}
+
+func (a *API) ListClientsWithParams(ctx context.Context, params *ListClientsParams) (*ListClientsResponse, error) {
+	parameters, err := ToParameters(params)
+	if err != nil {
+		return nil, err
+	}
+	return a.ListClientsContext(ctx, parameters)
+}
+
+type ListGrowthHistoryElement struct {
+	Month    ChimpTime `json:"month"`
+	Existing FlexInt   `json:"existing"`
+	Imports  FlexInt   `json:"imports"`
+	Optins   FlexInt   `json:"optins"`
+}
+
+//ListGrowthHistoryResponse is the type for values returned by the
+//ListGrowthHistory method. Mailchimp sends the existing, imports, and optins
+//properties as strings, which FlexInt accepts.
+type ListGrowthHistoryResponse []ListGrowthHistoryElement
+
+//ListGrowthHistory accesses the growth history by month for a given list
+//http://apidocs.mailchimp.com/api/1.3/listgrowthhistory.func.php
+func (a *API) ListGrowthHistory(parameters map[string]interface{}) (*ListGrowthHistoryResponse, error) {
+	return a.ListGrowthHistoryContext(context.Background(), parameters)
+}
+
+func (a *API) ListGrowthHistoryContext(ctx context.Context, parameters map[string]interface{}) (retVal *ListGrowthHistoryResponse, err error) {
+	retVal = new(ListGrowthHistoryResponse)
+	err = parseJson(ctx, a, "listGrowthHistory", parameters, retVal)
+	return
+}
+
+//ListGrowthHistoryParams are the parameters of ListGrowthHistory
+//http://apidocs.mailchimp.com/api/1.3/listgrowthhistory.func.php
+type ListGrowthHistoryParams struct {
+	ID string `json:"id,omitempty"`
+}
+
+func (p *ListGrowthHistoryParams) Validate() error {
+	switch {
+	case p.ID == "":
+		return ParamError{"listGrowthHistory", "id"}
+	}
+	return nil
+}
+
+func (a *API) ListGrowthHistoryWithParams(ctx context.Context, params *ListGrowthHistoryParams) (*ListGrowthHistoryResponse, error) {
+	parameters, err := ToParameters(params)
+	if err != nil {
+		return nil, err
+	}
+	return a.ListGrowthHistoryContext(ctx, parameters)
+}
+
+//ListInterestGroupAdd adds a single interest group, enabling interest groups
+//for the list if necessary
+//http://apidocs.mailchimp.com/api/1.3/listinterestgroupadd.func.php
+func (a *API) ListInterestGroupAdd(parameters map[string]interface{}) (bool, error) {
+	return a.ListInterestGroupAddContext(context.Background(), parameters)
+}
+
+func (a *API) ListInterestGroupAddContext(ctx context.Context, parameters map[string]interface{}) (bool, error) {
+	return parseBoolean(ctx, a, "listInterestGroupAdd", parameters)
+}
+
+//ListInterestGroupAddParams are the parameters of ListInterestGroupAdd
+//http://apidocs.mailchimp.com/api/1.3/listinterestgroupadd.func.php
+type ListInterestGroupAddParams struct {
+	ID         string `json:"id,omitempty"`
+	GroupName  string `json:"group_name,omitempty"`
+	GroupingID int    `json:"grouping_id,omitempty"`
+}
+
+func (p *ListInterestGroupAddParams) Validate() error {
+	switch {
+	case p.ID == "":
+		return ParamError{"listInterestGroupAdd", "id"}
+	case p.GroupName == "":
+		return ParamError{"listInterestGroupAdd", "group_name"}
+	}
+	return nil
+}
+
+func (a *API) ListInterestGroupAddWithParams(ctx context.Context, params *ListInterestGroupAddParams) (bool, error) {
+	parameters, err := ToParameters(params)
+	if err != nil {
+		return false, err
+	}
+	return a.ListInterestGroupAddContext(ctx, parameters)
+}
+
+//ListInterestGroupDel deletes a single interest group and turns off groups for
+//the list if it was the last group
+//http://apidocs.mailchimp.com/api/1.3/listinterestgroupdel.func.php
+func (a *API) ListInterestGroupDel(parameters map[string]interface{}) (bool, error) {
+	return a.ListInterestGroupDelContext(context.Background(), parameters)
+}
+
+func (a *API) ListInterestGroupDelContext(ctx context.Context, parameters map[string]interface{}) (bool, error) {
+	return parseBoolean(ctx, a, "listInterestGroupDel", parameters)
+}
+
+//ListInterestGroupDelParams are the parameters of ListInterestGroupDel
+//http://apidocs.mailchimp.com/api/1.3/listinterestgroupdel.func.php
+type ListInterestGroupDelParams struct {
+	ID         string `json:"id,omitempty"`
+	GroupName  string `json:"group_name,omitempty"`
+	GroupingID int    `json:"grouping_id,omitempty"`
+}
+
+func (p *ListInterestGroupDelParams) Validate() error {
+	switch {
+	case p.ID == "":
+		return ParamError{"listInterestGroupDel", "id"}
+	case p.GroupName == "":
+		return ParamError{"listInterestGroupDel", "group_name"}
+	}
+	return nil
+}
+
+func (a *API) ListInterestGroupDelWithParams(ctx context.Context, params *ListInterestGroupDelParams) (bool, error) {
+	parameters, err := ToParameters(params)
+	if err != nil {
+		return false, err
+	}
+	return a.ListInterestGroupDelContext(ctx, parameters)
+}
+
+//ListInterestGroupUpdate changes the name of an interest group
+//http://apidocs.mailchimp.com/api/1.3/listinterestgroupupdate.func.php
+func (a *API) ListInterestGroupUpdate(parameters map[string]interface{}) (bool, error) {
+	return a.ListInterestGroupUpdateContext(context.Background(), parameters)
+}
+
+func (a *API) ListInterestGroupUpdateContext(ctx context.Context, parameters map[string]interface{}) (bool, error) {
+	return parseBoolean(ctx, a, "listInterestGroupUpdate", parameters)
+}
+
+//ListInterestGroupUpdateParams are the parameters of ListInterestGroupUpdate
+//http://apidocs.mailchimp.com/api/1.3/listinterestgroupupdate.func.php
+type ListInterestGroupUpdateParams struct {
+	ID         string `json:"id,omitempty"`
+	OldName    string `json:"old_name,omitempty"`
+	NewName    string `json:"new_name,omitempty"`
+	GroupingID int    `json:"grouping_id,omitempty"`
+}
+
+func (p *ListInterestGroupUpdateParams) Validate() error {
+	switch {
+	case p.ID == "":
+		return ParamError{"listInterestGroupUpdate", "id"}
+	case p.OldName == "":
+		return ParamError{"listInterestGroupUpdate", "old_name"}
+	case p.NewName == "":
+		return ParamError{"listInterestGroupUpdate", "new_name"}
+	}
+	return nil
+}
+
+func (a *API) ListInterestGroupUpdateWithParams(ctx context.Context, params *ListInterestGroupUpdateParams) (bool, error) {
+	parameters, err := ToParameters(params)
+	if err != nil {
+		return false, err
+	}
+	return a.ListInterestGroupUpdateContext(ctx, parameters)
+}
+
+//ListInterestGroupingAdd adds a new interest grouping, automatically enabling
+//interest groups for the list if necessary, and returns its id
+//http://apidocs.mailchimp.com/api/1.3/listinterestgroupingadd.func.php
+func (a *API) ListInterestGroupingAdd(parameters map[string]interface{}) (int, error) {
+	return a.ListInterestGroupingAddContext(context.Background(), parameters)
+}
+
+func (a *API) ListInterestGroupingAddContext(ctx context.Context, parameters map[string]interface{}) (int, error) {
+	return parseInt(ctx, a, "listInterestGroupingAdd", parameters)
+}
+
+//ListInterestGroupingAddParams are the parameters of ListInterestGroupingAdd
+//http://apidocs.mailchimp.com/api/1.3/listinterestgroupingadd.func.php
+type ListInterestGroupingAddParams struct {
+	ID     string   `json:"id,omitempty"`
+	Name   string   `json:"name,omitempty"`
+	Type   string   `json:"type,omitempty"`
+	Groups []string `json:"groups,omitempty"`
+}
+
+func (p *ListInterestGroupingAddParams) Validate() error {
+	switch {
+	case p.ID == "":
+		return ParamError{"listInterestGroupingAdd", "id"}
+	case p.Name == "":
+		return ParamError{"listInterestGroupingAdd", "name"}
+	case p.Type == "":
+		return ParamError{"listInterestGroupingAdd", "type"}
+	case len(p.Groups) == 0:
+		return ParamError{"listInterestGroupingAdd", "groups"}
+	}
+	return nil
+}
+
+func (a *API) ListInterestGroupingAddWithParams(ctx context.Context, params *ListInterestGroupingAddParams) (int, error) {
+	parameters, err := ToParameters(params)
+	if err != nil {
+		return 0, err
+	}
+	return a.ListInterestGroupingAddContext(ctx, parameters)
+}
+
+//ListInterestGroupingDel deletes an existing interest grouping, including all
+//contained interest groups
+//http://apidocs.mailchimp.com/api/1.3/listinterestgroupingdel.func.php
+func (a *API) ListInterestGroupingDel(parameters map[string]interface{}) (bool, error) {
+	return a.ListInterestGroupingDelContext(context.Background(), parameters)
+}
+
+func (a *API) ListInterestGroupingDelContext(ctx context.Context, parameters map[string]interface{}) (bool, error) {
+	return parseBoolean(ctx, a, "listInterestGroupingDel", parameters)
+}
+
+//ListInterestGroupingDelParams are the parameters of ListInterestGroupingDel
+//http://apidocs.mailchimp.com/api/1.3/listinterestgroupingdel.func.php
+type ListInterestGroupingDelParams struct {
+	GroupingID int `json:"grouping_id,omitempty"`
+}
+
+func (p *ListInterestGroupingDelParams) Validate() error {
+	switch {
+	case p.GroupingID == 0:
+		return ParamError{"listInterestGroupingDel", "grouping_id"}
+	}
+	return nil
+}
+
+func (a *API) ListInterestGroupingDelWithParams(ctx context.Context, params *ListInterestGroupingDelParams) (bool, error) {
+	parameters, err := ToParameters(params)
+	if err != nil {
+		return false, err
+	}
+	return a.ListInterestGroupingDelContext(ctx, parameters)
+}
+
+//ListInterestGroupingUpdate updates an existing interest grouping
+//http://apidocs.mailchimp.com/api/1.3/listinterestgroupingupdate.func.php
+func (a *API) ListInterestGroupingUpdate(parameters map[string]interface{}) (bool, error) {
+	return a.ListInterestGroupingUpdateContext(context.Background(), parameters)
+}
+
+func (a *API) ListInterestGroupingUpdateContext(ctx context.Context, parameters map[string]interface{}) (bool, error) {
+	return parseBoolean(ctx, a, "listInterestGroupingUpdate", parameters)
+}
+
+//ListInterestGroupingUpdateParams are the parameters of ListInterestGroupingUpdate
+//http://apidocs.mailchimp.com/api/1.3/listinterestgroupingupdate.func.php
+type ListInterestGroupingUpdateParams struct {
+	GroupingID int    `json:"grouping_id,omitempty"`
+	Name       string `json:"name,omitempty"`
+	Value      string `json:"value,omitempty"`
+}
+
+func (p *ListInterestGroupingUpdateParams) Validate() error {
+	switch {
+	case p.GroupingID == 0:
+		return ParamError{"listInterestGroupingUpdate", "grouping_id"}
+	case p.Name == "":
+		return ParamError{"listInterestGroupingUpdate", "name"}
+	case p.Value == "":
+		return ParamError{"listInterestGroupingUpdate", "value"}
+	}
+	return nil
+}
+
+func (a *API) ListInterestGroupingUpdateWithParams(ctx context.Context, params *ListInterestGroupingUpdateParams) (bool, error) {
+	parameters, err := ToParameters(params)
+	if err != nil {
+		return false, err
+	}
+	return a.ListInterestGroupingUpdateContext(ctx, parameters)
+}
+
+type ListInterestGroupingsElementGroupsItem struct {
+	Bit          string  `json:"bit"`
+	Name         string  `json:"name"`
+	DisplayOrder string  `json:"display_order"`
+	Subscribers  FlexInt `json:"subscribers"`
+}
+
+//ListInterestGroupingsElement is the type of elements in the slice returned
+//from the ListInterestGroupings method
+type ListInterestGroupingsElement struct {
+	ID         FlexInt                                  `json:"id"`
+	Name       string                                   `json:"name"`
+	FormFields string                                   `json:"form_fields"`
+	Groups     []ListInterestGroupingsElementGroupsItem `json:"groups"`
+}
+
+//ListInterestGroupings gets the interest groupings of a list, including the
+//label, form information and groups of each
+//http://apidocs.mailchimp.com/api/1.3/listinterestgroupings.func.php
+func (a *API) ListInterestGroupings(parameters map[string]interface{}) ([]ListInterestGroupingsElement, error) {
+	return a.ListInterestGroupingsContext(context.Background(), parameters)
+}
+
+func (a *API) ListInterestGroupingsContext(ctx context.Context, parameters map[string]interface{}) (retVal []ListInterestGroupingsElement, err error) {
+	err = parseJson(ctx, a, "listInterestGroupings", parameters, &retVal)
+	return
+}
+
+//ListInterestGroupingsParams are the parameters of ListInterestGroupings
+//http://apidocs.mailchimp.com/api/1.3/listinterestgroupings.func.php
+type ListInterestGroupingsParams struct {
+	ID string `json:"id,omitempty"`
+}
+
+func (p *ListInterestGroupingsParams) Validate() error {
+	switch {
+	case p.ID == "":
+		return ParamError{"listInterestGroupings", "id"}
+	}
+	return nil
+}
+
+func (a *API) ListInterestGroupingsWithParams(ctx context.Context, params *ListInterestGroupingsParams) ([]ListInterestGroupingsElement, error) {
+	parameters, err := ToParameters(params)
+	if err != nil {
+		return nil, err
+	}
+	return a.ListInterestGroupingsContext(ctx, parameters)
+}
+
+//MemberActivity is something a member did, e.g. opening or clicking a link in a
+//campaign
+type MemberActivity struct {
+	//one of open, click, bounce, unsub, abuse, sent, ecomm, mandrill_send,
+	//mandrill_hard_bounce, mandrill_soft_bounce, mandrill_open, mandrill_click,
+	//mandrill_spam, mandrill_unsub or mandrill_reject
+	Action    string    `json:"action"`
+	Timestamp ChimpTime `json:"timestamp"`
+	//the link clicked, for click actions
+	URL string `json:"url"`
+	//hard or soft, for bounce actions
+	BounceType string `json:"bounce_type"`
+	CampaignID string `json:"campaign_id"`
+}
+
+//ListMemberActivityResponse is the type for values returned from the
+//ListMemberActivity method. Data holds the activities of each member, in the
+//order they were asked for.
+type ListMemberActivityResponse struct {
+	Success FlexInt            `json:"success"`
+	Errors  FlexInt            `json:"errors"`
+	Data    [][]MemberActivity `json:"data"`
+}
+
+//ListMemberActivity gets the most recent 100 activities, e.g. opens and clicks,
+//of up to 50 members of a list
+//http://apidocs.mailchimp.com/api/1.3/listmemberactivity.func.php
+func (a *API) ListMemberActivity(parameters map[string]interface{}) (*ListMemberActivityResponse, error) {
+	return a.ListMemberActivityContext(context.Background(), parameters)
+}
+
+func (a *API) ListMemberActivityContext(ctx context.Context, parameters map[string]interface{}) (retVal *ListMemberActivityResponse, err error) {
+	retVal = new(ListMemberActivityResponse)
+	err = parseJson(ctx, a, "listMemberActivity", parameters, retVal)
+	return
+}
+
+//ListMemberActivityParams are the parameters of ListMemberActivity
+//http://apidocs.mailchimp.com/api/1.3/listmemberactivity.func.php
+type ListMemberActivityParams struct {
+	ID string `json:"id,omitempty"`
+	//the members' email addresses, unique ids or euids
+	EmailAddress []string `json:"email_address,omitempty"`
+}
+
+func (p *ListMemberActivityParams) Validate() error {
+	switch {
+	case p.ID == "":
+		return ParamError{"listMemberActivity", "id"}
+	case len(p.EmailAddress) == 0:
+		return ParamError{"listMemberActivity", "email_address"}
+	}
+	return nil
+}
+
+func (a *API) ListMemberActivityWithParams(ctx context.Context, params *ListMemberActivityParams) (*ListMemberActivityResponse, error) {
+	parameters, err := ToParameters(params)
+	if err != nil {
+		return nil, err
+	}
+	return a.ListMemberActivityContext(ctx, parameters)
+}
+
+//MemberGeo is where a member is, as found from the addresses they opened
+//campaigns from
+type MemberGeo struct {
+	Latitude  FlexFloat `json:"latitude"`
+	Longitude FlexFloat `json:"longitude"`
+	//the offset of the member's timezone from GMT in hours
+	GMTOff FlexInt `json:"gmtoff"`
+	//the offset of the member's timezone from GMT in hours during daylight saving
+	//time
+	DSTOff      FlexInt `json:"dstoff"`
+	Timezone    string  `json:"timezone"`
+	CountryCode string  `json:"cc"`
+	Region      string  `json:"region"`
+}
+
+//MemberClient is the email client a member uses most
+type MemberClient struct {
+	Name    string `json:"name"`
+	IconURL string `json:"icon_url"`
+}
+
+//MemberStaticSegment is a static segment of a list a member is in
+type MemberStaticSegment struct {
+	ID    FlexInt   `json:"id"`
+	Name  string    `json:"name"`
+	Added ChimpTime `json:"added"`
+}
+
+//MemberNote is a note about a member
+type MemberNote struct {
+	ID            FlexInt   `json:"id"`
+	Note          string    `json:"note"`
+	Created       ChimpTime `json:"created"`
+	Updated       ChimpTime `json:"updated"`
+	CreatedByName string    `json:"created_by_name"`
+}
+
+//MemberInfo is the information about a member of a list
+type MemberInfo struct {
+	//the member's unique id
+	ID    string `json:"id"`
+	Email string `json:"email"`
+	//html, text or mobile
+	EmailType string    `json:"email_type"`
+	Merges    MergeVars `json:"merges"`
+	//subscribed, unsubscribed, cleaned or updated
+	Status          string    `json:"status"`
+	IPSignup        string    `json:"ip_signup"`
+	TimestampSignup ChimpTime `json:"timestamp_signup"`
+	IPOpt           string    `json:"ip_opt"`
+	TimestampOpt    ChimpTime `json:"timestamp_opt"`
+	//from 1 to 5
+	MemberRating FlexInt `json:"member_rating"`
+	//the campaign the member unsubscribed from, if they did
+	CampaignID string `json:"campaign_id"`
+	//the member's status in each of the account's other lists, keyed by list id
+	Lists MemberLists `json:"lists"`
+	//when the member's status last changed
+	Timestamp ChimpTime `json:"timestamp"`
+	//when the member's information last changed
+	InfoChanged ChimpTime `json:"info_changed"`
+	//identifies the member in the Mailchimp web app
+	WebID    FlexInt `json:"web_id"`
+	ListID   string  `json:"list_id"`
+	ListName string  `json:"list_name"`
+	Language string  `json:"language"`
+	//whether the member is a Golden Monkey
+	IsGmonkey      FlexBool              `json:"is_gmonkey"`
+	Geo            MemberGeo             `json:"geo"`
+	Clients        MemberClient          `json:"clients"`
+	StaticSegments []MemberStaticSegment `json:"static_segments"`
+	Notes          []MemberNote          `json:"notes"`
+	//the address asked for, if the member could not be found
+	EmailAddress string `json:"email_address"`
+	//why the member could not be found
+	Error string `json:"error"`
+}
+
+//ListMemberInfoResponse is the type for values returned from the ListMemberInfo
+//method. Data holds a MemberInfo for each member asked for, whose Error is set
+//if they could not be found.
+type ListMemberInfoResponse struct {
+	Success FlexInt      `json:"success"`
+	Errors  FlexInt      `json:"errors"`
+	Data    []MemberInfo `json:"data"`
+}
+
+//ListMemberInfo gets all the information about up to 50 members of a list
+//http://apidocs.mailchimp.com/api/1.3/listmemberinfo.func.php
+func (a *API) ListMemberInfo(parameters map[string]interface{}) (*ListMemberInfoResponse, error) {
+	return a.ListMemberInfoContext(context.Background(), parameters)
+}
+
+func (a *API) ListMemberInfoContext(ctx context.Context, parameters map[string]interface{}) (retVal *ListMemberInfoResponse, err error) {
+	retVal = new(ListMemberInfoResponse)
+	err = parseJson(ctx, a, "listMemberInfo", parameters, retVal)
+	return
+}
+
+//ListMemberInfoParams are the parameters of ListMemberInfo
+//http://apidocs.mailchimp.com/api/1.3/listmemberinfo.func.php
+type ListMemberInfoParams struct {
+	ID string `json:"id,omitempty"`
+	//the members' email addresses, unique ids or euids
+	EmailAddress []string `json:"email_address,omitempty"`
+}
+
+func (p *ListMemberInfoParams) Validate() error {
+	switch {
+	case p.ID == "":
+		return ParamError{"listMemberInfo", "id"}
+	case len(p.EmailAddress) == 0:
+		return ParamError{"listMemberInfo", "email_address"}
+	}
+	return nil
+}
+
+func (a *API) ListMemberInfoWithParams(ctx context.Context, params *ListMemberInfoParams) (*ListMemberInfoResponse, error) {
+	parameters, err := ToParameters(params)
+	if err != nil {
+		return nil, err
+	}
+	return a.ListMemberInfoContext(ctx, parameters)
+}
+
+//ListMember is a member of a list, as returned by ListMembers
+type ListMember struct {
+	Email string `json:"email"`
+	//when the member's status last changed
+	Timestamp ChimpTime `json:"timestamp"`
+	//why an unsubscribed or cleaned member left the list, e.g. NORMAL, NOSIGNUP,
+	//INAPPROPRIATE, SPAM or OTHER
+	Reason string `json:"reason"`
+	//the member's own reason, when reason is OTHER
+	ReasonText string `json:"reason_text"`
+}
+
+//ListMembersResponse is the type for values returned from the ListMembers
+//method
+type ListMembersResponse struct {
+	Total FlexInt      `json:"total"`
+	Data  []ListMember `json:"data"`
+}
+
+//ListMembers gets the email addresses of the members of a list with a given
+//status, optionally only those changed since a time
+//http://apidocs.mailchimp.com/api/1.3/listmembers.func.php
+func (a *API) ListMembers(parameters map[string]interface{}) (*ListMembersResponse, error) {
+	return a.ListMembersContext(context.Background(), parameters)
+}
+
+func (a *API) ListMembersContext(ctx context.Context, parameters map[string]interface{}) (retVal *ListMembersResponse, err error) {
+	retVal = new(ListMembersResponse)
+	err = parseJson(ctx, a, "listMembers", timeParameters(parameters, "since"), retVal)
+	return
+}
+
+//ListMembersParams are the parameters of ListMembers
+//http://apidocs.mailchimp.com/api/1.3/listmembers.func.php
+type ListMembersParams struct {
+	ID string `json:"id,omitempty"`
+	//subscribed, unsubscribed, cleaned or updated; subscribed by default
+	Status string `json:"status,omitempty"`
+	//only members whose data changed at or after this time are returned
+	Since ChimpTime `json:"since,omitempty"`
+	Start int       `json:"start,omitempty"`
+	Limit int       `json:"limit,omitempty"`
+}
+
+func (p *ListMembersParams) Validate() error {
+	switch {
+	case p.ID == "":
+		return ParamError{"listMembers", "id"}
+	}
+	return nil
+}
+
+func (a *API) ListMembersWithParams(ctx context.Context, params *ListMembersParams) (*ListMembersResponse, error) {
+	parameters, err := ToParameters(params)
+	if err != nil {
+		return nil, err
+	}
+	return a.ListMembersContext(ctx, parameters)
+}
+
+//ListMembersPager walks every page of ListMembers
+func (a *API) ListMembersPager(ctx context.Context, parameters map[string]interface{}) *Pager[ListMember] {
+	return newPager(ctx, "listMembers", parameters, fetchPage[ListMember](a, "listMembers"))
+}
+
+//ListMergeVarAdd adds a merge field to a list
+//http://apidocs.mailchimp.com/api/1.3/listmergevaradd.func.php
+func (a *API) ListMergeVarAdd(parameters map[string]interface{}) (bool, error) {
+	return a.ListMergeVarAddContext(context.Background(), parameters)
+}
+
+func (a *API) ListMergeVarAddContext(ctx context.Context, parameters map[string]interface{}) (bool, error) {
+	return parseBoolean(ctx, a, "listMergeVarAdd", parameters)
+}
+
+//ListMergeVarAddParams are the parameters of ListMergeVarAdd
+//http://apidocs.mailchimp.com/api/1.3/listmergevaradd.func.php
+type ListMergeVarAddParams struct {
+	ID string `json:"id,omitempty"`
+	//the field's tag, of up to 10 letters, digits and underscores, e.g. FAV_COLOR
+	Tag string `json:"tag,omitempty"`
+	//the field's name in forms
+	Name    string           `json:"name,omitempty"`
+	Options *MergeVarOptions `json:"options,omitempty"`
+}
+
+func (p *ListMergeVarAddParams) Validate() error {
+	switch {
+	case p.ID == "":
+		return ParamError{"listMergeVarAdd", "id"}
+	case p.Tag == "":
+		return ParamError{"listMergeVarAdd", "tag"}
+	case p.Name == "":
+		return ParamError{"listMergeVarAdd", "name"}
+	}
+	return nil
+}
+
+func (a *API) ListMergeVarAddWithParams(ctx context.Context, params *ListMergeVarAddParams) (bool, error) {
+	parameters, err := ToParameters(params)
+	if err != nil {
+		return false, err
+	}
+	return a.ListMergeVarAddContext(ctx, parameters)
+}
+
+//ListMergeVarDel deletes a merge field and the values of it of every member of
+//a list
+//http://apidocs.mailchimp.com/api/1.3/listmergevardel.func.php
+func (a *API) ListMergeVarDel(parameters map[string]interface{}) (bool, error) {
+	return a.ListMergeVarDelContext(context.Background(), parameters)
+}
+
+func (a *API) ListMergeVarDelContext(ctx context.Context, parameters map[string]interface{}) (bool, error) {
+	return parseBoolean(ctx, a, "listMergeVarDel", parameters)
+}
+
+//ListMergeVarDelParams are the parameters of ListMergeVarDel
+//http://apidocs.mailchimp.com/api/1.3/listmergevardel.func.php
+type ListMergeVarDelParams struct {
+	ID  string `json:"id,omitempty"`
+	Tag string `json:"tag,omitempty"`
+}
+
+func (p *ListMergeVarDelParams) Validate() error {
+	switch {
+	case p.ID == "":
+		return ParamError{"listMergeVarDel", "id"}
+	case p.Tag == "":
+		return ParamError{"listMergeVarDel", "tag"}
+	}
+	return nil
+}
+
+func (a *API) ListMergeVarDelWithParams(ctx context.Context, params *ListMergeVarDelParams) (bool, error) {
+	parameters, err := ToParameters(params)
+	if err != nil {
+		return false, err
+	}
+	return a.ListMergeVarDelContext(ctx, parameters)
+}
+
+//ListMergeVarReset clears the values of a merge field of every member of a list
+//http://apidocs.mailchimp.com/api/1.3/listmergevarreset.func.php
+func (a *API) ListMergeVarReset(parameters map[string]interface{}) (bool, error) {
+	return a.ListMergeVarResetContext(context.Background(), parameters)
+}
+
+func (a *API) ListMergeVarResetContext(ctx context.Context, parameters map[string]interface{}) (bool, error) {
+	return parseBoolean(ctx, a, "listMergeVarReset", parameters)
+}
+
+//ListMergeVarResetParams are the parameters of ListMergeVarReset
+//http://apidocs.mailchimp.com/api/1.3/listmergevarreset.func.php
+type ListMergeVarResetParams struct {
+	ID  string `json:"id,omitempty"`
+	Tag string `json:"tag,omitempty"`
+}
+
+func (p *ListMergeVarResetParams) Validate() error {
+	switch {
+	case p.ID == "":
+		return ParamError{"listMergeVarReset", "id"}
+	case p.Tag == "":
+		return ParamError{"listMergeVarReset", "tag"}
+	}
+	return nil
+}
+
+func (a *API) ListMergeVarResetWithParams(ctx context.Context, params *ListMergeVarResetParams) (bool, error) {
+	parameters, err := ToParameters(params)
+	if err != nil {
+		return false, err
+	}
+	return a.ListMergeVarResetContext(ctx, parameters)
+}
+
+//ListMergeVarSetValue sets the value of a merge field of every member of a list
+//http://apidocs.mailchimp.com/api/1.3/listmergevarsetvalue.func.php
+func (a *API) ListMergeVarSetValue(parameters map[string]interface{}) (bool, error) {
+	return a.ListMergeVarSetValueContext(context.Background(), parameters)
+}
+
+func (a *API) ListMergeVarSetValueContext(ctx context.Context, parameters map[string]interface{}) (bool, error) {
+	return parseBoolean(ctx, a, "listMergeVarSetValue", parameters)
+}
+
+//ListMergeVarSetValueParams are the parameters of ListMergeVarSetValue
+//http://apidocs.mailchimp.com/api/1.3/listmergevarsetvalue.func.php
+type ListMergeVarSetValueParams struct {
+	ID  string `json:"id,omitempty"`
+	Tag string `json:"tag,omitempty"`
+	//the new value, or empty to clear the field as ListMergeVarReset does
+	Value string `json:"value,omitempty"`
+}
+
+func (p *ListMergeVarSetValueParams) Validate() error {
+	switch {
+	case p.ID == "":
+		return ParamError{"listMergeVarSetValue", "id"}
+	case p.Tag == "":
+		return ParamError{"listMergeVarSetValue", "tag"}
+	}
+	return nil
+}
+
+func (a *API) ListMergeVarSetValueWithParams(ctx context.Context, params *ListMergeVarSetValueParams) (bool, error) {
+	parameters, err := ToParameters(params)
+	if err != nil {
+		return false, err
+	}
+	return a.ListMergeVarSetValueContext(ctx, parameters)
+}
+
+//ListMergeVarUpdate changes the definition of a merge field of a list
+//http://apidocs.mailchimp.com/api/1.3/listmergevarupdate.func.php
+func (a *API) ListMergeVarUpdate(parameters map[string]interface{}) (bool, error) {
+	return a.ListMergeVarUpdateContext(context.Background(), parameters)
+}
+
+func (a *API) ListMergeVarUpdateContext(ctx context.Context, parameters map[string]interface{}) (bool, error) {
+	return parseBoolean(ctx, a, "listMergeVarUpdate", parameters)
+}
+
+//ListMergeVarUpdateParams are the parameters of ListMergeVarUpdate
+//http://apidocs.mailchimp.com/api/1.3/listmergevarupdate.func.php
+type ListMergeVarUpdateParams struct {
+	ID  string `json:"id,omitempty"`
+	Tag string `json:"tag,omitempty"`
+	//the options to change, with Name set to rename the field
+	Options *MergeVarOptions `json:"options,omitempty"`
+}
+
+func (p *ListMergeVarUpdateParams) Validate() error {
+	switch {
+	case p.ID == "":
+		return ParamError{"listMergeVarUpdate", "id"}
+	case p.Tag == "":
+		return ParamError{"listMergeVarUpdate", "tag"}
+	case p.Options == nil:
+		return ParamError{"listMergeVarUpdate", "options"}
+	}
+	return nil
+}
+
+func (a *API) ListMergeVarUpdateWithParams(ctx context.Context, params *ListMergeVarUpdateParams) (bool, error) {
+	parameters, err := ToParameters(params)
+	if err != nil {
+		return false, err
+	}
+	return a.ListMergeVarUpdateContext(ctx, parameters)
+}
+
+//MergeVar is the definition of a merge field of a list
+type MergeVar struct {
+	Name     string   `json:"name"`
+	Required FlexBool `json:"req"`
+	//one of email, text, number, radio, dropdown, date, birthday, address, zip,
+	//phone, url or imageurl
+	FieldType string `json:"field_type"`
+	//whether members can see the field
+	Public FlexBool `json:"public"`
+	//whether the field is shown in the list's member table
+	Show     FlexBool `json:"show"`
+	Order    FlexInt  `json:"order"`
+	Default  string   `json:"default"`
+	HelpText string   `json:"helptext"`
+	//the width of the field in forms
+	Size string `json:"size"`
+	Tag  string `json:"tag"`
+	//the choices of radio and dropdown fields
+	Choices []string `json:"choices"`
+	ID      FlexInt  `json:"id"`
+}
+
+//ListMergeVars gets the definitions of the merge fields of a list
+//http://apidocs.mailchimp.com/api/1.3/listmergevars.func.php
+func (a *API) ListMergeVars(parameters map[string]interface{}) ([]MergeVar, error) {
+	return a.ListMergeVarsContext(context.Background(), parameters)
+}
+
+func (a *API) ListMergeVarsContext(ctx context.Context, parameters map[string]interface{}) (retVal []MergeVar, err error) {
+	err = parseJson(ctx, a, "listMergeVars", parameters, &retVal)
+	return
+}
+
+//ListMergeVarsParams are the parameters of ListMergeVars
+//http://apidocs.mailchimp.com/api/1.3/listmergevars.func.php
+type ListMergeVarsParams struct {
+	ID string `json:"id,omitempty"`
+}
+
+func (p *ListMergeVarsParams) Validate() error {
+	switch {
+	case p.ID == "":
+		return ParamError{"listMergeVars", "id"}
+	}
+	return nil
+}
+
+func (a *API) ListMergeVarsWithParams(ctx context.Context, params *ListMergeVarsParams) ([]MergeVar, error) {
+	parameters, err := ToParameters(params)
+	if err != nil {
+		return nil, err
+	}
+	return a.ListMergeVarsContext(ctx, parameters)
+}
+
+//ListStaticSegmentAdd adds a static segment to a list, returning its id. The
+//segment can be used in campaigns once members are added with
+//ListStaticSegmentMembersAdd.
+//http://apidocs.mailchimp.com/api/1.3/liststaticsegmentadd.func.php
+func (a *API) ListStaticSegmentAdd(parameters map[string]interface{}) (int, error) {
+	return a.ListStaticSegmentAddContext(context.Background(), parameters)
+}
+
+func (a *API) ListStaticSegmentAddContext(ctx context.Context, parameters map[string]interface{}) (int, error) {
+	return parseInt(ctx, a, "listStaticSegmentAdd", parameters)
+}
+
+//ListStaticSegmentAddParams are the parameters of ListStaticSegmentAdd
+//http://apidocs.mailchimp.com/api/1.3/liststaticsegmentadd.func.php
+type ListStaticSegmentAddParams struct {
+	ID string `json:"id,omitempty"`
+	//the segment's name, which must be unique in the list
+	Name string `json:"name,omitempty"`
+}
+
+func (p *ListStaticSegmentAddParams) Validate() error {
+	switch {
+	case p.ID == "":
+		return ParamError{"listStaticSegmentAdd", "id"}
+	case p.Name == "":
+		return ParamError{"listStaticSegmentAdd", "name"}
+	}
+	return nil
+}
+
+func (a *API) ListStaticSegmentAddWithParams(ctx context.Context, params *ListStaticSegmentAddParams) (int, error) {
+	parameters, err := ToParameters(params)
+	if err != nil {
+		return 0, err
+	}
+	return a.ListStaticSegmentAddContext(ctx, parameters)
+}
+
+//ListStaticSegmentDel deletes a static segment of a list
+//http://apidocs.mailchimp.com/api/1.3/liststaticsegmentdel.func.php
+func (a *API) ListStaticSegmentDel(parameters map[string]interface{}) (bool, error) {
+	return a.ListStaticSegmentDelContext(context.Background(), parameters)
+}
+
+func (a *API) ListStaticSegmentDelContext(ctx context.Context, parameters map[string]interface{}) (bool, error) {
+	return parseBoolean(ctx, a, "listStaticSegmentDel", parameters)
+}
+
+//ListStaticSegmentDelParams are the parameters of ListStaticSegmentDel
+//http://apidocs.mailchimp.com/api/1.3/liststaticsegmentdel.func.php
+type ListStaticSegmentDelParams struct {
+	ID    string `json:"id,omitempty"`
+	SegID int    `json:"seg_id,omitempty"`
+}
+
+func (p *ListStaticSegmentDelParams) Validate() error {
+	switch {
+	case p.ID == "":
+		return ParamError{"listStaticSegmentDel", "id"}
+	case p.SegID == 0:
+		return ParamError{"listStaticSegmentDel", "seg_id"}
+	}
+	return nil
+}
+
+func (a *API) ListStaticSegmentDelWithParams(ctx context.Context, params *ListStaticSegmentDelParams) (bool, error) {
+	parameters, err := ToParameters(params)
+	if err != nil {
+		return false, err
+	}
+	return a.ListStaticSegmentDelContext(ctx, parameters)
+}
+
+//ListStaticSegmentMembersAdd adds members to a static segment of a list.
+//Batches of more than 5000 members are sent 5000 at a time and their results
+//combined.
+//http://apidocs.mailchimp.com/api/1.3/liststaticsegmentmembersadd.func.php
+func (a *API) ListStaticSegmentMembersAdd(parameters map[string]interface{}) (*StaticSegmentMembersResponse, error) {
+	return a.ListStaticSegmentMembersAddContext(context.Background(), parameters)
+}
+
+//ListStaticSegmentMembersAddContext is ListStaticSegmentMembersAdd with a
+//context. If the request for one chunk of a large batch fails, the combined
+//results of the chunks before it are returned with the error, so that only the
+//rest of the batch need be sent again.
+func (a *API) ListStaticSegmentMembersAddContext(ctx context.Context, parameters map[string]interface{}) (*StaticSegmentMembersResponse, error) {
+	return parseChunks[StaticSegmentMembersResponse](ctx, a, "listStaticSegmentMembersAdd", parameters, 5000)
+}
+
+//ListStaticSegmentMembersAddParams are the parameters of ListStaticSegmentMembersAdd
+//http://apidocs.mailchimp.com/api/1.3/liststaticsegmentmembersadd.func.php
+type ListStaticSegmentMembersAddParams struct {
+	ID    string `json:"id,omitempty"`
+	SegID int    `json:"seg_id,omitempty"`
+	//the members' email addresses or unique ids
+	Batch []string `json:"batch,omitempty"`
+}
+
+func (p *ListStaticSegmentMembersAddParams) Validate() error {
+	switch {
+	case p.ID == "":
+		return ParamError{"listStaticSegmentMembersAdd", "id"}
+	case p.SegID == 0:
+		return ParamError{"listStaticSegmentMembersAdd", "seg_id"}
+	case len(p.Batch) == 0:
+		return ParamError{"listStaticSegmentMembersAdd", "batch"}
+	}
+	return nil
+}
+
+func (a *API) ListStaticSegmentMembersAddWithParams(ctx context.Context, params *ListStaticSegmentMembersAddParams) (*StaticSegmentMembersResponse, error) {
+	parameters, err := ToParameters(params)
+	if err != nil {
+		return nil, err
+	}
+	return a.ListStaticSegmentMembersAddContext(ctx, parameters)
+}
+
+//StaticSegmentMemberError is why a member could not be added to or removed from
+//a static segment. It is an error matching the sentinel errors of its code,
+//like a ChimpError.
+type StaticSegmentMemberError struct {
+	Email   string  `json:"email"`
+	Code    FlexInt `json:"code"`
+	Message string  `json:"msg"`
+}
+
+//StaticSegmentMembersResponse is the type for values returned from the
+//ListStaticSegmentMembersAdd and ListStaticSegmentMembersDel methods. Err
+//returns the Errors as an error.
+type StaticSegmentMembersResponse struct {
+	//the number of members added or removed
+	Success FlexInt                    `json:"success"`
+	Errors  []StaticSegmentMemberError `json:"errors"`
+}
+
+//ListStaticSegmentMembersDel removes members from a static segment of a list
+//http://apidocs.mailchimp.com/api/1.3/liststaticsegmentmembersdel.func.php
+func (a *API) ListStaticSegmentMembersDel(parameters map[string]interface{}) (*StaticSegmentMembersResponse, error) {
+	return a.ListStaticSegmentMembersDelContext(context.Background(), parameters)
+}
+
+func (a *API) ListStaticSegmentMembersDelContext(ctx context.Context, parameters map[string]interface{}) (retVal *StaticSegmentMembersResponse, err error) {
+	retVal = new(StaticSegmentMembersResponse)
+	err = parseJson(ctx, a, "listStaticSegmentMembersDel", parameters, retVal)
+	return
+}
+
+//ListStaticSegmentMembersDelParams are the parameters of ListStaticSegmentMembersDel
+//http://apidocs.mailchimp.com/api/1.3/liststaticsegmentmembersdel.func.php
+type ListStaticSegmentMembersDelParams struct {
+	ID    string `json:"id,omitempty"`
+	SegID int    `json:"seg_id,omitempty"`
+	//the members' email addresses or unique ids
+	Batch []string `json:"batch,omitempty"`
+}
+
+func (p *ListStaticSegmentMembersDelParams) Validate() error {
+	switch {
+	case p.ID == "":
+		return ParamError{"listStaticSegmentMembersDel", "id"}
+	case p.SegID == 0:
+		return ParamError{"listStaticSegmentMembersDel", "seg_id"}
+	case len(p.Batch) == 0:
+		return ParamError{"listStaticSegmentMembersDel", "batch"}
+	}
+	return nil
+}
+
+func (a *API) ListStaticSegmentMembersDelWithParams(ctx context.Context, params *ListStaticSegmentMembersDelParams) (*StaticSegmentMembersResponse, error) {
+	parameters, err := ToParameters(params)
+	if err != nil {
+		return nil, err
+	}
+	return a.ListStaticSegmentMembersDelContext(ctx, parameters)
+}
+
+//ListStaticSegmentReset removes every member from a static segment of a list
+//http://apidocs.mailchimp.com/api/1.3/liststaticsegmentreset.func.php
+func (a *API) ListStaticSegmentReset(parameters map[string]interface{}) (bool, error) {
+	return a.ListStaticSegmentResetContext(context.Background(), parameters)
+}
+
+func (a *API) ListStaticSegmentResetContext(ctx context.Context, parameters map[string]interface{}) (bool, error) {
+	return parseBoolean(ctx, a, "listStaticSegmentReset", parameters)
+}
+
+//ListStaticSegmentResetParams are the parameters of ListStaticSegmentReset
+//http://apidocs.mailchimp.com/api/1.3/liststaticsegmentreset.func.php
+type ListStaticSegmentResetParams struct {
+	ID    string `json:"id,omitempty"`
+	SegID int    `json:"seg_id,omitempty"`
+}
+
+func (p *ListStaticSegmentResetParams) Validate() error {
+	switch {
+	case p.ID == "":
+		return ParamError{"listStaticSegmentReset", "id"}
+	case p.SegID == 0:
+		return ParamError{"listStaticSegmentReset", "seg_id"}
+	}
+	return nil
+}
+
+func (a *API) ListStaticSegmentResetWithParams(ctx context.Context, params *ListStaticSegmentResetParams) (bool, error) {
+	parameters, err := ToParameters(params)
+	if err != nil {
+		return false, err
+	}
+	return a.ListStaticSegmentResetContext(ctx, parameters)
+}
+
+//StaticSegment is a static segment of a list
+type StaticSegment struct {
+	ID          FlexInt   `json:"id"`
+	Name        string    `json:"name"`
+	MemberCount FlexInt   `json:"member_count"`
+	CreatedDate ChimpTime `json:"created_date"`
+	//when members were last added or removed
+	LastUpdate ChimpTime `json:"last_update"`
+	LastReset  ChimpTime `json:"last_reset"`
+}
+
+//ListStaticSegments gets the static segments of a list
+//http://apidocs.mailchimp.com/api/1.3/liststaticsegments.func.php
+func (a *API) ListStaticSegments(parameters map[string]interface{}) ([]StaticSegment, error) {
+	return a.ListStaticSegmentsContext(context.Background(), parameters)
+}
+
+func (a *API) ListStaticSegmentsContext(ctx context.Context, parameters map[string]interface{}) (retVal []StaticSegment, err error) {
+	err = parseJson(ctx, a, "listStaticSegments", parameters, &retVal)
+	return
+}
+
+//ListStaticSegmentsParams are the parameters of ListStaticSegments
+//http://apidocs.mailchimp.com/api/1.3/liststaticsegments.func.php
+type ListStaticSegmentsParams struct {
+	ID string `json:"id,omitempty"`
+}
+
+func (p *ListStaticSegmentsParams) Validate() error {
+	switch {
+	case p.ID == "":
+		return ParamError{"listStaticSegments", "id"}
+	}
+	return nil
+}
+
+func (a *API) ListStaticSegmentsWithParams(ctx context.Context, params *ListStaticSegmentsParams) ([]StaticSegment, error) {
+	parameters, err := ToParameters(params)
+	if err != nil {
+		return nil, err
+	}
+	return a.ListStaticSegmentsContext(ctx, parameters)
+}
+
+//ListSubscribe subscribes an email address to a list, sending them a
+//confirmation email unless double_optin is false
+//http://apidocs.mailchimp.com/api/1.3/listsubscribe.func.php
+func (a *API) ListSubscribe(parameters map[string]interface{}) (bool, error) {
+	return a.ListSubscribeContext(context.Background(), parameters)
+}
+
+func (a *API) ListSubscribeContext(ctx context.Context, parameters map[string]interface{}) (bool, error) {
+	return parseBoolean(ctx, a, "listSubscribe", parameters)
+}
+
+//ListSubscribeParams are the parameters of ListSubscribe
+//http://apidocs.mailchimp.com/api/1.3/listsubscribe.func.php
+type ListSubscribeParams struct {
+	ID           string     `json:"id,omitempty"`
+	EmailAddress string     `json:"email_address,omitempty"`
+	MergeVars    *MergeVars `json:"merge_vars,omitempty"`
+	//html, text or mobile; html by default
+	EmailType   string `json:"email_type,omitempty"`
+	DoubleOptin *bool  `json:"double_optin,omitempty"`
+	//update the member if they are already subscribed rather than failing
+	UpdateExisting bool `json:"update_existing,omitempty"`
+	//replace the member's interest groups with those in merge_vars rather than
+	//adding to them
+	ReplaceInterests *bool `json:"replace_interests,omitempty"`
+	//send the list's welcome email if double_optin is false
+	SendWelcome bool `json:"send_welcome,omitempty"`
+}
+
+func (p *ListSubscribeParams) Validate() error {
+	switch {
+	case p.ID == "":
+		return ParamError{"listSubscribe", "id"}
+	case p.EmailAddress == "":
+		return ParamError{"listSubscribe", "email_address"}
+	}
+	return nil
+}
+
+func (a *API) ListSubscribeWithParams(ctx context.Context, params *ListSubscribeParams) (bool, error) {
+	parameters, err := ToParameters(params)
+	if err != nil {
+		return false, err
+	}
+	return a.ListSubscribeContext(ctx, parameters)
+}
+
+//ListUnsubscribe unsubscribes an email address from a list
+//http://apidocs.mailchimp.com/api/1.3/listunsubscribe.func.php
+func (a *API) ListUnsubscribe(parameters map[string]interface{}) (bool, error) {
+	return a.ListUnsubscribeContext(context.Background(), parameters)
+}
+
+func (a *API) ListUnsubscribeContext(ctx context.Context, parameters map[string]interface{}) (bool, error) {
+	return parseBoolean(ctx, a, "listUnsubscribe", parameters)
+}
+
+//ListUnsubscribeParams are the parameters of ListUnsubscribe
+//http://apidocs.mailchimp.com/api/1.3/listunsubscribe.func.php
+type ListUnsubscribeParams struct {
+	ID string `json:"id,omitempty"`
+	//the member's email address, unique id or euid
+	EmailAddress string `json:"email_address,omitempty"`
+	//delete the member rather than marking them unsubscribed
+	DeleteMember bool  `json:"delete_member,omitempty"`
+	SendGoodbye  *bool `json:"send_goodbye,omitempty"`
+	//send the list's unsubscribe notification
+	SendNotify *bool `json:"send_notify,omitempty"`
+}
+
+func (p *ListUnsubscribeParams) Validate() error {
+	switch {
+	case p.ID == "":
+		return ParamError{"listUnsubscribe", "id"}
+	case p.EmailAddress == "":
+		return ParamError{"listUnsubscribe", "email_address"}
+	}
+	return nil
+}
+
+func (a *API) ListUnsubscribeWithParams(ctx context.Context, params *ListUnsubscribeParams) (bool, error) {
+	parameters, err := ToParameters(params)
+	if err != nil {
+		return false, err
+	}
+	return a.ListUnsubscribeContext(ctx, parameters)
+}
+
+//ListUpdateMember updates the merge vars, interest groups and email type of a
+//member of a list without changing their subscription
+//http://apidocs.mailchimp.com/api/1.3/listupdatemember.func.php
+func (a *API) ListUpdateMember(parameters map[string]interface{}) (bool, error) {
+	return a.ListUpdateMemberContext(context.Background(), parameters)
+}
+
+func (a *API) ListUpdateMemberContext(ctx context.Context, parameters map[string]interface{}) (bool, error) {
+	return parseBoolean(ctx, a, "listUpdateMember", parameters)
+}
+
+//ListUpdateMemberParams are the parameters of ListUpdateMember
+//http://apidocs.mailchimp.com/api/1.3/listupdatemember.func.php
+type ListUpdateMemberParams struct {
+	ID string `json:"id,omitempty"`
+	//the member's email address, unique id or euid; set NewEmail in merge_vars to
+	//change it
+	EmailAddress string     `json:"email_address,omitempty"`
+	MergeVars    *MergeVars `json:"merge_vars,omitempty"`
+	//html, text or mobile, or empty to keep it
+	EmailType string `json:"email_type,omitempty"`
+	//replace the member's interest groups with those in merge_vars rather than
+	//adding to them
+	ReplaceInterests *bool `json:"replace_interests,omitempty"`
+}
+
+func (p *ListUpdateMemberParams) Validate() error {
+	switch {
+	case p.ID == "":
+		return ParamError{"listUpdateMember", "id"}
+	case p.EmailAddress == "":
+		return ParamError{"listUpdateMember", "email_address"}
+	case p.MergeVars == nil:
+		return ParamError{"listUpdateMember", "merge_vars"}
+	}
+	return nil
+}
+
+func (a *API) ListUpdateMemberWithParams(ctx context.Context, params *ListUpdateMemberParams) (bool, error) {
+	parameters, err := ToParameters(params)
+	if err != nil {
+		return false, err
+	}
+	return a.ListUpdateMemberContext(ctx, parameters)
+}
+
+//ListWebhookAdd adds a webhook to a list, which Mailchimp calls with a POST to
+//its url when the chosen actions happen
+//http://apidocs.mailchimp.com/api/1.3/listwebhookadd.func.php
+func (a *API) ListWebhookAdd(parameters map[string]interface{}) (bool, error) {
+	return a.ListWebhookAddContext(context.Background(), parameters)
+}
+
+func (a *API) ListWebhookAddContext(ctx context.Context, parameters map[string]interface{}) (bool, error) {
+	return parseBoolean(ctx, a, "listWebhookAdd", parameters)
+}
+
+//ListWebhookAddParams are the parameters of ListWebhookAdd
+//http://apidocs.mailchimp.com/api/1.3/listwebhookadd.func.php
+type ListWebhookAddParams struct {
+	ID  string `json:"id,omitempty"`
+	URL string `json:"url,omitempty"`
+	//the actions to call the webhook for, or nil for all of them
+	Actions *WebhookActions `json:"actions,omitempty"`
+	//the sources of the actions to call the webhook for, or nil for changes made by
+	//members and in the web app but not through the API
+	Sources *WebhookSources `json:"sources,omitempty"`
+}
+
+func (p *ListWebhookAddParams) Validate() error {
+	switch {
+	case p.ID == "":
+		return ParamError{"listWebhookAdd", "id"}
+	case p.URL == "":
+		return ParamError{"listWebhookAdd", "url"}
+	}
+	return nil
+}
+
+func (a *API) ListWebhookAddWithParams(ctx context.Context, params *ListWebhookAddParams) (bool, error) {
+	parameters, err := ToParameters(params)
+	if err != nil {
+		return false, err
+	}
+	return a.ListWebhookAddContext(ctx, parameters)
+}
+
+//ListWebhookDel deletes the webhook of a list with the given url
+//http://apidocs.mailchimp.com/api/1.3/listwebhookdel.func.php
+func (a *API) ListWebhookDel(parameters map[string]interface{}) (bool, error) {
+	return a.ListWebhookDelContext(context.Background(), parameters)
+}
+
+func (a *API) ListWebhookDelContext(ctx context.Context, parameters map[string]interface{}) (bool, error) {
+	return parseBoolean(ctx, a, "listWebhookDel", parameters)
+}
+
+//ListWebhookDelParams are the parameters of ListWebhookDel
+//http://apidocs.mailchimp.com/api/1.3/listwebhookdel.func.php
+type ListWebhookDelParams struct {
+	ID  string `json:"id,omitempty"`
+	URL string `json:"url,omitempty"`
+}
+
+func (p *ListWebhookDelParams) Validate() error {
+	switch {
+	case p.ID == "":
+		return ParamError{"listWebhookDel", "id"}
+	case p.URL == "":
+		return ParamError{"listWebhookDel", "url"}
+	}
+	return nil
+}
+
+func (a *API) ListWebhookDelWithParams(ctx context.Context, params *ListWebhookDelParams) (bool, error) {
+	parameters, err := ToParameters(params)
+	if err != nil {
+		return false, err
+	}
+	return a.ListWebhookDelContext(ctx, parameters)
+}
+
+//WebhookActions are the actions a webhook is called for
+type WebhookActions struct {
+	Subscribe   FlexBool `json:"subscribe"`
+	Unsubscribe FlexBool `json:"unsubscribe"`
+	//a member changing their merge fields or interest groups
+	Profile FlexBool `json:"profile"`
+	//a member's address being removed after bouncing
+	Cleaned FlexBool `json:"cleaned"`
+	//a member changing their email address
+	UpEmail FlexBool `json:"upemail"`
+	//a campaign being sent or cancelled
+	Campaign FlexBool `json:"campaign"`
+}
+
+//WebhookSources are the sources of the actions a webhook is called for
+type WebhookSources struct {
+	//members themselves, e.g. through signup forms
+	User FlexBool `json:"user"`
+	//the account's users, in the web app
+	Admin FlexBool `json:"admin"`
+	//API calls
+	API FlexBool `json:"api"`
+}
+
+//Webhook is a webhook of a list
+type Webhook struct {
+	URL     string         `json:"url"`
+	Actions WebhookActions `json:"actions"`
+	Sources WebhookSources `json:"sources"`
+}
+
+//ListWebhooks gets the webhooks of a list
+//http://apidocs.mailchimp.com/api/1.3/listwebhooks.func.php
+func (a *API) ListWebhooks(parameters map[string]interface{}) ([]Webhook, error) {
+	return a.ListWebhooksContext(context.Background(), parameters)
+}
+
+func (a *API) ListWebhooksContext(ctx context.Context, parameters map[string]interface{}) (retVal []Webhook, err error) {
+	err = parseJson(ctx, a, "listWebhooks", parameters, &retVal)
+	return
+}
+
+//ListWebhooksParams are the parameters of ListWebhooks
+//http://apidocs.mailchimp.com/api/1.3/listwebhooks.func.php
+type ListWebhooksParams struct {
+	ID string `json:"id,omitempty"`
+}
+
+func (p *ListWebhooksParams) Validate() error {
+	switch {
+	case p.ID == "":
+		return ParamError{"listWebhooks", "id"}
+	}
+	return nil
+}
+
+func (a *API) ListWebhooksWithParams(ctx context.Context, params *ListWebhooksParams) ([]Webhook, error) {
+	parameters, err := ToParameters(params)
+	if err != nil {
+		return nil, err
+	}
+	return a.ListWebhooksContext(ctx, parameters)
+}
+
+//ListsForEmail gets the ids of the lists an email address is subscribed to
+//http://apidocs.mailchimp.com/api/1.3/listsforemail.func.php
+func (a *API) ListsForEmail(parameters map[string]interface{}) ([]string, error) {
+	return a.ListsForEmailContext(context.Background(), parameters)
+}
+
+func (a *API) ListsForEmailContext(ctx context.Context, parameters map[string]interface{}) (retVal []string, err error) {
+	err = parseJson(ctx, a, "listsForEmail", parameters, &retVal)
+	return
+}
+
+//ListsForEmailParams are the parameters of ListsForEmail
+//http://apidocs.mailchimp.com/api/1.3/listsforemail.func.php
+type ListsForEmailParams struct {
+	EmailAddress string `json:"email_address,omitempty"`
+}
+
+func (p *ListsForEmailParams) Validate() error {
+	switch {
+	case p.EmailAddress == "":
+		return ParamError{"listsForEmail", "email_address"}
+	}
+	return nil
+}
+
+func (a *API) ListsForEmailWithParams(ctx context.Context, params *ListsForEmailParams) ([]string, error) {
+	parameters, err := ToParameters(params)
+	if err != nil {
+		return nil, err
+	}
+	return a.ListsForEmailContext(ctx, parameters)
+}
+
+//SearchMembersMatches is the members found by a search
+type SearchMembersMatches struct {
+	Total   FlexInt      `json:"total"`
+	Members []MemberInfo `json:"members"`
+}
+
+//SearchMembersResponse is the type for values returned from the SearchMembers
+//method
+type SearchMembersResponse struct {
+	ExactMatches SearchMembersMatches `json:"exact_matches"`
+	FullSearch   SearchMembersMatches `json:"full_search"`
+}
+
+//SearchMembers searches the members of every list, or of one, for an email
+//address or name. Matches are returned 100 at a time, the page being chosen by
+//offset.
+//http://apidocs.mailchimp.com/api/1.3/searchmembers.func.php
+func (a *API) SearchMembers(parameters map[string]interface{}) (*SearchMembersResponse, error) {
+	return a.SearchMembersContext(context.Background(), parameters)
+}
+
+func (a *API) SearchMembersContext(ctx context.Context, parameters map[string]interface{}) (retVal *SearchMembersResponse, err error) {
+	retVal = new(SearchMembersResponse)
+	err = parseJson(ctx, a, "searchMembers", parameters, retVal)
+	return
+}
+
+//SearchMembersParams are the parameters of SearchMembers
+//http://apidocs.mailchimp.com/api/1.3/searchmembers.func.php
+type SearchMembersParams struct {
+	//all or part of an email address, or a name
+	Query string `json:"query,omitempty"`
+	//the list to search, or empty to search every list
+	ID string `json:"id,omitempty"`
+	//the page of matches to return
+	Offset int `json:"offset,omitempty"`
+}
+
+func (p *SearchMembersParams) Validate() error {
+	switch {
+	case p.Query == "":
+		return ParamError{"searchMembers", "query"}
+	}
+	return nil
+}
+
+func (a *API) SearchMembersWithParams(ctx context.Context, params *SearchMembersParams) (*SearchMembersResponse, error) {
+	parameters, err := ToParameters(params)
+	if err != nil {
+		return nil, err
+	}
+	return a.SearchMembersContext(ctx, parameters)
+}
+
+func init() {
+	readMethods["campaignAbuseReports"] = true
+	readMethods["campaignAdvice"] = true
+	readMethods["campaignAnalytics"] = true
+	readMethods["campaignBounceMessage"] = true
+	readMethods["campaignBounceMessages"] = true
+	readMethods["campaignClickDetailAIM"] = true
+	readMethods["campaignClickStats"] = true
+	readMethods["campaignContent"] = true
+	readMethods["campaignEcommOrders"] = true
+	readMethods["campaignEepUrlStats"] = true
+	readMethods["campaignEmailDomainPerformance"] = true
+	readMethods["campaignEmailStatsAIM"] = true
+	readMethods["campaignEmailStatsAIMAll"] = true
+	readMethods["campaignGeoOpens"] = true
+	readMethods["campaignGeoOpensForCountry"] = true
+	readMethods["campaignMembers"] = true
+	readMethods["campaignNotOpenedAIM"] = true
+	readMethods["campaignOpenedAIM"] = true
+	readMethods["campaignSegmentTest"] = true
+	readMethods["campaignStats"] = true
+	readMethods["campaignTemplateContent"] = true
+	readMethods["campaignUnsubscribes"] = true
+	readMethods["campaigns"] = true
+	readMethods["campaignsForEmail"] = true
+	readMethods["chimpChatter"] = true
+	readMethods["ecommOrders"] = true
+	readMethods["folders"] = true
+	readMethods["generateText"] = true
+	readMethods["getAccountDetails"] = true
+	readMethods["getVerifiedDomains"] = true
+	readMethods["gmonkeyActivity"] = true
+	readMethods["gmonkeyMembers"] = true
+	readMethods["inlineCss"] = true
+	readMethods["listAbuseReports"] = true
+	readMethods["listActivity"] = true
+	readMethods["listClients"] = true
+	readMethods["listGrowthHistory"] = true
+	readMethods["listInterestGroupings"] = true
+	readMethods["listMemberActivity"] = true
+	readMethods["listMemberInfo"] = true
+	readMethods["listMembers"] = true
+	readMethods["listMergeVars"] = true
+	readMethods["listStaticSegments"] = true
+	readMethods["listWebhooks"] = true
+	readMethods["listsForEmail"] = true
+	readMethods["searchMembers"] = true
+	pageLimits["campaignAbuseReports"] = 1000
+	pageLimits["campaignBounceMessages"] = 50
+	pageLimits["campaignClickDetailAIM"] = 15000
+	pageLimits["campaignEcommOrders"] = 500
+	pageLimits["campaignEmailStatsAIMAll"] = 1000
+	pageLimits["campaignMembers"] = 15000
+	pageLimits["campaignNotOpenedAIM"] = 15000
+	pageLimits["campaignOpenedAIM"] = 15000
+	pageLimits["campaignUnsubscribes"] = 15000
+	pageLimits["campaigns"] = 1000
+	pageLimits["ecommOrders"] = 500
+	pageLimits["listAbuseReports"] = 1000
+	pageLimits["listMembers"] = 15000
+	pageLimits["searchMembers"] = 100
+}
@@ -0,0 +1,358 @@
+// Code generated by mailchimpgen; DO NOT EDIT.
+
+package mailchimp
+
+import (
+	"context"
+	"testing"
+)
+
+func TestCampaignAbuseReportsFixture(t *testing.T) {
+	api := fixtureAPI(t, "campaignAbuseReports")
+	if _, err := api.CampaignAbuseReportsContext(context.Background(), nil); err != nil {
+		t.Error("CampaignAbuseReports:", err)
+	}
+}
+
+func TestCampaignAdviceFixture(t *testing.T) {
+	api := fixtureAPI(t, "campaignAdvice")
+	if _, err := api.CampaignAdviceContext(context.Background(), nil); err != nil {
+		t.Error("CampaignAdvice:", err)
+	}
+}
+
+func TestCampaignAnalyticsFixture(t *testing.T) {
+	api := fixtureAPI(t, "campaignAnalytics")
+	if _, err := api.CampaignAnalyticsContext(context.Background(), nil); err != nil {
+		t.Error("CampaignAnalytics:", err)
+	}
+}
+
+func TestCampaignBounceMessageFixture(t *testing.T) {
+	api := fixtureAPI(t, "campaignBounceMessage")
+	if _, err := api.CampaignBounceMessageContext(context.Background(), nil); err != nil {
+		t.Error("CampaignBounceMessage:", err)
+	}
+}
+
+func TestCampaignBounceMessagesFixture(t *testing.T) {
+	api := fixtureAPI(t, "campaignBounceMessages")
+	if _, err := api.CampaignBounceMessagesContext(context.Background(), nil); err != nil {
+		t.Error("CampaignBounceMessages:", err)
+	}
+}
+
+func TestCampaignClickDetailAIMFixture(t *testing.T) {
+	api := fixtureAPI(t, "campaignClickDetailAIM")
+	if _, err := api.CampaignClickDetailAIMContext(context.Background(), nil); err != nil {
+		t.Error("CampaignClickDetailAIM:", err)
+	}
+}
+
+func TestCampaignClickStatsFixture(t *testing.T) {
+	api := fixtureAPI(t, "campaignClickStats")
+	if _, err := api.CampaignClickStatsContext(context.Background(), nil); err != nil {
+		t.Error("CampaignClickStats:", err)
+	}
+}
+
+func TestCampaignContentFixture(t *testing.T) {
+	api := fixtureAPI(t, "campaignContent")
+	if _, err := api.CampaignContentContext(context.Background(), nil); err != nil {
+		t.Error("CampaignContent:", err)
+	}
+}
+
+func TestCampaignEcommOrdersFixture(t *testing.T) {
+	api := fixtureAPI(t, "campaignEcommOrders")
+	if _, err := api.CampaignEcommOrdersContext(context.Background(), nil); err != nil {
+		t.Error("CampaignEcommOrders:", err)
+	}
+}
+
+func TestCampaignEepUrlStatsFixture(t *testing.T) {
+	api := fixtureAPI(t, "campaignEepUrlStats")
+	if _, err := api.CampaignEepUrlStatsContext(context.Background(), nil); err != nil {
+		t.Error("CampaignEepUrlStats:", err)
+	}
+}
+
+func TestCampaignEmailDomainPerformanceFixture(t *testing.T) {
+	api := fixtureAPI(t, "campaignEmailDomainPerformance")
+	if _, err := api.CampaignEmailDomainPerformanceContext(context.Background(), nil); err != nil {
+		t.Error("CampaignEmailDomainPerformance:", err)
+	}
+}
+
+func TestCampaignEmailStatsAIMFixture(t *testing.T) {
+	api := fixtureAPI(t, "campaignEmailStatsAIM")
+	if _, err := api.CampaignEmailStatsAIMContext(context.Background(), nil); err != nil {
+		t.Error("CampaignEmailStatsAIM:", err)
+	}
+}
+
+func TestCampaignEmailStatsAIMAllFixture(t *testing.T) {
+	api := fixtureAPI(t, "campaignEmailStatsAIMAll")
+	if _, err := api.CampaignEmailStatsAIMAllContext(context.Background(), nil); err != nil {
+		t.Error("CampaignEmailStatsAIMAll:", err)
+	}
+}
+
+func TestCampaignGeoOpensFixture(t *testing.T) {
+	api := fixtureAPI(t, "campaignGeoOpens")
+	if _, err := api.CampaignGeoOpensContext(context.Background(), nil); err != nil {
+		t.Error("CampaignGeoOpens:", err)
+	}
+}
+
+func TestCampaignGeoOpensForCountryFixture(t *testing.T) {
+	api := fixtureAPI(t, "campaignGeoOpensForCountry")
+	if _, err := api.CampaignGeoOpensForCountryContext(context.Background(), nil); err != nil {
+		t.Error("CampaignGeoOpensForCountry:", err)
+	}
+}
+
+func TestCampaignMembersFixture(t *testing.T) {
+	api := fixtureAPI(t, "campaignMembers")
+	if _, err := api.CampaignMembersContext(context.Background(), nil); err != nil {
+		t.Error("CampaignMembers:", err)
+	}
+}
+
+func TestCampaignNotOpenedAIMFixture(t *testing.T) {
+	api := fixtureAPI(t, "campaignNotOpenedAIM")
+	if _, err := api.CampaignNotOpenedAIMContext(context.Background(), nil); err != nil {
+		t.Error("CampaignNotOpenedAIM:", err)
+	}
+}
+
+func TestCampaignOpenedAIMFixture(t *testing.T) {
+	api := fixtureAPI(t, "campaignOpenedAIM")
+	if _, err := api.CampaignOpenedAIMContext(context.Background(), nil); err != nil {
+		t.Error("CampaignOpenedAIM:", err)
+	}
+}
+
+func TestCampaignShareReportFixture(t *testing.T) {
+	api := fixtureAPI(t, "campaignShareReport")
+	if _, err := api.CampaignShareReportContext(context.Background(), nil); err != nil {
+		t.Error("CampaignShareReport:", err)
+	}
+}
+
+func TestCampaignStatsFixture(t *testing.T) {
+	api := fixtureAPI(t, "campaignStats")
+	if _, err := api.CampaignStatsContext(context.Background(), nil); err != nil {
+		t.Error("CampaignStats:", err)
+	}
+}
+
+func TestCampaignTemplateContentFixture(t *testing.T) {
+	api := fixtureAPI(t, "campaignTemplateContent")
+	if _, err := api.CampaignTemplateContentContext(context.Background(), nil); err != nil {
+		t.Error("CampaignTemplateContent:", err)
+	}
+}
+
+func TestCampaignUnsubscribesFixture(t *testing.T) {
+	api := fixtureAPI(t, "campaignUnsubscribes")
+	if _, err := api.CampaignUnsubscribesContext(context.Background(), nil); err != nil {
+		t.Error("CampaignUnsubscribes:", err)
+	}
+}
+
+func TestCampaignsFixture(t *testing.T) {
+	api := fixtureAPI(t, "campaigns")
+	if _, err := api.CampaignsContext(context.Background(), nil); err != nil {
+		t.Error("Campaigns:", err)
+	}
+}
+
+func TestCampaignsForEmailFixture(t *testing.T) {
+	api := fixtureAPI(t, "campaignsForEmail")
+	if _, err := api.CampaignsForEmailContext(context.Background(), nil); err != nil {
+		t.Error("CampaignsForEmail:", err)
+	}
+}
+
+func TestChimpChatterFixture(t *testing.T) {
+	api := fixtureAPI(t, "chimpChatter")
+	if _, err := api.ChimpChatterContext(context.Background(), nil); err != nil {
+		t.Error("ChimpChatter:", err)
+	}
+}
+
+func TestEcommOrdersFixture(t *testing.T) {
+	api := fixtureAPI(t, "ecommOrders")
+	if _, err := api.EcommOrdersContext(context.Background(), nil); err != nil {
+		t.Error("EcommOrders:", err)
+	}
+}
+
+func TestFoldersFixture(t *testing.T) {
+	api := fixtureAPI(t, "folders")
+	if _, err := api.FoldersContext(context.Background(), nil); err != nil {
+		t.Error("Folders:", err)
+	}
+}
+
+func TestGetAccountDetailsFixture(t *testing.T) {
+	api := fixtureAPI(t, "getAccountDetails")
+	if _, err := api.GetAccountDetailsContext(context.Background(), nil); err != nil {
+		t.Error("GetAccountDetails:", err)
+	}
+}
+
+func TestGetVerifiedDomainsFixture(t *testing.T) {
+	api := fixtureAPI(t, "getVerifiedDomains")
+	if _, err := api.GetVerifiedDomainsContext(context.Background(), nil); err != nil {
+		t.Error("GetVerifiedDomains:", err)
+	}
+}
+
+func TestGmonkeyActivityFixture(t *testing.T) {
+	api := fixtureAPI(t, "gmonkeyActivity")
+	if _, err := api.GmonkeyActivityContext(context.Background(), nil); err != nil {
+		t.Error("GmonkeyActivity:", err)
+	}
+}
+
+func TestGmonkeyAddFixture(t *testing.T) {
+	api := fixtureAPI(t, "gmonkeyAdd")
+	if _, err := api.GmonkeyAddContext(context.Background(), nil); err != nil {
+		t.Error("GmonkeyAdd:", err)
+	}
+}
+
+func TestGmonkeyDelFixture(t *testing.T) {
+	api := fixtureAPI(t, "gmonkeyDel")
+	if _, err := api.GmonkeyDelContext(context.Background(), nil); err != nil {
+		t.Error("GmonkeyDel:", err)
+	}
+}
+
+func TestGmonkeyMembersFixture(t *testing.T) {
+	api := fixtureAPI(t, "gmonkeyMembers")
+	if _, err := api.GmonkeyMembersContext(context.Background(), nil); err != nil {
+		t.Error("GmonkeyMembers:", err)
+	}
+}
+
+func TestListAbuseReportsFixture(t *testing.T) {
+	api := fixtureAPI(t, "listAbuseReports")
+	if _, err := api.ListAbuseReportsContext(context.Background(), nil); err != nil {
+		t.Error("ListAbuseReports:", err)
+	}
+}
+
+func TestListActivityFixture(t *testing.T) {
+	api := fixtureAPI(t, "listActivity")
+	if _, err := api.ListActivityContext(context.Background(), nil); err != nil {
+		t.Error("ListActivity:", err)
+	}
+}
+
+func TestListBatchSubscribeFixture(t *testing.T) {
+	api := fixtureAPI(t, "listBatchSubscribe")
+	if _, err := api.ListBatchSubscribeContext(context.Background(), nil); err != nil {
+		t.Error("ListBatchSubscribe:", err)
+	}
+}
+
+func TestListBatchUnsubscribeFixture(t *testing.T) {
+	api := fixtureAPI(t, "listBatchUnsubscribe")
+	if _, err := api.ListBatchUnsubscribeContext(context.Background(), nil); err != nil {
+		t.Error("ListBatchUnsubscribe:", err)
+	}
+}
+
+func TestListClientsFixture(t *testing.T) {
+	api := fixtureAPI(t, "listClients")
+	if _, err := api.ListClientsContext(context.Background(), nil); err != nil {
+		t.Error("ListClients:", err)
+	}
+}
+
+func TestListGrowthHistoryFixture(t *testing.T) {
+	api := fixtureAPI(t, "listGrowthHistory")
+	if _, err := api.ListGrowthHistoryContext(context.Background(), nil); err != nil {
+		t.Error("ListGrowthHistory:", err)
+	}
+}
+
+func TestListInterestGroupingsFixture(t *testing.T) {
+	api := fixtureAPI(t, "listInterestGroupings")
+	if _, err := api.ListInterestGroupingsContext(context.Background(), nil); err != nil {
+		t.Error("ListInterestGroupings:", err)
+	}
+}
+
+func TestListMemberActivityFixture(t *testing.T) {
+	api := fixtureAPI(t, "listMemberActivity")
+	if _, err := api.ListMemberActivityContext(context.Background(), nil); err != nil {
+		t.Error("ListMemberActivity:", err)
+	}
+}
+
+func TestListMemberInfoFixture(t *testing.T) {
+	api := fixtureAPI(t, "listMemberInfo")
+	if _, err := api.ListMemberInfoContext(context.Background(), nil); err != nil {
+		t.Error("ListMemberInfo:", err)
+	}
+}
+
+func TestListMembersFixture(t *testing.T) {
+	api := fixtureAPI(t, "listMembers")
+	if _, err := api.ListMembersContext(context.Background(), nil); err != nil {
+		t.Error("ListMembers:", err)
+	}
+}
+
+func TestListMergeVarsFixture(t *testing.T) {
+	api := fixtureAPI(t, "listMergeVars")
+	if _, err := api.ListMergeVarsContext(context.Background(), nil); err != nil {
+		t.Error("ListMergeVars:", err)
+	}
+}
+
+func TestListStaticSegmentMembersAddFixture(t *testing.T) {
+	api := fixtureAPI(t, "listStaticSegmentMembersAdd")
+	if _, err := api.ListStaticSegmentMembersAddContext(context.Background(), nil); err != nil {
+		t.Error("ListStaticSegmentMembersAdd:", err)
+	}
+}
+
+func TestListStaticSegmentMembersDelFixture(t *testing.T) {
+	api := fixtureAPI(t, "listStaticSegmentMembersDel")
+	if _, err := api.ListStaticSegmentMembersDelContext(context.Background(), nil); err != nil {
+		t.Error("ListStaticSegmentMembersDel:", err)
+	}
+}
+
+func TestListStaticSegmentsFixture(t *testing.T) {
+	api := fixtureAPI(t, "listStaticSegments")
+	if _, err := api.ListStaticSegmentsContext(context.Background(), nil); err != nil {
+		t.Error("ListStaticSegments:", err)
+	}
+}
+
+func TestListWebhooksFixture(t *testing.T) {
+	api := fixtureAPI(t, "listWebhooks")
+	if _, err := api.ListWebhooksContext(context.Background(), nil); err != nil {
+		t.Error("ListWebhooks:", err)
+	}
+}
+
+func TestListsForEmailFixture(t *testing.T) {
+	api := fixtureAPI(t, "listsForEmail")
+	if _, err := api.ListsForEmailContext(context.Background(), nil); err != nil {
+		t.Error("ListsForEmail:", err)
+	}
+}
+
+func TestSearchMembersFixture(t *testing.T) {
+	api := fixtureAPI(t, "searchMembers")
+	if _, err := api.SearchMembersContext(context.Background(), nil); err != nil {
+		t.Error("SearchMembers:", err)
+	}
+}
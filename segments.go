@@ -0,0 +1,32 @@
+package mailchimp
+
+import (
+	"errors"
+	"fmt"
+)
+
+//combine adds the result of another chunk of a batch to r
+func (r *StaticSegmentMembersResponse) combine(chunk *StaticSegmentMembersResponse) {
+	r.Success += chunk.Success
+	r.Errors = append(r.Errors, chunk.Errors...)
+}
+
+func (e StaticSegmentMemberError) Error() string {
+	return fmt.Sprintf("%v: %v: %v", e.Email, e.Code, e.Message)
+}
+
+//Is reports whether the error's code belongs to the family of target, as
+//ChimpError.Is does
+func (e StaticSegmentMemberError) Is(target error) bool {
+	return ChimpError{Err: e.Message, Code: int(e.Code)}.Is(target)
+}
+
+//Err returns the errors of the members that could not be added or removed
+//joined into one error, or nil if there were none
+func (r *StaticSegmentMembersResponse) Err() error {
+	errs := make([]error, len(r.Errors))
+	for i, e := range r.Errors {
+		errs[i] = e
+	}
+	return errors.Join(errs...)
+}
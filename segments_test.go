@@ -0,0 +1,46 @@
+package mailchimp
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/areed/mailchimp/mailchimptest"
+)
+
+func TestStaticSegmentMembersAddChunkError(t *testing.T) {
+	chimp, server := fakeAPI(t)
+	requests := 0
+	server.Handle("listStaticSegmentMembersAdd", func(p mailchimptest.Params) (interface{}, error) {
+		requests++
+		if requests == 2 {
+			return nil, mailchimptest.Errorf(-50, "Too many connections")
+		}
+		return map[string]interface{}{"success": len(p.Strings("batch")), "errors": []interface{}{}}, nil
+	})
+	const chunkSize = 5000
+	batch := make([]string, 2*chunkSize+1)
+	for i := range batch {
+		batch[i] = "a@example.com"
+	}
+	parameters := map[string]interface{}{"id": fakeList, "seg_id": 1, "batch": batch}
+	result, err := chimp.ListStaticSegmentMembersAdd(parameters)
+	if !errors.Is(err, ErrTooManyConnections) {
+		t.Error("expected the error of the second chunk but got", err)
+	}
+	verify(t, "success", chunkSize, int(result.Success))
+	verify(t, "requests", 2, requests)
+	if len(parameters["batch"].([]string)) != len(batch) {
+		t.Error("expected the batch parameter to be left as it was")
+	}
+}
+
+func TestStaticSegmentMemberError(t *testing.T) {
+	e := StaticSegmentMemberError{Email: "a@example.com", Code: 215, Message: "a@example.com is not subscribed"}
+	verify(t, "Error", "a@example.com: 215: a@example.com is not subscribed", e.Error())
+	if !errors.Is(e, ErrEmailNotSubscribed) || errors.Is(e, ErrInvalidEmail) {
+		t.Error("expected the error to match the sentinel errors of its code")
+	}
+	if (&StaticSegmentMembersResponse{}).Err() != nil {
+		t.Error("expected no error without member errors")
+	}
+}
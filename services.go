@@ -0,0 +1,326 @@
+package mailchimp
+
+import (
+	"context"
+	"time"
+)
+
+//CampaignService creates, sends and manages campaigns and the folders they are
+//filed in
+type CampaignService interface {
+	CampaignContent(parameters map[string]interface{}) (*CampaignContentResult, error)
+	CampaignContentContext(ctx context.Context, parameters map[string]interface{}) (retVal *CampaignContentResult, err error)
+	CampaignContentWithParams(ctx context.Context, params *CampaignContentParams) (*CampaignContentResult, error)
+	CampaignCreate(parameters map[string]interface{}) (string, error)
+	CampaignCreateContext(ctx context.Context, parameters map[string]interface{}) (string, error)
+	CampaignCreateWithParams(ctx context.Context, params *CampaignCreateParams) (string, error)
+	CampaignDelete(parameters map[string]interface{}) (bool, error)
+	CampaignDeleteContext(ctx context.Context, parameters map[string]interface{}) (bool, error)
+	CampaignDeleteWithParams(ctx context.Context, params *CampaignDeleteParams) (bool, error)
+	CampaignPause(parameters map[string]interface{}) (bool, error)
+	CampaignPauseContext(ctx context.Context, parameters map[string]interface{}) (bool, error)
+	CampaignPauseWithParams(ctx context.Context, params *CampaignPauseParams) (bool, error)
+	CampaignReplicate(parameters map[string]interface{}) (string, error)
+	CampaignReplicateContext(ctx context.Context, parameters map[string]interface{}) (string, error)
+	CampaignReplicateWithParams(ctx context.Context, params *CampaignReplicateParams) (string, error)
+	CampaignResume(parameters map[string]interface{}) (bool, error)
+	CampaignResumeContext(ctx context.Context, parameters map[string]interface{}) (bool, error)
+	CampaignResumeWithParams(ctx context.Context, params *CampaignResumeParams) (bool, error)
+	CampaignSchedule(parameters map[string]interface{}) (bool, error)
+	CampaignScheduleContext(ctx context.Context, parameters map[string]interface{}) (bool, error)
+	CampaignScheduleWithParams(ctx context.Context, params *CampaignScheduleParams) (bool, error)
+	CampaignSegmentTest(parameters map[string]interface{}) (int, error)
+	CampaignSegmentTestContext(ctx context.Context, parameters map[string]interface{}) (int, error)
+	CampaignSegmentTestWithParams(ctx context.Context, params *CampaignSegmentTestParams) (int, error)
+	CampaignSendNow(parameters map[string]interface{}) (bool, error)
+	CampaignSendNowContext(ctx context.Context, parameters map[string]interface{}) (bool, error)
+	CampaignSendNowWithParams(ctx context.Context, params *CampaignSendNowParams) (bool, error)
+	CampaignSendTest(parameters map[string]interface{}) (bool, error)
+	CampaignSendTestContext(ctx context.Context, parameters map[string]interface{}) (bool, error)
+	CampaignSendTestWithParams(ctx context.Context, params *CampaignSendTestParams) (bool, error)
+	CampaignShareReport(parameters map[string]interface{}) (*CampaignShareReportResult, error)
+	CampaignShareReportContext(ctx context.Context, parameters map[string]interface{}) (retVal *CampaignShareReportResult, err error)
+	CampaignShareReportWithParams(ctx context.Context, params *CampaignShareReportParams) (*CampaignShareReportResult, error)
+	CampaignTemplateContent(parameters map[string]interface{}) (map[string]interface{}, error)
+	CampaignTemplateContentContext(ctx context.Context, parameters map[string]interface{}) (retVal map[string]interface{}, err error)
+	CampaignTemplateContentWithParams(ctx context.Context, params *CampaignTemplateContentParams) (map[string]interface{}, error)
+	CampaignUnschedule(parameters map[string]interface{}) (bool, error)
+	CampaignUnscheduleContext(ctx context.Context, parameters map[string]interface{}) (bool, error)
+	CampaignUnscheduleWithParams(ctx context.Context, params *CampaignUnscheduleParams) (bool, error)
+	CampaignUpdate(parameters map[string]interface{}) (bool, error)
+	CampaignUpdateContext(ctx context.Context, parameters map[string]interface{}) (bool, error)
+	CampaignUpdateWithParams(ctx context.Context, params *CampaignUpdateParams) (bool, error)
+	Campaigns(parameters map[string]interface{}) (*CampaignsResult, error)
+	CampaignsContext(ctx context.Context, parameters map[string]interface{}) (retVal *CampaignsResult, err error)
+	CampaignsWithParams(ctx context.Context, params *CampaignsParams) (*CampaignsResult, error)
+	CampaignsPager(ctx context.Context, parameters map[string]interface{}) *Pager[CampaignsResultData]
+	FolderAdd(parameters map[string]interface{}) (int, error)
+	FolderAddContext(ctx context.Context, parameters map[string]interface{}) (int, error)
+	FolderAddWithParams(ctx context.Context, params *FolderAddParams) (int, error)
+	FolderDel(parameters map[string]interface{}) (bool, error)
+	FolderDelContext(ctx context.Context, parameters map[string]interface{}) (bool, error)
+	FolderDelWithParams(ctx context.Context, params *FolderDelParams) (bool, error)
+	FolderUpdate(parameters map[string]interface{}) (bool, error)
+	FolderUpdateContext(ctx context.Context, parameters map[string]interface{}) (bool, error)
+	FolderUpdateWithParams(ctx context.Context, params *FolderUpdateParams) (bool, error)
+	Folders(parameters map[string]interface{}) ([]FoldersResultItem, error)
+	FoldersContext(ctx context.Context, parameters map[string]interface{}) (retVal []FoldersResultItem, err error)
+	FoldersWithParams(ctx context.Context, params *FoldersParams) ([]FoldersResultItem, error)
+}
+
+//ListService manages lists, their members and interest groups, and reports
+//on list growth and activity
+type ListService interface {
+	GmonkeyActivity(parameters map[string]interface{}) ([]GmonkeyActivityResultItem, error)
+	GmonkeyActivityContext(ctx context.Context, parameters map[string]interface{}) (retVal []GmonkeyActivityResultItem, err error)
+	GmonkeyAdd(parameters map[string]interface{}) (*GmonkeyAddResult, error)
+	GmonkeyAddContext(ctx context.Context, parameters map[string]interface{}) (retVal *GmonkeyAddResult, err error)
+	GmonkeyAddWithParams(ctx context.Context, params *GmonkeyAddParams) (*GmonkeyAddResult, error)
+	GmonkeyDel(parameters map[string]interface{}) (*GmonkeyDelResult, error)
+	GmonkeyDelContext(ctx context.Context, parameters map[string]interface{}) (retVal *GmonkeyDelResult, err error)
+	GmonkeyDelWithParams(ctx context.Context, params *GmonkeyDelParams) (*GmonkeyDelResult, error)
+	GmonkeyMembers(parameters map[string]interface{}) ([]GmonkeyMembersItem, error)
+	GmonkeyMembersContext(ctx context.Context, parameters map[string]interface{}) (retVal []GmonkeyMembersItem, err error)
+	ListAbuseReports(parameters map[string]interface{}) (*ListAbuseReportsResponse, error)
+	ListAbuseReportsContext(ctx context.Context, parameters map[string]interface{}) (retVal *ListAbuseReportsResponse, err error)
+	ListAbuseReportsWithParams(ctx context.Context, params *ListAbuseReportsParams) (*ListAbuseReportsResponse, error)
+	ListAbuseReportsPager(ctx context.Context, parameters map[string]interface{}) *Pager[ListAbuseReportsResponseDataItem]
+	ListActivity(parameters map[string]interface{}) ([]ListActivityElement, error)
+	ListActivityContext(ctx context.Context, parameters map[string]interface{}) (retVal []ListActivityElement, err error)
+	ListActivityWithParams(ctx context.Context, params *ListActivityParams) ([]ListActivityElement, error)
+	ListBatchSubscribe(parameters map[string]interface{}) (*ListBatchSubscribeResponse, error)
+	ListBatchSubscribeContext(ctx context.Context, parameters map[string]interface{}) (retVal *ListBatchSubscribeResponse, err error)
+	ListBatchSubscribeWithParams(ctx context.Context, params *ListBatchSubscribeParams) (*ListBatchSubscribeResponse, error)
+	ListBatchUnsubscribe(parameters map[string]interface{}) (*ListBatchUnsubscribeResponse, error)
+	ListBatchUnsubscribeContext(ctx context.Context, parameters map[string]interface{}) (retVal *ListBatchUnsubscribeResponse, err error)
+	ListBatchUnsubscribeWithParams(ctx context.Context, params *ListBatchUnsubscribeParams) (*ListBatchUnsubscribeResponse, error)
+	ListClients(parameters map[string]interface{}) (*ListClientsResponse, error)
+	ListClientsContext(ctx context.Context, parameters map[string]interface{}) (retVal *ListClientsResponse, err error)
+	ListClientsWithParams(ctx context.Context, params *ListClientsParams) (*ListClientsResponse, error)
+	ListGrowthHistory(parameters map[string]interface{}) (*ListGrowthHistoryResponse, error)
+	ListGrowthHistoryContext(ctx context.Context, parameters map[string]interface{}) (retVal *ListGrowthHistoryResponse, err error)
+	ListGrowthHistoryWithParams(ctx context.Context, params *ListGrowthHistoryParams) (*ListGrowthHistoryResponse, error)
+	ListInterestGroupAdd(parameters map[string]interface{}) (bool, error)
+	ListInterestGroupAddContext(ctx context.Context, parameters map[string]interface{}) (bool, error)
+	ListInterestGroupAddWithParams(ctx context.Context, params *ListInterestGroupAddParams) (bool, error)
+	ListInterestGroupDel(parameters map[string]interface{}) (bool, error)
+	ListInterestGroupDelContext(ctx context.Context, parameters map[string]interface{}) (bool, error)
+	ListInterestGroupDelWithParams(ctx context.Context, params *ListInterestGroupDelParams) (bool, error)
+	ListInterestGroupUpdate(parameters map[string]interface{}) (bool, error)
+	ListInterestGroupUpdateContext(ctx context.Context, parameters map[string]interface{}) (bool, error)
+	ListInterestGroupUpdateWithParams(ctx context.Context, params *ListInterestGroupUpdateParams) (bool, error)
+	ListInterestGroupingAdd(parameters map[string]interface{}) (int, error)
+	ListInterestGroupingAddContext(ctx context.Context, parameters map[string]interface{}) (int, error)
+	ListInterestGroupingAddWithParams(ctx context.Context, params *ListInterestGroupingAddParams) (int, error)
+	ListInterestGroupingDel(parameters map[string]interface{}) (bool, error)
+	ListInterestGroupingDelContext(ctx context.Context, parameters map[string]interface{}) (bool, error)
+	ListInterestGroupingDelWithParams(ctx context.Context, params *ListInterestGroupingDelParams) (bool, error)
+	ListInterestGroupingUpdate(parameters map[string]interface{}) (bool, error)
+	ListInterestGroupingUpdateContext(ctx context.Context, parameters map[string]interface{}) (bool, error)
+	ListInterestGroupingUpdateWithParams(ctx context.Context, params *ListInterestGroupingUpdateParams) (bool, error)
+	ListInterestGroupings(parameters map[string]interface{}) ([]ListInterestGroupingsElement, error)
+	ListInterestGroupingsContext(ctx context.Context, parameters map[string]interface{}) (retVal []ListInterestGroupingsElement, err error)
+	ListInterestGroupingsWithParams(ctx context.Context, params *ListInterestGroupingsParams) ([]ListInterestGroupingsElement, error)
+	ListMemberActivity(parameters map[string]interface{}) (*ListMemberActivityResponse, error)
+	ListMemberActivityContext(ctx context.Context, parameters map[string]interface{}) (retVal *ListMemberActivityResponse, err error)
+	ListMemberActivityWithParams(ctx context.Context, params *ListMemberActivityParams) (*ListMemberActivityResponse, error)
+	ListMemberInfo(parameters map[string]interface{}) (*ListMemberInfoResponse, error)
+	ListMemberInfoContext(ctx context.Context, parameters map[string]interface{}) (retVal *ListMemberInfoResponse, err error)
+	ListMemberInfoWithParams(ctx context.Context, params *ListMemberInfoParams) (*ListMemberInfoResponse, error)
+	ListMembers(parameters map[string]interface{}) (*ListMembersResponse, error)
+	ListMembersContext(ctx context.Context, parameters map[string]interface{}) (retVal *ListMembersResponse, err error)
+	ListMembersWithParams(ctx context.Context, params *ListMembersParams) (*ListMembersResponse, error)
+	ListMembersPager(ctx context.Context, parameters map[string]interface{}) *Pager[ListMember]
+	ListMembersChangedSince(ctx context.Context, id, status string, since time.Time) *Pager[ListMember]
+	ListMergeVarAdd(parameters map[string]interface{}) (bool, error)
+	ListMergeVarAddContext(ctx context.Context, parameters map[string]interface{}) (bool, error)
+	ListMergeVarAddWithParams(ctx context.Context, params *ListMergeVarAddParams) (bool, error)
+	ListMergeVarDel(parameters map[string]interface{}) (bool, error)
+	ListMergeVarDelContext(ctx context.Context, parameters map[string]interface{}) (bool, error)
+	ListMergeVarDelWithParams(ctx context.Context, params *ListMergeVarDelParams) (bool, error)
+	ListMergeVarReset(parameters map[string]interface{}) (bool, error)
+	ListMergeVarResetContext(ctx context.Context, parameters map[string]interface{}) (bool, error)
+	ListMergeVarResetWithParams(ctx context.Context, params *ListMergeVarResetParams) (bool, error)
+	ListMergeVarSetValue(parameters map[string]interface{}) (bool, error)
+	ListMergeVarSetValueContext(ctx context.Context, parameters map[string]interface{}) (bool, error)
+	ListMergeVarSetValueWithParams(ctx context.Context, params *ListMergeVarSetValueParams) (bool, error)
+	ListMergeVarUpdate(parameters map[string]interface{}) (bool, error)
+	ListMergeVarUpdateContext(ctx context.Context, parameters map[string]interface{}) (bool, error)
+	ListMergeVarUpdateWithParams(ctx context.Context, params *ListMergeVarUpdateParams) (bool, error)
+	ListMergeVars(parameters map[string]interface{}) ([]MergeVar, error)
+	ListMergeVarsContext(ctx context.Context, parameters map[string]interface{}) (retVal []MergeVar, err error)
+	ListMergeVarsWithParams(ctx context.Context, params *ListMergeVarsParams) ([]MergeVar, error)
+	ListStaticSegmentAdd(parameters map[string]interface{}) (int, error)
+	ListStaticSegmentAddContext(ctx context.Context, parameters map[string]interface{}) (int, error)
+	ListStaticSegmentAddWithParams(ctx context.Context, params *ListStaticSegmentAddParams) (int, error)
+	ListStaticSegmentDel(parameters map[string]interface{}) (bool, error)
+	ListStaticSegmentDelContext(ctx context.Context, parameters map[string]interface{}) (bool, error)
+	ListStaticSegmentDelWithParams(ctx context.Context, params *ListStaticSegmentDelParams) (bool, error)
+	ListStaticSegmentMembersAdd(parameters map[string]interface{}) (*StaticSegmentMembersResponse, error)
+	ListStaticSegmentMembersAddContext(ctx context.Context, parameters map[string]interface{}) (*StaticSegmentMembersResponse, error)
+	ListStaticSegmentMembersAddWithParams(ctx context.Context, params *ListStaticSegmentMembersAddParams) (*StaticSegmentMembersResponse, error)
+	ListStaticSegmentMembersDel(parameters map[string]interface{}) (*StaticSegmentMembersResponse, error)
+	ListStaticSegmentMembersDelContext(ctx context.Context, parameters map[string]interface{}) (retVal *StaticSegmentMembersResponse, err error)
+	ListStaticSegmentMembersDelWithParams(ctx context.Context, params *ListStaticSegmentMembersDelParams) (*StaticSegmentMembersResponse, error)
+	ListStaticSegmentReset(parameters map[string]interface{}) (bool, error)
+	ListStaticSegmentResetContext(ctx context.Context, parameters map[string]interface{}) (bool, error)
+	ListStaticSegmentResetWithParams(ctx context.Context, params *ListStaticSegmentResetParams) (bool, error)
+	ListStaticSegments(parameters map[string]interface{}) ([]StaticSegment, error)
+	ListStaticSegmentsContext(ctx context.Context, parameters map[string]interface{}) (retVal []StaticSegment, err error)
+	ListStaticSegmentsWithParams(ctx context.Context, params *ListStaticSegmentsParams) ([]StaticSegment, error)
+	ListSubscribe(parameters map[string]interface{}) (bool, error)
+	ListSubscribeContext(ctx context.Context, parameters map[string]interface{}) (bool, error)
+	ListSubscribeWithParams(ctx context.Context, params *ListSubscribeParams) (bool, error)
+	ListUnsubscribe(parameters map[string]interface{}) (bool, error)
+	ListUnsubscribeContext(ctx context.Context, parameters map[string]interface{}) (bool, error)
+	ListUnsubscribeWithParams(ctx context.Context, params *ListUnsubscribeParams) (bool, error)
+	ListUpdateMember(parameters map[string]interface{}) (bool, error)
+	ListUpdateMemberContext(ctx context.Context, parameters map[string]interface{}) (bool, error)
+	ListUpdateMemberWithParams(ctx context.Context, params *ListUpdateMemberParams) (bool, error)
+	ListWebhookAdd(parameters map[string]interface{}) (bool, error)
+	ListWebhookAddContext(ctx context.Context, parameters map[string]interface{}) (bool, error)
+	ListWebhookAddWithParams(ctx context.Context, params *ListWebhookAddParams) (bool, error)
+	ListWebhookDel(parameters map[string]interface{}) (bool, error)
+	ListWebhookDelContext(ctx context.Context, parameters map[string]interface{}) (bool, error)
+	ListWebhookDelWithParams(ctx context.Context, params *ListWebhookDelParams) (bool, error)
+	ListWebhooks(parameters map[string]interface{}) ([]Webhook, error)
+	ListWebhooksContext(ctx context.Context, parameters map[string]interface{}) (retVal []Webhook, err error)
+	ListWebhooksWithParams(ctx context.Context, params *ListWebhooksParams) ([]Webhook, error)
+	EnsureWebhook(ctx context.Context, id string, hook Webhook) (bool, error)
+	SearchMembers(parameters map[string]interface{}) (*SearchMembersResponse, error)
+	SearchMembersContext(ctx context.Context, parameters map[string]interface{}) (retVal *SearchMembersResponse, err error)
+	SearchMembersWithParams(ctx context.Context, params *SearchMembersParams) (*SearchMembersResponse, error)
+	SearchMembersPager(ctx context.Context, parameters map[string]interface{}) *Pager[MemberInfo]
+}
+
+//EcommService records ecommerce orders, optionally attributed to campaigns
+type EcommService interface {
+	CampaignEcommOrderAdd(parameters map[string]interface{}) (bool, error)
+	CampaignEcommOrderAddContext(ctx context.Context, parameters map[string]interface{}) (bool, error)
+	CampaignEcommOrderAddWithParams(ctx context.Context, params *CampaignEcommOrderAddParams) (bool, error)
+	CampaignEcommOrders(parameters map[string]interface{}) (*CampaignEcommOrdersResult, error)
+	CampaignEcommOrdersContext(ctx context.Context, parameters map[string]interface{}) (retVal *CampaignEcommOrdersResult, err error)
+	CampaignEcommOrdersWithParams(ctx context.Context, params *CampaignEcommOrdersParams) (*CampaignEcommOrdersResult, error)
+	CampaignEcommOrdersPager(ctx context.Context, parameters map[string]interface{}) *Pager[CampaignEcommOrdersResultDataItem]
+	EcommOrderAdd(parameters map[string]interface{}) (bool, error)
+	EcommOrderAddContext(ctx context.Context, parameters map[string]interface{}) (bool, error)
+	EcommOrderAddWithParams(ctx context.Context, params *EcommOrderAddParams) (bool, error)
+	EcommOrderDel(parameters map[string]interface{}) (bool, error)
+	EcommOrderDelContext(ctx context.Context, parameters map[string]interface{}) (bool, error)
+	EcommOrderDelWithParams(ctx context.Context, params *EcommOrderDelParams) (bool, error)
+	EcommOrders(parameters map[string]interface{}) (*EcommOrdersResult, error)
+	EcommOrdersContext(ctx context.Context, parameters map[string]interface{}) (retVal *EcommOrdersResult, err error)
+	EcommOrdersWithParams(ctx context.Context, params *EcommOrdersParams) (*EcommOrdersResult, error)
+	EcommOrdersPager(ctx context.Context, parameters map[string]interface{}) *Pager[EcommOrdersResultDataItem]
+}
+
+//ReportService reports on sent campaigns: their statistics, recipients and
+//the activity of each member
+type ReportService interface {
+	CampaignAbuseReports(parameters map[string]interface{}) (*CampaignAbuseReportsResult, error)
+	CampaignAbuseReportsContext(ctx context.Context, parameters map[string]interface{}) (retVal *CampaignAbuseReportsResult, err error)
+	CampaignAbuseReportsWithParams(ctx context.Context, params *CampaignAbuseReportsParams) (*CampaignAbuseReportsResult, error)
+	CampaignAbuseReportsPager(ctx context.Context, parameters map[string]interface{}) *Pager[CampaignAbuseReportsResultDataItem]
+	CampaignAdvice(parameters map[string]interface{}) ([]CampaignAdviceResultItem, error)
+	CampaignAdviceContext(ctx context.Context, parameters map[string]interface{}) (retVal []CampaignAdviceResultItem, err error)
+	CampaignAdviceWithParams(ctx context.Context, params *CampaignAdviceParams) ([]CampaignAdviceResultItem, error)
+	CampaignAnalytics(parameters map[string]interface{}) (*CampaignAnalyticsResult, error)
+	CampaignAnalyticsContext(ctx context.Context, parameters map[string]interface{}) (retVal *CampaignAnalyticsResult, err error)
+	CampaignAnalyticsWithParams(ctx context.Context, params *CampaignAnalyticsParams) (*CampaignAnalyticsResult, error)
+	CampaignBounceMessage(parameters map[string]interface{}) (*CampaignBounceMessageResult, error)
+	CampaignBounceMessageContext(ctx context.Context, parameters map[string]interface{}) (retVal *CampaignBounceMessageResult, err error)
+	CampaignBounceMessageWithParams(ctx context.Context, params *CampaignBounceMessageParams) (*CampaignBounceMessageResult, error)
+	CampaignBounceMessages(parameters map[string]interface{}) (*CampaignBounceMessagesResult, error)
+	CampaignBounceMessagesContext(ctx context.Context, parameters map[string]interface{}) (retVal *CampaignBounceMessagesResult, err error)
+	CampaignBounceMessagesWithParams(ctx context.Context, params *CampaignBounceMessagesParams) (*CampaignBounceMessagesResult, error)
+	CampaignBounceMessagesPager(ctx context.Context, parameters map[string]interface{}) *Pager[CampaignBounceMessageResult]
+	CampaignClickDetailAIM(parameters map[string]interface{}) (*CampaignClickDetailAIMResult, error)
+	CampaignClickDetailAIMContext(ctx context.Context, parameters map[string]interface{}) (retVal *CampaignClickDetailAIMResult, err error)
+	CampaignClickDetailAIMWithParams(ctx context.Context, params *CampaignClickDetailAIMParams) (*CampaignClickDetailAIMResult, error)
+	CampaignClickDetailAIMPager(ctx context.Context, parameters map[string]interface{}) *Pager[CampaignClickDetailAIMResultDataItem]
+	CampaignClickDetailAIMStream(ctx context.Context, parameters map[string]interface{}, fn func(CampaignClickDetailAIMResultDataItem) error) (int, error)
+	CampaignClickStats(parameters map[string]interface{}) (map[string]CampaignClickStatsResultItem, error)
+	CampaignClickStatsContext(ctx context.Context, parameters map[string]interface{}) (retVal map[string]CampaignClickStatsResultItem, err error)
+	CampaignClickStatsWithParams(ctx context.Context, params *CampaignClickStatsParams) (map[string]CampaignClickStatsResultItem, error)
+	CampaignEepUrlStats(parameters map[string]interface{}) (*CampaignEepUrlStatsResult, error)
+	CampaignEepUrlStatsContext(ctx context.Context, parameters map[string]interface{}) (retVal *CampaignEepUrlStatsResult, err error)
+	CampaignEepUrlStatsWithParams(ctx context.Context, params *CampaignEepUrlStatsParams) (*CampaignEepUrlStatsResult, error)
+	CampaignEmailDomainPerformance(parameters map[string]interface{}) ([]CampaignEmailDomainPerformanceResultItem, error)
+	CampaignEmailDomainPerformanceContext(ctx context.Context, parameters map[string]interface{}) (retVal []CampaignEmailDomainPerformanceResultItem, err error)
+	CampaignEmailDomainPerformanceWithParams(ctx context.Context, params *CampaignEmailDomainPerformanceParams) ([]CampaignEmailDomainPerformanceResultItem, error)
+	CampaignEmailStatsAIM(parameters map[string]interface{}) (*CampaignEmailStatsAIMResult, error)
+	CampaignEmailStatsAIMContext(ctx context.Context, parameters map[string]interface{}) (retVal *CampaignEmailStatsAIMResult, err error)
+	CampaignEmailStatsAIMWithParams(ctx context.Context, params *CampaignEmailStatsAIMParams) (*CampaignEmailStatsAIMResult, error)
+	CampaignEmailStatsAIMAll(parameters map[string]interface{}) (*CampaignEmailStatsAIMAllResult, error)
+	CampaignEmailStatsAIMAllContext(ctx context.Context, parameters map[string]interface{}) (retVal *CampaignEmailStatsAIMAllResult, err error)
+	CampaignEmailStatsAIMAllWithParams(ctx context.Context, params *CampaignEmailStatsAIMAllParams) (*CampaignEmailStatsAIMAllResult, error)
+	CampaignEmailStatsAIMAllPager(ctx context.Context, parameters map[string]interface{}) *Pager[CampaignEmailStatsAIMAllItem]
+	CampaignEmailStatsAIMAllStream(ctx context.Context, parameters map[string]interface{}, fn func(CampaignEmailStatsAIMAllItem) error) (int, error)
+	CampaignGeoOpens(parameters map[string]interface{}) ([]CampaignGeoOpensResultItem, error)
+	CampaignGeoOpensContext(ctx context.Context, parameters map[string]interface{}) (retVal []CampaignGeoOpensResultItem, err error)
+	CampaignGeoOpensWithParams(ctx context.Context, params *CampaignGeoOpensParams) ([]CampaignGeoOpensResultItem, error)
+	CampaignGeoOpensForCountry(parameters map[string]interface{}) ([]CampaignGeoOpensForCountryReturnItem, error)
+	CampaignGeoOpensForCountryContext(ctx context.Context, parameters map[string]interface{}) (retVal []CampaignGeoOpensForCountryReturnItem, err error)
+	CampaignGeoOpensForCountryWithParams(ctx context.Context, params *CampaignGeoOpensForCountryParams) ([]CampaignGeoOpensForCountryReturnItem, error)
+	CampaignMembers(parameters map[string]interface{}) (*CampaignMembersResult, error)
+	CampaignMembersContext(ctx context.Context, parameters map[string]interface{}) (retVal *CampaignMembersResult, err error)
+	CampaignMembersWithParams(ctx context.Context, params *CampaignMembersParams) (*CampaignMembersResult, error)
+	CampaignMembersPager(ctx context.Context, parameters map[string]interface{}) *Pager[CampaignMembersResultDataItem]
+	CampaignMembersStream(ctx context.Context, parameters map[string]interface{}, fn func(CampaignMembersResultDataItem) error) (int, error)
+	CampaignNotOpenedAIM(parameters map[string]interface{}) (*CampaignNotOpenedAIMResult, error)
+	CampaignNotOpenedAIMContext(ctx context.Context, parameters map[string]interface{}) (retVal *CampaignNotOpenedAIMResult, err error)
+	CampaignNotOpenedAIMWithParams(ctx context.Context, params *CampaignNotOpenedAIMParams) (*CampaignNotOpenedAIMResult, error)
+	CampaignNotOpenedAIMPager(ctx context.Context, parameters map[string]interface{}) *Pager[string]
+	CampaignNotOpenedAIMStream(ctx context.Context, parameters map[string]interface{}, fn func(string) error) (int, error)
+	CampaignOpenedAIM(parameters map[string]interface{}) (*CampaignOpenedAIMResult, error)
+	CampaignOpenedAIMContext(ctx context.Context, parameters map[string]interface{}) (retVal *CampaignOpenedAIMResult, err error)
+	CampaignOpenedAIMWithParams(ctx context.Context, params *CampaignOpenedAIMParams) (*CampaignOpenedAIMResult, error)
+	CampaignOpenedAIMPager(ctx context.Context, parameters map[string]interface{}) *Pager[CampaignOpenedAIMResultDataItem]
+	CampaignOpenedAIMStream(ctx context.Context, parameters map[string]interface{}, fn func(CampaignOpenedAIMResultDataItem) error) (int, error)
+	CampaignStats(parameters map[string]interface{}) (*CampaignStatsResult, error)
+	CampaignStatsContext(ctx context.Context, parameters map[string]interface{}) (retVal *CampaignStatsResult, err error)
+	CampaignStatsWithParams(ctx context.Context, params *CampaignStatsParams) (*CampaignStatsResult, error)
+	CampaignUnsubscribes(parameters map[string]interface{}) (*CampaignUnsubscribesResult, error)
+	CampaignUnsubscribesContext(ctx context.Context, parameters map[string]interface{}) (retVal *CampaignUnsubscribesResult, err error)
+	CampaignUnsubscribesWithParams(ctx context.Context, params *CampaignUnsubscribesParams) (*CampaignUnsubscribesResult, error)
+	CampaignUnsubscribesPager(ctx context.Context, parameters map[string]interface{}) *Pager[CampaignUnsubscribesResultDataItem]
+}
+
+//HelperService has the routines about the account rather than a campaign or
+//list, and the utilities
+type HelperService interface {
+	CampaignsForEmail(parameters map[string]interface{}) ([]string, error)
+	CampaignsForEmailContext(ctx context.Context, parameters map[string]interface{}) (retVal []string, err error)
+	CampaignsForEmailWithParams(ctx context.Context, params *CampaignsForEmailParams) ([]string, error)
+	ChimpChatter(parameters map[string]interface{}) ([]ChimpChatterResultItem, error)
+	ChimpChatterContext(ctx context.Context, parameters map[string]interface{}) (retVal []ChimpChatterResultItem, err error)
+	GenerateText(parameters map[string]interface{}) (string, error)
+	GenerateTextContext(ctx context.Context, parameters map[string]interface{}) (string, error)
+	GenerateTextWithParams(ctx context.Context, params *GenerateTextParams) (string, error)
+	GetAccountDetails(parameters map[string]interface{}) (*GetAccountDetailsResult, error)
+	GetAccountDetailsContext(ctx context.Context, parameters map[string]interface{}) (retVal *GetAccountDetailsResult, err error)
+	GetAccountDetailsWithParams(ctx context.Context, params *GetAccountDetailsParams) (*GetAccountDetailsResult, error)
+	GetVerifiedDomains(parameters map[string]interface{}) ([]GetVerifiedDomainsResultItem, error)
+	GetVerifiedDomainsContext(ctx context.Context, parameters map[string]interface{}) (retVal []GetVerifiedDomainsResultItem, err error)
+	InlineCss(parameters map[string]interface{}) (string, error)
+	InlineCssContext(ctx context.Context, parameters map[string]interface{}) (string, error)
+	InlineCssWithParams(ctx context.Context, params *InlineCssParams) (string, error)
+	ListsForEmail(parameters map[string]interface{}) ([]string, error)
+	ListsForEmailContext(ctx context.Context, parameters map[string]interface{}) (retVal []string, err error)
+	ListsForEmailWithParams(ctx context.Context, params *ListsForEmailParams) ([]string, error)
+	Ping() (string, error)
+	PingContext(ctx context.Context) (string, error)
+}
+
+//Client is every routine of the API. *API implements it; depend on Client, or
+//on the service interfaces it is made of, to substitute a fake in tests, such
+//as the mock in package mailchimpmock.
+type Client interface {
+	CampaignService
+	ListService
+	EcommService
+	ReportService
+	HelperService
+}
+
+var _ Client = (*API)(nil)
@@ -0,0 +1,16 @@
+package mailchimp
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestClientCoversAPI(t *testing.T) {
+	api := reflect.TypeOf((*API)(nil))
+	client := reflect.TypeOf((*Client)(nil)).Elem()
+	for i := 0; i < api.NumMethod(); i++ {
+		if _, ok := client.MethodByName(api.Method(i).Name); !ok {
+			t.Errorf("Client: missing %v, which should be added to one of the service interfaces", api.Method(i).Name)
+		}
+	}
+}
@@ -0,0 +1,273 @@
+package mailchimp
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+//stream makes a request to Mailchimp and decodes the response while it is
+//read instead of reading it into memory first, calling data with the decoder
+//positioned at the value of the response's "data" key. It returns the
+//response's "total". Requests are retried as usual until a successful
+//response arrives, including responses that are Mailchimp errors, but never
+//once data has been called.
+func stream(ctx context.Context, a *API, method string, parameters map[string]interface{}, data func(*json.Decoder) error) (int, error) {
+	b, err := payload(a, parameters)
+	if err != nil {
+		return 0, err
+	}
+	for attempt := 1; ; attempt++ {
+		resp, start, release, err := send(ctx, a, method, b)
+		status := 0
+		if err == nil {
+			status = resp.StatusCode
+			if status/100 == 2 {
+				var total int
+				var started bool
+				total, started, err = streamBody(ctx, a, method, b, start, resp, data)
+				release()
+				var chimpErr ChimpError
+				if err == nil || started || !errors.As(err, &chimpErr) {
+					return total, err
+				}
+			} else {
+				_, err = readResponse(ctx, a, method, b, start, resp)
+				release()
+			}
+		}
+		if attempt >= a.retry.MaxAttempts || !a.retry.retryable(ctx, method, status, err) {
+			return 0, err
+		}
+		if err = a.retry.wait(ctx, method, attempt); err != nil {
+			return 0, err
+		}
+	}
+}
+
+//streamBody decodes a successful response with decodeStream, reporting
+//whether data was called. The response is kept until then, so that an error
+//object is returned with the APIError describing it.
+func streamBody(ctx context.Context, a *API, method string, b []byte, start time.Time, resp *http.Response, data func(*json.Decoder) error) (int, bool, error) {
+	defer resp.Body.Close()
+	head := new(headBuffer)
+	started := false
+	dec := json.NewDecoder(io.TeeReader(resp.Body, head))
+	total, err := decodeStream(method, resp.StatusCode, dec, a.strict, func(dec *json.Decoder) error {
+		started = true
+		head.stop()
+		return data(dec)
+	})
+	var apiErr APIError
+	if !started && errors.As(err, &apiErr) {
+		apiErr.Body = head.Bytes()
+		err = apiErr
+	}
+	var timeoutErr TimeoutError
+	if err != nil && ctx.Err() != nil && !errors.As(err, &timeoutErr) {
+		err = TimeoutError{method, ctx.Err()}
+	}
+	var body []byte
+	if !started {
+		body = head.Bytes()
+	}
+	a.logRequest(ctx, method, start, resp.StatusCode, b, body, err)
+	return total, started, err
+}
+
+//headBuffer keeps what is read of a response until stop is called, which
+//holds a whole error object but not the whole of a large streamed response
+type headBuffer struct {
+	bytes.Buffer
+	stopped bool
+}
+
+func (h *headBuffer) Write(p []byte) (int, error) {
+	if !h.stopped {
+		h.Buffer.Write(p)
+	}
+	return len(p), nil
+}
+
+func (h *headBuffer) stop() {
+	h.stopped = true
+	h.Reset()
+}
+
+//decodeStream decodes a response object from dec, handing the value of its
+//"data" key to data and returning its "total" or the ChimpError it describes.
+//In strict mode other keys are errors.
+func decodeStream(method string, status int, dec *json.Decoder, strict bool, data func(*json.Decoder) error) (int, error) {
+	if err := expectDelim(dec, '{'); err != nil {
+		return 0, DecodeError{Method: method, Err: err}
+	}
+	var total FlexInt
+	var chimpErr ChimpError
+	for dec.More() {
+		token, err := dec.Token()
+		if err != nil {
+			return 0, DecodeError{Method: method, Err: err}
+		}
+		key, _ := token.(string)
+		switch key {
+		case "data":
+			err = data(dec)
+		case "total":
+			if err = dec.Decode(&total); err != nil {
+				err = DecodeError{method, key, nil, err}
+			}
+		case "error":
+			err = dec.Decode(&chimpErr.Err)
+		case "code":
+			err = dec.Decode(&chimpErr.Code)
+		default:
+			var skipped json.RawMessage
+			if err = dec.Decode(&skipped); err == nil && strict {
+				err = DecodeError{method, key, nil, fmt.Errorf("%v%q", unknownFieldPrefix, key)}
+			}
+		}
+		if err != nil {
+			return 0, err
+		}
+	}
+	if chimpErr.Err != "" || chimpErr.Code != 0 {
+		return 0, APIError{method, status, nil, chimpErr}
+	}
+	if _, err := dec.Token(); err != nil {
+		return 0, DecodeError{Method: method, Err: err}
+	}
+	return int(total), nil
+}
+
+//expectDelim reads the next token from dec, failing unless it is delim
+func expectDelim(dec *json.Decoder, delim json.Delim) error {
+	token, err := dec.Token()
+	if err != nil {
+		return err
+	}
+	if token != delim {
+		return fmt.Errorf("expected %v but found %v", delim, token)
+	}
+	return nil
+}
+
+//decodeItem decodes the next value from dec into item, returning a
+//DecodeError locating the failure under path if it does not fit
+func decodeItem(method string, dec *json.Decoder, strict bool, path string, item interface{}) error {
+	var raw json.RawMessage
+	if err := dec.Decode(&raw); err != nil {
+		return DecodeError{method, path, nil, err}
+	}
+	itemDec := json.NewDecoder(bytes.NewReader(raw))
+	if strict {
+		itemDec.DisallowUnknownFields()
+	}
+	if err := itemDec.Decode(item); err != nil {
+		decodeErr := newDecodeError(method, raw, item, err)
+		if decodeErr.Field == "" {
+			decodeErr.Field = path
+		} else {
+			decodeErr.Field = path + "." + decodeErr.Field
+		}
+		return decodeErr
+	}
+	return nil
+}
+
+//streamSlice makes a data function that decodes a "data" array one element
+//at a time, calling fn with each
+func streamSlice[T any](a *API, method string, fn func(T) error) func(*json.Decoder) error {
+	return func(dec *json.Decoder) error {
+		if err := expectDelim(dec, '['); err != nil {
+			return DecodeError{method, "data", nil, err}
+		}
+		for i := 0; dec.More(); i++ {
+			var item T
+			if err := decodeItem(method, dec, a.strict, "data."+strconv.Itoa(i), &item); err != nil {
+				return err
+			}
+			if err := fn(item); err != nil {
+				return err
+			}
+		}
+		if _, err := dec.Token(); err != nil {
+			return DecodeError{method, "data", nil, err}
+		}
+		return nil
+	}
+}
+
+//CampaignMembersStream calls fn with each member in the page of
+//CampaignMembers selected by parameters as it is decoded, returning the
+//total number of members. An error returned by fn stops the stream and is
+//returned as is.
+func (a *API) CampaignMembersStream(ctx context.Context, parameters map[string]interface{}, fn func(CampaignMembersResultDataItem) error) (int, error) {
+	return stream(ctx, a, "campaignMembers", parameters, streamSlice(a, "campaignMembers", fn))
+}
+
+//CampaignClickDetailAIMStream calls fn with each member in the page of
+//CampaignClickDetailAIM selected by parameters as it is decoded, returning
+//the total number of members. An error returned by fn stops the stream and is
+//returned as is.
+func (a *API) CampaignClickDetailAIMStream(ctx context.Context, parameters map[string]interface{}, fn func(CampaignClickDetailAIMResultDataItem) error) (int, error) {
+	return stream(ctx, a, "campaignClickDetailAIM", parameters, streamSlice(a, "campaignClickDetailAIM", fn))
+}
+
+//CampaignNotOpenedAIMStream calls fn with each email address in the page of
+//CampaignNotOpenedAIM selected by parameters as it is decoded, returning the
+//total number of addresses. An error returned by fn stops the stream and is
+//returned as is.
+func (a *API) CampaignNotOpenedAIMStream(ctx context.Context, parameters map[string]interface{}, fn func(string) error) (int, error) {
+	return stream(ctx, a, "campaignNotOpenedAIM", parameters, streamSlice(a, "campaignNotOpenedAIM", fn))
+}
+
+//CampaignOpenedAIMStream calls fn with each member in the page of
+//CampaignOpenedAIM selected by parameters as it is decoded, returning the
+//total number of members. An error returned by fn stops the stream and is
+//returned as is.
+func (a *API) CampaignOpenedAIMStream(ctx context.Context, parameters map[string]interface{}, fn func(CampaignOpenedAIMResultDataItem) error) (int, error) {
+	return stream(ctx, a, "campaignOpenedAIM", parameters, streamSlice(a, "campaignOpenedAIM", fn))
+}
+
+//CampaignEmailStatsAIMAllStream calls fn with the activity of each member in
+//the page of CampaignEmailStatsAIMAll selected by parameters as it is
+//decoded, in the order Mailchimp sent them, returning the total number of
+//members. An error returned by fn stops the stream and is returned as is.
+func (a *API) CampaignEmailStatsAIMAllStream(ctx context.Context, parameters map[string]interface{}, fn func(CampaignEmailStatsAIMAllItem) error) (int, error) {
+	method := "campaignEmailStatsAIMAll"
+	return stream(ctx, a, method, parameters, func(dec *json.Decoder) error {
+		token, err := dec.Token()
+		if err != nil {
+			return DecodeError{method, "data", nil, err}
+		}
+		//an empty page is sent as [] rather than {}
+		if token == json.Delim('[') {
+			return expectDelim(dec, ']')
+		} else if token != json.Delim('{') {
+			return DecodeError{method, "data", nil, fmt.Errorf("expected { but found %v", token)}
+		}
+		for dec.More() {
+			token, err := dec.Token()
+			if err != nil {
+				return DecodeError{method, "data", nil, err}
+			}
+			item := CampaignEmailStatsAIMAllItem{Email: token.(string)}
+			if err := decodeItem(method, dec, a.strict, "data."+item.Email, &item.Activity); err != nil {
+				return err
+			}
+			if err := fn(item); err != nil {
+				return err
+			}
+		}
+		if _, err := dec.Token(); err != nil {
+			return DecodeError{method, "data", nil, err}
+		}
+		return nil
+	})
+}
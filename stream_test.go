@@ -0,0 +1,160 @@
+package mailchimp
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func streamAPI(t *testing.T, handler http.HandlerFunc) (*API, func()) {
+	server := httptest.NewServer(handler)
+	api, err := NewClient("abc123-us1", WithBaseURL(server.URL+"/1.3/"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return api, server.Close
+}
+
+func TestStream(t *testing.T) {
+	//the handler holds the end of the response until the first member has
+	//been handed to the callback, which only a streaming decoder allows
+	firstSeen := make(chan struct{})
+	api, done := streamAPI(t, func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"total":3,"data":[{"email":"a@example.com","clicks":"2"},`)
+		w.(http.Flusher).Flush()
+		select {
+		case <-firstSeen:
+		case <-time.After(5 * time.Second):
+		}
+		fmt.Fprint(w, `{"email":"b@example.com","clicks":1},{"email":"c@example.com","clicks":0}]}`)
+	})
+	defer done()
+	var emails []string
+	total, err := api.CampaignClickDetailAIMStream(context.Background(), nil, func(item CampaignClickDetailAIMResultDataItem) error {
+		if len(emails) == 0 {
+			close(firstSeen)
+		}
+		emails = append(emails, item.Email)
+		return nil
+	})
+	if err != nil {
+		t.Fatal("CampaignClickDetailAIMStream:", err)
+	}
+	verify(t, "CampaignClickDetailAIMStream total", 3, total)
+	verify(t, "CampaignClickDetailAIMStream", "a@example.com b@example.com c@example.com", fmt.Sprint(emails[0], " ", emails[1], " ", emails[2]))
+}
+
+func TestStreamCallbackError(t *testing.T) {
+	api, done := streamAPI(t, func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"total":3,"data":["a@example.com","b@example.com","c@example.com"]}`)
+	})
+	defer done()
+	stop := errors.New("stop")
+	n := 0
+	_, err := api.CampaignNotOpenedAIMStream(context.Background(), nil, func(email string) error {
+		n++
+		return stop
+	})
+	if err != stop || n != 1 {
+		t.Errorf("CampaignNotOpenedAIMStream: expected the callback's error after 1 call but got %v after %d", err, n)
+	}
+}
+
+func TestStreamChimpError(t *testing.T) {
+	api, done := streamAPI(t, func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"error":"Invalid Campaign ID: abc","code":300}`)
+	})
+	defer done()
+	_, err := api.CampaignMembersStream(context.Background(), nil, func(CampaignMembersResultDataItem) error {
+		return nil
+	})
+	if !errors.Is(err, ErrCampaignNotFound) {
+		t.Error("CampaignMembersStream: expected ErrCampaignNotFound but got", err)
+	}
+}
+
+func TestStreamRetry(t *testing.T) {
+	requests := new(int32)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if atomic.AddInt32(requests, 1) == 1 {
+			fmt.Fprint(w, `{"error":"Too many connections","code":-50}`)
+			return
+		}
+		fmt.Fprint(w, `{"total":1,"data":[{"email":"a@example.com"}]}`)
+	}))
+	defer server.Close()
+	var emails []string
+	total, err := retryAPI(t, server, fastRetries).CampaignMembersStream(context.Background(), nil, func(item CampaignMembersResultDataItem) error {
+		emails = append(emails, item.Email)
+		return nil
+	})
+	if err != nil {
+		t.Fatal("CampaignMembersStream:", err)
+	}
+	if *requests != 2 || total != 1 || len(emails) != 1 {
+		t.Errorf("expected 2 requests and one member but got %d, %d and %v", *requests, total, emails)
+	}
+}
+
+func TestStreamChimpErrorBody(t *testing.T) {
+	body := `{"error":"Too many connections","code":-50}`
+	server, requests := flakyServer(3, http.StatusOK, body)
+	defer server.Close()
+	_, err := retryAPI(t, server, fastRetries).CampaignMembersStream(context.Background(), nil, func(CampaignMembersResultDataItem) error {
+		return nil
+	})
+	var apiErr APIError
+	if !errors.As(err, &apiErr) || apiErr.StatusCode != http.StatusOK || string(apiErr.Body) != body {
+		t.Errorf("CampaignMembersStream: expected an APIError with status 200 and the response body but got %#v", err)
+	}
+	verify(t, "CampaignMembersStream requests", int32(fastRetries.MaxAttempts), *requests)
+}
+
+func TestStreamDecodeError(t *testing.T) {
+	api, done := streamAPI(t, func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"total":2,"data":[{"email":"a@example.com","open_count":1},{"email":"b@example.com","open_count":"many"}]}`)
+	})
+	defer done()
+	_, err := api.CampaignOpenedAIMStream(context.Background(), nil, func(CampaignOpenedAIMResultDataItem) error {
+		return nil
+	})
+	var decodeErr DecodeError
+	if !errors.As(err, &decodeErr) || decodeErr.Field != "data.1.open_count" {
+		t.Error("CampaignOpenedAIMStream: expected a DecodeError for data.1.open_count but got", err)
+	}
+}
+
+func TestStreamEmailStatsAIMAll(t *testing.T) {
+	api, done := streamAPI(t, func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"total":2,"data":{"z@example.com":[{"action":"open","timestamp":"2010-06-04 03:01:19","url":null}],"a@example.com":[]}}`)
+	})
+	defer done()
+	var items []CampaignEmailStatsAIMAllItem
+	total, err := api.CampaignEmailStatsAIMAllStream(context.Background(), nil, func(item CampaignEmailStatsAIMAllItem) error {
+		items = append(items, item)
+		return nil
+	})
+	if err != nil {
+		t.Fatal("CampaignEmailStatsAIMAllStream:", err)
+	}
+	verify(t, "CampaignEmailStatsAIMAllStream total", 2, total)
+	verify(t, "CampaignEmailStatsAIMAllStream", 2, len(items))
+	verify(t, "CampaignEmailStatsAIMAllStream", "z@example.com", items[0].Email)
+	verify(t, "CampaignEmailStatsAIMAllStream", "open", items[0].Activity[0].Action)
+
+	api, done = streamAPI(t, func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"total":0,"data":[]}`)
+	})
+	defer done()
+	if _, err := api.CampaignEmailStatsAIMAllStream(context.Background(), nil, func(CampaignEmailStatsAIMAllItem) error {
+		t.Error("CampaignEmailStatsAIMAllStream: expected no items")
+		return nil
+	}); err != nil {
+		t.Error("CampaignEmailStatsAIMAllStream:", err)
+	}
+}
@@ -0,0 +1,141 @@
+package mailchimp
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"time"
+)
+
+//ChimpTime is a named struct with a single anonymous field of type time.Time
+//and inherits all its methods except MarshalJSON and UnmarshalJSON, which are
+//overridden since Mailchimp does not adhere to the RFC3339 format.
+//
+//Mailchimp reports times in GMT, so decoded times are in UTC; convert them
+//with In, e.g. using the Location of the account. Empty dates, such as the
+//last_open of a member who never opened a campaign, decode to the zero time,
+//which IsZero reports.
+type ChimpTime struct {
+	time.Time
+}
+
+//format string for time.Format
+const ChimpTimeFormat = "2006-01-02 15:04:05"
+
+//chimpLayouts are the date shapes the 1.3 API emits, tried in order. Layouts
+//without an offset are parsed as GMT, and time.Parse accepts fractional
+//seconds after the seconds field of any of them.
+var chimpLayouts = []string{
+	ChimpTimeFormat,
+	"2006-01-02 15:04:05 -0700",
+	time.RFC3339,
+	"2006-01-02T15:04:05",
+	"2006-01-02 15:04",
+	"2006-01-02",
+	"2006-01",
+}
+
+//zeroTimes are the values Mailchimp sends in place of a missing date
+var zeroTimes = map[string]bool{
+	"":                    true,
+	"0000-00-00":          true,
+	"0000-00-00 00:00:00": true,
+}
+
+//TimeError is returned when decoding a ChimpTime from a value in none of the
+//date formats Mailchimp uses
+type TimeError struct {
+	Value string
+}
+
+func (e TimeError) Error() string {
+	return fmt.Sprintf("mailchimp: unrecognised time format %v", e.Value)
+}
+
+func (t *ChimpTime) UnmarshalJSON(data []byte) error {
+	data = bytes.TrimSpace(data)
+	if string(data) == "null" {
+		t.Time = time.Time{}
+		return nil
+	}
+	s := string(data)
+	if len(data) > 0 && data[0] == '"' {
+		if err := json.Unmarshal(data, &s); err != nil {
+			return TimeError{string(data)}
+		}
+	}
+	parsed, err := parseChimpTime(s)
+	if err != nil {
+		return err
+	}
+	t.Time = parsed
+	return nil
+}
+
+//MarshalJSON encodes t in ChimpTimeFormat in GMT, and the zero time as an
+//empty string, so that values decoded from Mailchimp encode to what it sent
+func (t ChimpTime) MarshalJSON() ([]byte, error) {
+	if t.IsZero() {
+		return []byte(`""`), nil
+	}
+	return json.Marshal(t.UTC().Format(ChimpTimeFormat))
+}
+
+//parseChimpTime parses s, which is in one of chimpLayouts or a Unix
+//timestamp, as Mailchimp sends for some gmonkey dates
+func parseChimpTime(s string) (time.Time, error) {
+	if zeroTimes[s] {
+		return time.Time{}, nil
+	}
+	for _, layout := range chimpLayouts {
+		if t, err := time.Parse(layout, s); err == nil {
+			return t.UTC(), nil
+		}
+	}
+	if secs, err := strconv.ParseInt(s, 10, 64); err == nil {
+		return time.Unix(secs, 0).UTC(), nil
+	}
+	return time.Time{}, TimeError{s}
+}
+
+//chimpTime formats time parameters in ChimpTimeFormat in GMT, leaving any
+//other value as it is
+func chimpTime(t interface{}) interface{} {
+	switch ti := t.(type) {
+	case time.Time:
+		return ti.UTC().Format(ChimpTimeFormat)
+	case ChimpTime:
+		return ti.UTC().Format(ChimpTimeFormat)
+	case string:
+		return ti
+	}
+	return t
+}
+
+//Location loads the time zone of the account, for showing the dates of
+//results in the account's local time with ChimpTime.In
+func (r *GetAccountDetailsResult) Location() (*time.Location, error) {
+	if r.Timezone == "" {
+		return time.UTC, nil
+	}
+	return time.LoadLocation(r.Timezone)
+}
+
+//timeParameters returns a copy of parameters with the time parameters named
+//formatted by chimpTime, leaving the caller's map as it was
+func timeParameters(parameters map[string]interface{}, names ...string) map[string]interface{} {
+	if parameters == nil {
+		return nil
+	}
+	converted := make(map[string]interface{}, len(parameters))
+	for k, v := range parameters {
+		converted[k] = v
+	}
+	for _, name := range names {
+		if t, ok := parameters[name]; ok {
+			converted[name] = chimpTime(t)
+		}
+	}
+	return converted
+}
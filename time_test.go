@@ -0,0 +1,85 @@
+package mailchimp
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestChimpTimeUnmarshal(t *testing.T) {
+	for input, expected := range map[string]time.Time{
+		`"2010-06-04 03:01:19"`:       time.Date(2010, 6, 4, 3, 1, 19, 0, time.UTC),
+		`"2010-06-04 03:01:19.250"`:   time.Date(2010, 6, 4, 3, 1, 19, 250e6, time.UTC),
+		`"2010-06-04 03:01:19 -0400"`: time.Date(2010, 6, 4, 7, 1, 19, 0, time.UTC),
+		`"2010-06-04T03:01:19+00:00"`: time.Date(2010, 6, 4, 3, 1, 19, 0, time.UTC),
+		`"2010-06-04T03:01:19"`:       time.Date(2010, 6, 4, 3, 1, 19, 0, time.UTC),
+		`"2010-06-04 03:01"`:          time.Date(2010, 6, 4, 3, 1, 0, 0, time.UTC),
+		`"2010-06-04"`:                time.Date(2010, 6, 4, 0, 0, 0, 0, time.UTC),
+		`"2010-06"`:                   time.Date(2010, 6, 1, 0, 0, 0, 0, time.UTC),
+		`1275620479`:                  time.Date(2010, 6, 4, 3, 1, 19, 0, time.UTC),
+		`"1275620479"`:                time.Date(2010, 6, 4, 3, 1, 19, 0, time.UTC),
+		`""`:                          {},
+		`null`:                        {},
+		`"0000-00-00 00:00:00"`:       {},
+	} {
+		ct := ChimpTime{time.Now()}
+		if err := json.Unmarshal([]byte(input), &ct); err != nil || !ct.Equal(expected) || ct.Location() != time.UTC {
+			t.Errorf("ChimpTime %s: expected %v but got %v, %v", input, expected, ct.Time, err)
+		}
+	}
+	for _, input := range []string{`"June 4th"`, `"2010-13-01"`, `true`} {
+		var ct ChimpTime
+		err := json.Unmarshal([]byte(input), &ct)
+		var timeErr TimeError
+		if !errors.As(err, &timeErr) {
+			t.Errorf("ChimpTime %s: expected a TimeError but got %v", input, err)
+		}
+	}
+}
+
+func TestChimpTimeMarshal(t *testing.T) {
+	est := time.FixedZone("EST", -5*60*60)
+	for expected, ct := range map[string]ChimpTime{
+		`"2010-06-04 08:01:19"`: {time.Date(2010, 6, 4, 3, 1, 19, 0, est)},
+		`""`:                    {},
+	} {
+		b, err := json.Marshal(ct)
+		if err != nil || string(b) != expected {
+			t.Errorf("ChimpTime %v: expected %s but got %s, %v", ct.Time, expected, b, err)
+		}
+		var back ChimpTime
+		if err := json.Unmarshal(b, &back); err != nil || !back.Equal(ct.Time) {
+			t.Errorf("ChimpTime %s: expected to decode to %v but got %v, %v", b, ct.Time, back.Time, err)
+		}
+	}
+	verify(t, "chimpTime", "2010-06-04 08:01:19", chimpTime(time.Date(2010, 6, 4, 3, 1, 19, 0, est)))
+}
+
+func TestChimpTimeFixtures(t *testing.T) {
+	reports := new(ListAbuseReportsResponse)
+	if err := populate("listAbuseReports", reports); err != nil {
+		t.Fatal(err)
+	}
+	if expected := time.Date(2010, 8, 3, 23, 41, 18, 0, time.UTC); !reports.Data[1].Date.Equal(expected) {
+		t.Error("ListAbuseReportsResponse: expected", expected, "but got", reports.Data[1].Date)
+	}
+}
+
+func TestChimpTimeDecodeErrorField(t *testing.T) {
+	body := []byte(`{"total":1,"data":[{"date":"last tuesday"}]}`)
+	result := new(ListAbuseReportsResponse)
+	err := newDecodeError("listAbuseReports", body, result, json.Unmarshal(body, result))
+	if err.Field != "data.0.date" {
+		t.Error("expected the field path data.0.date but got", err.Field)
+	}
+}
+
+func TestAccountLocation(t *testing.T) {
+	details := &GetAccountDetailsResult{Timezone: "America/New_York"}
+	loc, err := details.Location()
+	if err != nil {
+		t.Skip("no time zone database:", err)
+	}
+	verify(t, "Location", "America/New_York", loc.String())
+}
@@ -0,0 +1,74 @@
+package webhook
+
+import "github.com/areed/mailchimp"
+
+//SubscribeEvent is sent when someone subscribes to a list
+type SubscribeEvent struct {
+	FiredAt   mailchimp.ChimpTime `json:"fired_at"`
+	ID        string              `json:"id"`
+	ListID    string              `json:"list_id"`
+	Email     string              `json:"email"`
+	EmailType string              `json:"email_type"`
+	Merges    mailchimp.MergeVars `json:"merges"`
+	IPOpt     string              `json:"ip_opt"`
+	IPSignup  string              `json:"ip_signup"`
+}
+
+//UnsubscribeEvent is sent when a member unsubscribes or is deleted from a list
+type UnsubscribeEvent struct {
+	FiredAt mailchimp.ChimpTime `json:"fired_at"`
+	//Action is unsub, or delete if the member was deleted
+	Action string `json:"action"`
+	//Reason is manual, or abuse if the member reported a campaign as spam
+	Reason     string              `json:"reason"`
+	ID         string              `json:"id"`
+	ListID     string              `json:"list_id"`
+	Email      string              `json:"email"`
+	EmailType  string              `json:"email_type"`
+	Merges     mailchimp.MergeVars `json:"merges"`
+	IPOpt      string              `json:"ip_opt"`
+	CampaignID string              `json:"campaign_id"`
+}
+
+//ProfileEvent is sent when a member changes their merge fields or interest
+//groups
+type ProfileEvent struct {
+	FiredAt   mailchimp.ChimpTime `json:"fired_at"`
+	ID        string              `json:"id"`
+	ListID    string              `json:"list_id"`
+	Email     string              `json:"email"`
+	EmailType string              `json:"email_type"`
+	Merges    mailchimp.MergeVars `json:"merges"`
+	IPOpt     string              `json:"ip_opt"`
+}
+
+//UpemailEvent is sent when a member changes their email address, which also
+//gives them a new unique id
+type UpemailEvent struct {
+	FiredAt  mailchimp.ChimpTime `json:"fired_at"`
+	ListID   string              `json:"list_id"`
+	NewID    string              `json:"new_id"`
+	NewEmail string              `json:"new_email"`
+	OldEmail string              `json:"old_email"`
+}
+
+//CleanedEvent is sent when a member's address is removed from a list
+type CleanedEvent struct {
+	FiredAt    mailchimp.ChimpTime `json:"fired_at"`
+	ListID     string              `json:"list_id"`
+	CampaignID string              `json:"campaign_id"`
+	//Reason is hard for a hard bounce, or abuse
+	Reason string `json:"reason"`
+	Email  string `json:"email"`
+}
+
+//CampaignEvent is sent when a campaign to a list is sent or cancelled
+type CampaignEvent struct {
+	FiredAt mailchimp.ChimpTime `json:"fired_at"`
+	ID      string              `json:"id"`
+	Subject string              `json:"subject"`
+	//Status is sent or cancel
+	Status string `json:"status"`
+	Reason string `json:"reason"`
+	ListID string `json:"list_id"`
+}
@@ -0,0 +1,179 @@
+//Package webhook receives the calls Mailchimp makes to the webhooks of a
+//list, such as those added with mailchimp.EnsureWebhook.
+//
+//Mailchimp posts a form for every change to the list, e.g.
+//type=subscribe&fired_at=...&data[email]=...&data[merges][FNAME]=...,
+//which a Handler parses into a typed event and passes to the callback
+//registered for its type:
+//
+//	hooks := webhook.NewHandler(secret)
+//	hooks.OnSubscribe(func(ctx context.Context, e *webhook.SubscribeEvent) error {
+//		return welcome(ctx, e.Email, e.Merges.String("FNAME"))
+//	})
+//	http.Handle("/mailchimp", hooks)
+//
+//and the webhook's url is https://example.com/mailchimp?key=<secret>.
+package webhook
+
+import (
+	"context"
+	"crypto/subtle"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+
+	"github.com/areed/mailchimp"
+)
+
+//Handler is an http.Handler for a list webhook, which checks that each call
+//carries the secret in its url, answers the GET request Mailchimp makes to
+//check the url when the webhook is added, and passes the events it is
+//posted to the callbacks registered for them. Calls whose events have no
+//callback are accepted and ignored.
+//
+//Callbacks must be registered before the Handler serves any request.
+type Handler struct {
+	secret      string
+	param       string
+	logger      mailchimp.Logger
+	subscribe   func(context.Context, *SubscribeEvent) error
+	unsubscribe func(context.Context, *UnsubscribeEvent) error
+	profile     func(context.Context, *ProfileEvent) error
+	upemail     func(context.Context, *UpemailEvent) error
+	cleaned     func(context.Context, *CleanedEvent) error
+	campaign    func(context.Context, *CampaignEvent) error
+}
+
+//Option configures a Handler
+type Option func(*Handler)
+
+//WithSecretParam sets the url query parameter holding the secret, which is
+//key by default
+func WithSecretParam(name string) Option {
+	return func(h *Handler) {
+		h.param = name
+	}
+}
+
+//WithLogger records the calls the Handler rejects and the errors its
+//callbacks return to logger, at slog.LevelError. *slog.Logger satisfies
+//mailchimp.Logger.
+func WithLogger(logger mailchimp.Logger) Option {
+	return func(h *Handler) {
+		h.logger = logger
+	}
+}
+
+//NewHandler returns a Handler accepting calls whose url has the query
+//parameter key=secret. An empty secret accepts every call, e.g. for a
+//Handler behind other authentication.
+func NewHandler(secret string, options ...Option) *Handler {
+	h := &Handler{secret: secret, param: "key"}
+	for _, option := range options {
+		option(h)
+	}
+	return h
+}
+
+//OnSubscribe registers f to be called with subscribe events
+func (h *Handler) OnSubscribe(f func(context.Context, *SubscribeEvent) error) {
+	h.subscribe = f
+}
+
+//OnUnsubscribe registers f to be called with unsubscribe events
+func (h *Handler) OnUnsubscribe(f func(context.Context, *UnsubscribeEvent) error) {
+	h.unsubscribe = f
+}
+
+//OnProfile registers f to be called with profile events
+func (h *Handler) OnProfile(f func(context.Context, *ProfileEvent) error) {
+	h.profile = f
+}
+
+//OnUpemail registers f to be called with upemail events
+func (h *Handler) OnUpemail(f func(context.Context, *UpemailEvent) error) {
+	h.upemail = f
+}
+
+//OnCleaned registers f to be called with cleaned events
+func (h *Handler) OnCleaned(f func(context.Context, *CleanedEvent) error) {
+	h.cleaned = f
+}
+
+//OnCampaign registers f to be called with campaign events
+func (h *Handler) OnCampaign(f func(context.Context, *CampaignEvent) error) {
+	h.campaign = f
+}
+
+//ServeHTTP answers 403 Forbidden to calls without the secret and 400 Bad
+//Request to forms it cannot parse. If the callback returns an error it
+//answers 500 Internal Server Error, so that Mailchimp calls again later.
+func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if h.secret != "" && subtle.ConstantTimeCompare([]byte(r.URL.Query().Get(h.param)), []byte(h.secret)) != 1 {
+		h.fail(w, r, http.StatusForbidden, errors.New("webhook: missing or wrong secret"))
+		return
+	}
+	switch r.Method {
+	case http.MethodGet, http.MethodHead:
+		w.WriteHeader(http.StatusOK)
+		return
+	case http.MethodPost:
+	default:
+		w.Header().Set("Allow", "GET, HEAD, POST")
+		h.fail(w, r, http.StatusMethodNotAllowed, fmt.Errorf("webhook: method %v not allowed", r.Method))
+		return
+	}
+	if err := r.ParseForm(); err != nil {
+		h.fail(w, r, http.StatusBadRequest, err)
+		return
+	}
+	event, err := Parse(r.PostForm)
+	if err != nil {
+		h.fail(w, r, http.StatusBadRequest, err)
+		return
+	}
+	if err := h.dispatch(r.Context(), event); err != nil {
+		h.fail(w, r, http.StatusInternalServerError, err)
+		return
+	}
+	w.WriteHeader(http.StatusOK)
+}
+
+//dispatch passes event to the callback registered for its type, if any
+func (h *Handler) dispatch(ctx context.Context, event interface{}) error {
+	switch e := event.(type) {
+	case *SubscribeEvent:
+		if h.subscribe != nil {
+			return h.subscribe(ctx, e)
+		}
+	case *UnsubscribeEvent:
+		if h.unsubscribe != nil {
+			return h.unsubscribe(ctx, e)
+		}
+	case *ProfileEvent:
+		if h.profile != nil {
+			return h.profile(ctx, e)
+		}
+	case *UpemailEvent:
+		if h.upemail != nil {
+			return h.upemail(ctx, e)
+		}
+	case *CleanedEvent:
+		if h.cleaned != nil {
+			return h.cleaned(ctx, e)
+		}
+	case *CampaignEvent:
+		if h.campaign != nil {
+			return h.campaign(ctx, e)
+		}
+	}
+	return nil
+}
+
+func (h *Handler) fail(w http.ResponseWriter, r *http.Request, status int, err error) {
+	if h.logger != nil {
+		h.logger.Log(r.Context(), slog.LevelError, "mailchimp webhook", "status", status, "error", err.Error(), "type", r.PostForm.Get("type"))
+	}
+	http.Error(w, http.StatusText(status), status)
+}
@@ -0,0 +1,69 @@
+package webhook
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+)
+
+func TestHandler(t *testing.T) {
+	var subscribed []string
+	hooks := NewHandler("s3cret")
+	hooks.OnSubscribe(func(ctx context.Context, e *SubscribeEvent) error {
+		if e.Email == "fail@example.com" {
+			return errors.New("database unavailable")
+		}
+		subscribed = append(subscribed, e.Email)
+		return nil
+	})
+	server := httptest.NewServer(hooks)
+	defer server.Close()
+
+	for _, test := range []struct {
+		method, query string
+		form          url.Values
+		status        int
+	}{
+		{"GET", "?key=s3cret", nil, http.StatusOK},
+		{"GET", "", nil, http.StatusForbidden},
+		{"POST", "?key=wrong", url.Values{"type": {"subscribe"}, "data[email]": {"wrong@example.com"}}, http.StatusForbidden},
+		{"POST", "?key=s3cret", url.Values{"type": {"subscribe"}, "data[email]": {"jane@example.com"}}, http.StatusOK},
+		{"POST", "?key=s3cret", url.Values{"type": {"subscribe"}, "data[email]": {"fail@example.com"}}, http.StatusInternalServerError},
+		{"POST", "?key=s3cret", url.Values{"type": {"cleaned"}, "data[email]": {"jane@example.com"}}, http.StatusOK},
+		{"POST", "?key=s3cret", url.Values{"type": {"bounce"}}, http.StatusBadRequest},
+		{"DELETE", "?key=s3cret", nil, http.StatusMethodNotAllowed},
+	} {
+		var resp *http.Response
+		var err error
+		if test.method == "POST" {
+			resp, err = http.PostForm(server.URL+test.query, test.form)
+		} else {
+			req, _ := http.NewRequest(test.method, server.URL+test.query, nil)
+			resp, err = http.DefaultClient.Do(req)
+		}
+		if err != nil {
+			t.Fatal(err)
+		}
+		resp.Body.Close()
+		if resp.StatusCode != test.status {
+			t.Errorf("%v %v %v: expected status %v but got %v", test.method, test.query, test.form, test.status, resp.StatusCode)
+		}
+	}
+	if len(subscribed) != 1 || subscribed[0] != "jane@example.com" {
+		t.Error("expected one subscribe event for jane@example.com but got", subscribed)
+	}
+}
+
+func TestHandlerSecretParam(t *testing.T) {
+	hooks := NewHandler("s3cret", WithSecretParam("token"))
+	for query, status := range map[string]int{"?token=s3cret": http.StatusOK, "?key=s3cret": http.StatusForbidden} {
+		w := httptest.NewRecorder()
+		hooks.ServeHTTP(w, httptest.NewRequest("GET", "/mailchimp"+query, nil))
+		if w.Code != status {
+			t.Errorf("%v: expected status %v but got %v", query, status, w.Code)
+		}
+	}
+}
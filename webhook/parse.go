@@ -0,0 +1,139 @@
+package webhook
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+//Parse parses the form Mailchimp posts to a webhook into a *SubscribeEvent,
+//*UnsubscribeEvent, *ProfileEvent, *UpemailEvent, *CleanedEvent or
+//*CampaignEvent, according to its type
+func Parse(form url.Values) (interface{}, error) {
+	var event interface{}
+	switch t := form.Get("type"); t {
+	case "subscribe":
+		event = new(SubscribeEvent)
+	case "unsubscribe":
+		event = new(UnsubscribeEvent)
+	case "profile":
+		event = new(ProfileEvent)
+	case "upemail":
+		event = new(UpemailEvent)
+	case "cleaned":
+		event = new(CleanedEvent)
+	case "campaign":
+		event = new(CampaignEvent)
+	default:
+		return nil, fmt.Errorf("webhook: unknown event type %q", t)
+	}
+	tree, err := formTree(form)
+	if err != nil {
+		return nil, err
+	}
+	data, _ := tree["data"].(map[string]interface{})
+	if data == nil {
+		data = make(map[string]interface{})
+	}
+	//the time is sent beside the data rather than in it, but is a field of
+	//every event
+	data["fired_at"] = form.Get("fired_at")
+	//the events' types decode what Mailchimp sends as JSON, so the form is
+	//decoded by way of JSON too
+	b, err := json.Marshal(arrays(data))
+	if err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(b, event); err != nil {
+		return nil, fmt.Errorf("webhook: %v event: %w", form.Get("type"), err)
+	}
+	return event, nil
+}
+
+//formTree nests the values of PHP style form keys, e.g.
+//data[merges][FNAME]=Jane, in maps keyed by the parts of the key
+func formTree(form url.Values) (map[string]interface{}, error) {
+	tree := make(map[string]interface{})
+	for key, values := range form {
+		path, err := keyPath(key)
+		if err != nil {
+			return nil, err
+		}
+		node := tree
+		for _, part := range path[:len(path)-1] {
+			child, ok := node[part].(map[string]interface{})
+			if !ok {
+				if _, leaf := node[part]; leaf {
+					return nil, fmt.Errorf("webhook: form key %v is both a value and an object", key)
+				}
+				child = make(map[string]interface{})
+				node[part] = child
+			}
+			node = child
+		}
+		last := path[len(path)-1]
+		if _, exists := node[last]; exists {
+			return nil, fmt.Errorf("webhook: form key %v is both a value and an object", key)
+		}
+		node[last] = values[0]
+	}
+	return tree, nil
+}
+
+//keyPath splits a form key such as data[merges][FNAME] into its parts
+func keyPath(key string) ([]string, error) {
+	name, rest, nested := strings.Cut(key, "[")
+	path := []string{name}
+	for nested {
+		var part string
+		part, rest, nested = strings.Cut(rest, "]")
+		if !nested {
+			return nil, fmt.Errorf("webhook: malformed form key %v", key)
+		}
+		path = append(path, part)
+		if rest == "" {
+			break
+		}
+		if rest[0] != '[' {
+			return nil, fmt.Errorf("webhook: malformed form key %v", key)
+		}
+		rest = rest[1:]
+	}
+	return path, nil
+}
+
+//arrays turns the maps in v keyed by 0, 1, 2, ... into slices, e.g. the
+//groupings of a member's merges, which are sent as
+//data[merges][GROUPINGS][0][name]
+func arrays(v interface{}) interface{} {
+	node, ok := v.(map[string]interface{})
+	if !ok {
+		return v
+	}
+	for k, child := range node {
+		node[k] = arrays(child)
+	}
+	keys := make([]int, 0, len(node))
+	for k := range node {
+		i, err := strconv.Atoi(k)
+		if err != nil || strconv.Itoa(i) != k {
+			return node
+		}
+		keys = append(keys, i)
+	}
+	if len(keys) == 0 {
+		return node
+	}
+	sort.Ints(keys)
+	items := make([]interface{}, len(keys))
+	for i, k := range keys {
+		if k != i {
+			return node
+		}
+		items[i] = node[strconv.Itoa(k)]
+	}
+	return items
+}
@@ -0,0 +1,112 @@
+package webhook
+
+import (
+	"net/url"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/areed/mailchimp"
+)
+
+func TestParse(t *testing.T) {
+	form := url.Values{
+		"type":                               {"subscribe"},
+		"fired_at":                           {"2009-03-26 21:35:57"},
+		"data[id]":                           {"8a25ff1d98"},
+		"data[list_id]":                      {"a6b5da1054"},
+		"data[email]":                        {"api@mailchimp.com"},
+		"data[email_type]":                   {"html"},
+		"data[merges][EMAIL]":                {"api@mailchimp.com"},
+		"data[merges][FNAME]":                {"MailChimp"},
+		"data[merges][INTERESTS]":            {"Group1,Group2"},
+		"data[merges][GROUPINGS][0][id]":     {"1"},
+		"data[merges][GROUPINGS][0][name]":   {"Interests"},
+		"data[merges][GROUPINGS][0][groups]": {"Coffee, Tea"},
+		"data[merges][GROUPINGS][1][id]":     {"2"},
+		"data[merges][GROUPINGS][1][name]":   {"Size"},
+		"data[merges][GROUPINGS][1][groups]": {""},
+		"data[ip_opt]":                       {"10.20.10.30"},
+		"data[ip_signup]":                    {"10.20.10.30"},
+	}
+	event, err := Parse(form)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := &SubscribeEvent{
+		FiredAt:   mailchimp.ChimpTime{Time: time.Date(2009, 3, 26, 21, 35, 57, 0, time.UTC)},
+		ID:        "8a25ff1d98",
+		ListID:    "a6b5da1054",
+		Email:     "api@mailchimp.com",
+		EmailType: "html",
+		Merges: mailchimp.MergeVars{
+			Fields: map[string]interface{}{"EMAIL": "api@mailchimp.com", "FNAME": "MailChimp", "INTERESTS": "Group1,Group2"},
+			Groupings: []mailchimp.Grouping{
+				{ID: 1, Name: "Interests", Groups: []string{"Coffee", "Tea"}},
+				{ID: 2, Name: "Size"},
+			},
+		},
+		IPOpt:    "10.20.10.30",
+		IPSignup: "10.20.10.30",
+	}
+	if !reflect.DeepEqual(event, expected) {
+		t.Errorf("expected %+v but got %+v", expected, event)
+	}
+}
+
+func TestParseTypes(t *testing.T) {
+	for _, test := range []struct {
+		form     string
+		expected interface{}
+	}{
+		{
+			"type=unsubscribe&data[action]=unsub&data[reason]=manual&data[email]=api@mailchimp.com&data[campaign_id]=cb398d21d2",
+			&UnsubscribeEvent{Action: "unsub", Reason: "manual", Email: "api@mailchimp.com", CampaignID: "cb398d21d2"},
+		},
+		{
+			"type=profile&data[email]=api@mailchimp.com&data[merges][FNAME]=Jane",
+			&ProfileEvent{Email: "api@mailchimp.com", Merges: mailchimp.MergeVars{Fields: map[string]interface{}{"FNAME": "Jane"}}},
+		},
+		{
+			"type=upemail&data[list_id]=a6b5da1054&data[new_id]=51da8c3259&data[new_email]=new@mailchimp.com&data[old_email]=old@mailchimp.com",
+			&UpemailEvent{ListID: "a6b5da1054", NewID: "51da8c3259", NewEmail: "new@mailchimp.com", OldEmail: "old@mailchimp.com"},
+		},
+		{
+			"type=cleaned&data[reason]=hard&data[email]=api@mailchimp.com",
+			&CleanedEvent{Reason: "hard", Email: "api@mailchimp.com"},
+		},
+		{
+			"type=campaign&data[id]=5aa2102003&data[subject]=Test+Campaign+Subject&data[status]=sent",
+			&CampaignEvent{ID: "5aa2102003", Subject: "Test Campaign Subject", Status: "sent"},
+		},
+	} {
+		form, err := url.ParseQuery(test.form)
+		if err != nil {
+			t.Fatal(err)
+		}
+		event, err := Parse(form)
+		if err != nil {
+			t.Errorf("%v: %v", test.form, err)
+		} else if !reflect.DeepEqual(event, test.expected) {
+			t.Errorf("%v: expected %+v but got %+v", test.form, test.expected, event)
+		}
+	}
+}
+
+func TestParseErrors(t *testing.T) {
+	for _, form := range []string{
+		"type=bounce",
+		"type=subscribe&data[email=api@mailchimp.com",
+		"type=subscribe&data[merges]x=1",
+		"type=subscribe&data[merges]=1&data[merges][FNAME]=Jane",
+		"type=subscribe&fired_at=yesterday",
+	} {
+		values, err := url.ParseQuery(form)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if event, err := Parse(values); err == nil {
+			t.Errorf("%v: expected an error but got %+v", form, event)
+		}
+	}
+}
@@ -0,0 +1,47 @@
+package mailchimp
+
+import "context"
+
+//AllWebhookActions returns WebhookActions for every action
+func AllWebhookActions() WebhookActions {
+	return WebhookActions{
+		Subscribe:   true,
+		Unsubscribe: true,
+		Profile:     true,
+		Cleaned:     true,
+		UpEmail:     true,
+		Campaign:    true,
+	}
+}
+
+//EnsureWebhook makes hook a webhook of the list with the given id, called for
+//exactly its actions and sources, and reports whether it had to change the
+//list's webhooks to do so. A webhook with the same url but other actions or
+//sources is deleted and added again, so Mailchimp does not call it for a moment
+//in between. Running it again changes nothing, so a deploy can run it every
+//time.
+func (a *API) EnsureWebhook(ctx context.Context, id string, hook Webhook) (bool, error) {
+	hooks, err := a.ListWebhooksWithParams(ctx, &ListWebhooksParams{ID: id})
+	if err != nil {
+		return false, err
+	}
+	for _, existing := range hooks {
+		if existing.URL != hook.URL {
+			continue
+		}
+		if existing.Actions == hook.Actions && existing.Sources == hook.Sources {
+			return false, nil
+		}
+		if _, err := a.ListWebhookDelWithParams(ctx, &ListWebhookDelParams{ID: id, URL: hook.URL}); err != nil {
+			return false, err
+		}
+		break
+	}
+	_, err = a.ListWebhookAddWithParams(ctx, &ListWebhookAddParams{
+		ID:      id,
+		URL:     hook.URL,
+		Actions: &hook.Actions,
+		Sources: &hook.Sources,
+	})
+	return true, err
+}